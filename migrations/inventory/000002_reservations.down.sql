@@ -0,0 +1,6 @@
+-- Drop tables in reverse order
+DROP TABLE IF EXISTS reservations;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_reserved_within_stock,
+    DROP COLUMN IF EXISTS reserved_quantity;
\ No newline at end of file
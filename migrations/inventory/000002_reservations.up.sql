@@ -0,0 +1,18 @@
+-- Stock held for orders that are not confirmed yet
+ALTER TABLE products
+    ADD COLUMN reserved_quantity NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (reserved_quantity >= 0),
+    ADD CONSTRAINT products_reserved_within_stock CHECK (reserved_quantity <= stock_quantity);
+
+CREATE TABLE reservations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'held',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reservations_order_id ON reservations (order_id);
+CREATE INDEX idx_reservations_held_expiry ON reservations (expires_at) WHERE status = 'held';
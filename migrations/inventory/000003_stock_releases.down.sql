@@ -0,0 +1,3 @@
+-- Drop tables in reverse order
+DROP TABLE IF EXISTS stock_release_lines;
+DROP TABLE IF EXISTS stock_releases;
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- Stock returned to inventory, keyed by the caller's release id so a retried
+-- release is applied only once
+CREATE TABLE stock_releases (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE stock_release_lines (
+    release_id VARCHAR(255) NOT NULL REFERENCES stock_releases(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (release_id, product_id)
+);
+
+CREATE INDEX idx_stock_releases_order_id ON stock_releases (order_id);
@@ -0,0 +1,3 @@
+ALTER TABLE products
+    ALTER COLUMN price TYPE NUMERIC(10,2),
+    DROP COLUMN IF EXISTS currency;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Prices carry their currency. Three decimal places fit every ISO 4217 minor
+-- unit, so amounts round-trip exactly.
+ALTER TABLE products
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ALTER COLUMN price TYPE NUMERIC(12,3);
@@ -0,0 +1,3 @@
+-- The tax class of a category picks the rate the orders service charges on
+-- its products. Empty is the standard rate of the region.
+ALTER TABLE categories ADD COLUMN tax_class VARCHAR(32) NOT NULL DEFAULT '';
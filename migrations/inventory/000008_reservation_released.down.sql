@@ -0,0 +1,3 @@
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_released_within_quantity,
+    DROP COLUMN IF EXISTS released_quantity;
@@ -0,0 +1,20 @@
+-- Stock releases record what they give back of a reservation instead of
+-- shrinking its quantity, so the quantity an order reserved is kept
+ALTER TABLE reservations
+    ADD COLUMN released_quantity INTEGER NOT NULL DEFAULT 0;
+
+-- Confirmed stock already credited back by releases made after the
+-- confirmation
+UPDATE reservations r
+SET released_quantity = LEAST(r.quantity, (
+    SELECT COALESCE(SUM(l.quantity), 0)
+    FROM stock_releases s
+    JOIN stock_release_lines l ON l.release_id = s.id
+    WHERE s.order_id = r.order_id
+      AND l.product_id = r.product_id
+      AND s.created_at >= r.updated_at
+))
+WHERE r.status = 'confirmed';
+
+ALTER TABLE reservations
+    ADD CONSTRAINT reservations_released_within_quantity CHECK (released_quantity BETWEEN 0 AND quantity);
@@ -0,0 +1,10 @@
+-- Drop tables in reverse order
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS order_items;
+DROP TABLE IF EXISTS orders;
+
+-- Drop enumerations
+DROP TYPE IF EXISTS order_status;
+
+-- Remove UUID extension
+DROP EXTENSION IF EXISTS "uuid-ossp";
\ No newline at end of file
@@ -12,9 +12,9 @@ CREATE TYPE order_status AS ENUM (
 -- Orders Table
 CREATE TABLE orders (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    user_id UUID NOT NULL
+    user_id UUID NOT NULL,
     user_name VARCHAR(255) NOT NULL,
-    total_amount NUMERIC(12,2) NOT NULL CHECK (total_amount >= 0);
+    total_amount NUMERIC(12,2) NOT NULL CHECK (total_amount >= 0),
     status order_status NOT NULL DEFAULT 'pending',
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
@@ -0,0 +1,19 @@
+-- Saga log for CreateOrder stock reservations
+CREATE TABLE order_sagas (
+    order_id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'started',
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE order_saga_steps (
+    order_id UUID NOT NULL REFERENCES order_sagas(order_id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (order_id, product_id)
+);
+
+CREATE INDEX idx_order_sagas_status ON order_sagas (status);
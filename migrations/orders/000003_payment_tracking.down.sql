@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_payments_active_order;
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS failure_reason,
+    DROP COLUMN IF EXISTS provider_reference;
\ No newline at end of file
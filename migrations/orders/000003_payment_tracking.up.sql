@@ -0,0 +1,6 @@
+-- Provider bookkeeping for payments
+ALTER TABLE payments
+    ADD COLUMN provider_reference VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN failure_reason TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX idx_payments_active_order ON payments (order_id) WHERE status <> 'failed';
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS payment_refund_lines;
+
+DROP INDEX IF EXISTS idx_payments_parent_payment_id;
+DROP INDEX IF EXISTS idx_payments_active_order;
+CREATE UNIQUE INDEX idx_payments_active_order ON payments (order_id) WHERE status <> 'failed';
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS parent_payment_id,
+    DROP COLUMN IF EXISTS kind;
\ No newline at end of file
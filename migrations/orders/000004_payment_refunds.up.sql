@@ -0,0 +1,16 @@
+-- Refunds are payments of kind 'refund' that point at the captured charge
+ALTER TABLE payments
+    ADD COLUMN kind VARCHAR(20) NOT NULL DEFAULT 'charge',
+    ADD COLUMN parent_payment_id UUID REFERENCES payments(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_payments_active_order;
+CREATE UNIQUE INDEX idx_payments_active_order ON payments (order_id) WHERE status <> 'failed' AND kind = 'charge';
+CREATE INDEX idx_payments_parent_payment_id ON payments (parent_payment_id);
+
+CREATE TABLE payment_refund_lines (
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    amount NUMERIC(12,2) NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (payment_id, product_id)
+);
@@ -0,0 +1,7 @@
+ALTER TABLE payment_refund_lines ALTER COLUMN amount TYPE NUMERIC(12,2);
+ALTER TABLE payments ALTER COLUMN amount TYPE NUMERIC(12,2);
+ALTER TABLE order_items ALTER COLUMN product_price TYPE NUMERIC(10,2);
+
+ALTER TABLE orders
+    ALTER COLUMN total_amount TYPE NUMERIC(12,2),
+    DROP COLUMN IF EXISTS currency;
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Orders carry the currency their items and payments are priced in. Three
+-- decimal places fit every ISO 4217 minor unit, so amounts round-trip exactly.
+ALTER TABLE orders
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ALTER COLUMN total_amount TYPE NUMERIC(15,3);
+
+ALTER TABLE order_items ALTER COLUMN product_price TYPE NUMERIC(12,3);
+ALTER TABLE payments ALTER COLUMN amount TYPE NUMERIC(15,3);
+ALTER TABLE payment_refund_lines ALTER COLUMN amount TYPE NUMERIC(15,3);
@@ -0,0 +1,13 @@
+-- Requests made under an idempotency key. A row is a short claim while the
+-- request runs and holds the encoded response once it succeeds; expired rows
+-- are taken over by the next request with the same key.
+CREATE TABLE idempotency_keys (
+    scope VARCHAR(255) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    fingerprint VARCHAR(64) NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    response BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (scope, key)
+);
@@ -0,0 +1,17 @@
+-- Domain events written in the same transaction as the change they describe.
+-- The relay publishes unpublished rows in seq order and stamps published_at;
+-- attempts and last_error record failed deliveries.
+CREATE TABLE outbox_events (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    published_at TIMESTAMP WITH TIME ZONE,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_outbox_events_unpublished ON outbox_events (seq) WHERE published_at IS NULL;
@@ -0,0 +1,2 @@
+-- Order watchers read the events of one order after a given position.
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_type, aggregate_id, seq);
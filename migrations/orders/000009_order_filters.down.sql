@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_orders_created_at;
+DROP INDEX IF EXISTS idx_orders_status_created_at;
\ No newline at end of file
@@ -0,0 +1,2 @@
+CREATE INDEX idx_orders_status_created_at ON orders (status, created_at);
+CREATE INDEX idx_orders_created_at ON orders (created_at);
@@ -0,0 +1,18 @@
+-- One row per status change of an order. Rows outlive their order, so a
+-- deleted order can still be audited.
+CREATE TABLE order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL,
+    from_status order_status,
+    to_status order_status NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_order_status_history_order_id ON order_status_history (order_id, id);
+
+-- Orders placed before the history existed start from their current status.
+INSERT INTO order_status_history (order_id, to_status, actor, reason, changed_at)
+SELECT id, status, 'system', 'status when history was introduced', updated_at
+FROM orders;
@@ -0,0 +1,36 @@
+-- Coupons are looked up by their normalized (upper-case) code. redemptions
+-- counts the orders placed with the coupon and is bumped in the transaction
+-- that saves the order, so the global limit cannot be overrun.
+CREATE TABLE coupons (
+    id UUID PRIMARY KEY,
+    code VARCHAR(64) NOT NULL UNIQUE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('percentage', 'fixed_amount', 'free_shipping')),
+    percent_off INTEGER NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 10000),
+    amount_off NUMERIC(15,3) NOT NULL DEFAULT 0 CHECK (amount_off >= 0),
+    min_order_value NUMERIC(15,3) NOT NULL DEFAULT 0 CHECK (min_order_value >= 0),
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    valid_from TIMESTAMP WITH TIME ZONE,
+    valid_until TIMESTAMP WITH TIME ZONE,
+    max_redemptions INTEGER NOT NULL DEFAULT 0 CHECK (max_redemptions >= 0),
+    max_redemptions_per_user INTEGER NOT NULL DEFAULT 0 CHECK (max_redemptions_per_user >= 0),
+    redemptions INTEGER NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- What each coupon took off an order, copied from the coupon when the order
+-- was placed so the total stays reproducible after the coupon changes.
+CREATE TABLE order_discounts (
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    coupon_id UUID NOT NULL REFERENCES coupons(id),
+    code VARCHAR(64) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    percent_off INTEGER NOT NULL DEFAULT 0,
+    amount NUMERIC(15,3) NOT NULL CHECK (amount >= 0),
+    free_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (order_id, coupon_id)
+);
+
+CREATE INDEX idx_order_discounts_coupon_id ON order_discounts (coupon_id);
@@ -0,0 +1,15 @@
+ALTER TABLE orders ADD COLUMN tax_region VARCHAR(32) NOT NULL DEFAULT '';
+
+-- The tax on each order item, worked out when the order was placed. Tax that
+-- is not inclusive was added to the order's total_amount.
+CREATE TABLE order_tax_lines (
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    tax_class VARCHAR(32) NOT NULL,
+    region VARCHAR(32) NOT NULL,
+    rate INTEGER NOT NULL CHECK (rate BETWEEN 0 AND 100000),
+    taxable NUMERIC(15,3) NOT NULL,
+    amount NUMERIC(15,3) NOT NULL CHECK (amount >= 0),
+    inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (order_id, product_id)
+);
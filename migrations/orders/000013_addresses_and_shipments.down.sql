@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS shipment_items;
+DROP TABLE IF EXISTS shipments;
+DROP TABLE IF EXISTS order_addresses;
\ No newline at end of file
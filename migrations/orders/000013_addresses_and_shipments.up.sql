@@ -0,0 +1,35 @@
+-- An order has at most one address of each kind, set when it is placed.
+CREATE TABLE order_addresses (
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('shipping', 'billing')),
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    line1 VARCHAR(255) NOT NULL,
+    line2 VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(255) NOT NULL,
+    region VARCHAR(64) NOT NULL DEFAULT '',
+    postal_code VARCHAR(32) NOT NULL DEFAULT '',
+    country VARCHAR(2) NOT NULL,
+    phone VARCHAR(32) NOT NULL DEFAULT '',
+    PRIMARY KEY (order_id, kind)
+);
+
+CREATE TABLE shipments (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    carrier VARCHAR(64) NOT NULL,
+    tracking_number VARCHAR(128) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('shipped', 'delivered')),
+    shipped_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_shipments_order_id ON shipments (order_id);
+
+CREATE TABLE shipment_items (
+    shipment_id UUID NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (shipment_id, product_id)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE order_status_history
+    DROP COLUMN IF EXISTS return_status,
+    DROP COLUMN IF EXISTS return_id;
+DROP TABLE IF EXISTS return_items;
+DROP TABLE IF EXISTS returns;
\ No newline at end of file
@@ -0,0 +1,27 @@
+CREATE TABLE returns (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('requested', 'approved', 'received', 'refunded', 'rejected')),
+    refund_id UUID REFERENCES payments(id),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_returns_order_id ON returns (order_id);
+
+-- The condition is set when the return is received.
+CREATE TABLE return_items (
+    return_id UUID NOT NULL REFERENCES returns(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    reason TEXT NOT NULL DEFAULT '',
+    condition VARCHAR(20) CHECK (condition IN ('resellable', 'damaged')),
+    PRIMARY KEY (return_id, product_id)
+);
+
+-- Changes of a return are part of its order's history. They keep the
+-- order's status in from_status and to_status.
+ALTER TABLE order_status_history
+    ADD COLUMN return_id UUID,
+    ADD COLUMN return_status VARCHAR(20);
@@ -0,0 +1,18 @@
+-- One cart per user. Prices are not stored; they come from inventory when
+-- the cart is read.
+CREATE TABLE carts (
+    user_id UUID PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_carts_expires_at ON carts (expires_at);
+
+CREATE TABLE cart_items (
+    user_id UUID NOT NULL REFERENCES carts(user_id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, product_id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS subscription_runs;
+DROP TABLE IF EXISTS subscription_items;
+DROP TABLE IF EXISTS subscriptions;
\ No newline at end of file
@@ -0,0 +1,39 @@
+-- Exactly one of cron and interval_seconds is set. end_at is NULL for a
+-- subscription without an end date.
+CREATE TABLE subscriptions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    user_name VARCHAR(255) NOT NULL,
+    cron VARCHAR(100) NOT NULL DEFAULT '',
+    interval_seconds BIGINT NOT NULL DEFAULT 0,
+    stock_policy VARCHAR(20) NOT NULL CHECK (stock_policy IN ('skip', 'partial')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('active', 'paused', 'ended')),
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((cron = '') <> (interval_seconds = 0))
+);
+
+CREATE INDEX idx_subscriptions_user_id ON subscriptions (user_id);
+CREATE INDEX idx_subscriptions_due ON subscriptions (next_run_at) WHERE status = 'active';
+
+CREATE TABLE subscription_items (
+    subscription_id UUID NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (subscription_id, product_id)
+);
+
+-- One run per cycle; order_id is set when the cycle placed an order.
+CREATE TABLE subscription_runs (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    order_id UUID REFERENCES orders(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('ordered', 'partial', 'skipped', 'failed')),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (subscription_id, scheduled_at)
+);
@@ -0,0 +1,57 @@
+-- The last number issued in each year. Issuing an invoice bumps its year's
+-- row in the transaction that stores the invoice, which keeps the numbers
+-- gapless: the row stays locked until the invoice commits or rolls back.
+CREATE TABLE invoice_sequences (
+    year INTEGER PRIMARY KEY,
+    last_number BIGINT NOT NULL CHECK (last_number > 0)
+);
+
+-- An invoice is a copy of its order taken when it was issued. It has no
+-- foreign key to the order, so it outlives a deleted one. The billing
+-- columns are empty for an order without an address.
+CREATE TABLE invoices (
+    id UUID PRIMARY KEY,
+    number VARCHAR(32) NOT NULL UNIQUE,
+    year INTEGER NOT NULL,
+    sequence BIGINT NOT NULL,
+    order_id UUID NOT NULL UNIQUE,
+    user_id UUID NOT NULL,
+    user_name VARCHAR(255) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    subtotal NUMERIC(15,3) NOT NULL,
+    discount_total NUMERIC(15,3) NOT NULL,
+    tax_total NUMERIC(15,3) NOT NULL,
+    total NUMERIC(15,3) NOT NULL,
+    billing_name VARCHAR(255) NOT NULL DEFAULT '',
+    billing_line1 VARCHAR(255) NOT NULL DEFAULT '',
+    billing_line2 VARCHAR(255) NOT NULL DEFAULT '',
+    billing_city VARCHAR(255) NOT NULL DEFAULT '',
+    billing_region VARCHAR(64) NOT NULL DEFAULT '',
+    billing_postal_code VARCHAR(32) NOT NULL DEFAULT '',
+    billing_country VARCHAR(2) NOT NULL DEFAULT '',
+    billing_phone VARCHAR(32) NOT NULL DEFAULT '',
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (year, sequence)
+);
+
+CREATE TABLE invoice_lines (
+    invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    product_id UUID NOT NULL,
+    product_name VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(15,3) NOT NULL,
+    amount NUMERIC(15,3) NOT NULL,
+    tax_rate INTEGER NOT NULL DEFAULT 0,
+    tax_amount NUMERIC(15,3) NOT NULL DEFAULT 0,
+    tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (invoice_id, position)
+);
+
+CREATE TABLE invoice_discounts (
+    invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    code VARCHAR(64) NOT NULL,
+    amount NUMERIC(15,3) NOT NULL,
+    PRIMARY KEY (invoice_id, position)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE order_discounts
+    DROP COLUMN IF EXISTS min_order_value,
+    DROP COLUMN IF EXISTS amount_off;
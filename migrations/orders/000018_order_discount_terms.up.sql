@@ -0,0 +1,11 @@
+-- The coupon's terms are kept with each discount, so the discount can be
+-- worked out again when the order's items are edited. Coupons never change
+-- their terms, so existing discounts take them from their coupon.
+ALTER TABLE order_discounts
+    ADD COLUMN amount_off NUMERIC(15,3) NOT NULL DEFAULT 0 CHECK (amount_off >= 0),
+    ADD COLUMN min_order_value NUMERIC(15,3) NOT NULL DEFAULT 0 CHECK (min_order_value >= 0);
+
+UPDATE order_discounts d
+SET amount_off = c.amount_off, min_order_value = c.min_order_value
+FROM coupons c
+WHERE c.id = d.coupon_id;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS order_task_items;
+DROP TABLE IF EXISTS order_tasks;
@@ -0,0 +1,33 @@
+-- Work an order change leaves to be done elsewhere, such as returning the
+-- stock of a cancelled order. A task is stored in the transaction of its
+-- change and retried until it succeeds, so the work survives a crash.
+CREATE TABLE order_tasks (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    ref VARCHAR(64) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE order_task_items (
+    task_id VARCHAR(255) NOT NULL REFERENCES order_tasks(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (task_id, product_id)
+);
+
+CREATE INDEX idx_order_tasks_due ON order_tasks (run_at) WHERE status = 'pending';
+CREATE INDEX idx_order_tasks_order_id ON order_tasks (order_id);
+
+-- Orders cancelled before tasks existed may have lost their stock return or
+-- refund. Both are keyed so that running them again does no harm.
+INSERT INTO order_tasks (id, order_id, kind, run_at)
+SELECT kind || ':' || o.id, o.id, kind, NOW()
+FROM orders o
+CROSS JOIN (VALUES ('return_stock'), ('refund')) AS kinds (kind)
+WHERE o.status = 'cancelled';
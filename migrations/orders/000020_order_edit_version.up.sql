@@ -0,0 +1,3 @@
+-- Counts the item edits of an order, so the stock each edit gives back is
+-- released under its own key.
+ALTER TABLE orders ADD COLUMN edit_version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DELETE FROM order_tasks WHERE kind = 'issue_invoice';
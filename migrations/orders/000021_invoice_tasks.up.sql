@@ -0,0 +1,8 @@
+-- Completed orders whose invoice was lost before invoicing became a task
+-- get one now. Issuing is keyed by the order, so no order is invoiced twice.
+INSERT INTO order_tasks (id, order_id, kind, run_at)
+SELECT 'issue_invoice:' || o.id, o.id, 'issue_invoice', NOW()
+FROM orders o
+WHERE o.status = 'completed'
+  AND NOT EXISTS (SELECT 1 FROM invoices i WHERE i.order_id = o.id)
+ON CONFLICT (id) DO NOTHING;
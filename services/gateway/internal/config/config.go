@@ -112,7 +112,372 @@ func NewConfig() Config {
 					},
 				},
 			},
-			// Orders service configuration
+			{
+				Name:       "orders service",
+				GrpcAddr:   getEnv("ORDERS_GRPC_ADDR", "localhost:50052"),
+				ApiVersion: "v1",
+				Status:     "down",
+				Routes: []RouteConfig{
+					{
+						Method:      "GET",
+						Path:        "/orders",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListOrders",
+						RequestType: "ListOrdersRequest",
+						QueryParams: []string{
+							"Page", "PageSize", "SortBy",
+							"UserId", "Statuses", "CreatedFrom", "CreatedTo",
+							"MinTotal.MinorUnits", "MinTotal.CurrencyCode",
+							"MaxTotal.MinorUnits", "MaxTotal.CurrencyCode",
+							"ProductId",
+						},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetOrderByID",
+						RequestType: "GetOrderRequest",
+						PathParams:  []string{"Id"},
+						QueryParams: []string{"IncludeHistory"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/orders",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CreateOrder",
+						RequestType: "CreateOrderRequest",
+					},
+					{
+						Method:      "PUT",
+						Path:        "/orders/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "UpdateOrder",
+						RequestType: "UpdateOrderRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "DELETE",
+						Path:        "/orders/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "DeleteOrder",
+						RequestType: "DeleteOrderRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/orders/{id}/cancel",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CancelOrder",
+						RequestType: "CancelOrderRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{id}/history",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetOrderHistory",
+						RequestType: "GetOrderHistoryRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "PUT",
+						Path:        "/orders/{id}/items",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "EditOrderItems",
+						RequestType: "EditOrderItemsRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{id}/events",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "WatchOrder",
+						RequestType: "GetOrderRequest",
+						PathParams:  []string{"Id"},
+						QueryParams: []string{"ResumeToken"},
+						Stream:      true,
+					},
+					{
+						Method:      "POST",
+						Path:        "/orders/{orderid}/payments",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CreatePaymentIntent",
+						RequestType: "CreatePaymentIntentRequest",
+						PathParams:  []string{"OrderId"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{id}/payments",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListOrderPayments",
+						RequestType: "GetOrderRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/payments/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetPayment",
+						RequestType: "PaymentRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/payments/{id}/authorize",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "AuthorizePayment",
+						RequestType: "PaymentRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/payments/{id}/capture",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CapturePayment",
+						RequestType: "PaymentRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/orders/{orderid}/refunds",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "RefundOrder",
+						RequestType: "RefundOrderRequest",
+						PathParams:  []string{"OrderId"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{id}/shipments",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListOrderShipments",
+						RequestType: "GetOrderRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/orders/{orderid}/shipments",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CreateShipment",
+						RequestType: "CreateShipmentRequest",
+						PathParams:  []string{"OrderId"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/shipments/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetShipment",
+						RequestType: "ShipmentRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/shipments/{id}/delivery",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "MarkShipmentDelivered",
+						RequestType: "MarkShipmentDeliveredRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{id}/returns",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListOrderReturns",
+						RequestType: "GetOrderRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/orders/{orderid}/returns",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "RequestReturn",
+						RequestType: "RequestReturnRequest",
+						PathParams:  []string{"OrderId"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/returns/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetReturn",
+						RequestType: "ReturnRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/returns/{id}/approve",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ApproveReturn",
+						RequestType: "ReturnDecisionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/returns/{id}/reject",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "RejectReturn",
+						RequestType: "ReturnDecisionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/returns/{id}/receive",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ReceiveReturn",
+						RequestType: "ReceiveReturnRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/returns/{id}/refund",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "RefundReturn",
+						RequestType: "ReturnRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/carts/{userid}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetCart",
+						RequestType: "CartRequest",
+						PathParams:  []string{"UserId"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/carts/{userid}/items",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "AddCartItem",
+						RequestType: "CartItemRequest",
+						PathParams:  []string{"UserId"},
+					},
+					{
+						Method:      "PUT",
+						Path:        "/carts/{userid}/items",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "UpdateCartItem",
+						RequestType: "CartItemRequest",
+						PathParams:  []string{"UserId"},
+					},
+					{
+						Method:      "DELETE",
+						Path:        "/carts/{userid}/items/{productid}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "RemoveCartItem",
+						RequestType: "RemoveCartItemRequest",
+						PathParams:  []string{"UserId", "ProductId"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/carts/{userid}/checkout",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "Checkout",
+						RequestType: "CheckoutRequest",
+						PathParams:  []string{"UserId"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/subscriptions",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CreateSubscription",
+						RequestType: "CreateSubscriptionRequest",
+					},
+					{
+						Method:      "GET",
+						Path:        "/subscriptions",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListUserSubscriptions",
+						RequestType: "ListUserSubscriptionsRequest",
+						QueryParams: []string{"UserId"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/subscriptions/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetSubscription",
+						RequestType: "SubscriptionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/subscriptions/{id}/pause",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "PauseSubscription",
+						RequestType: "SubscriptionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/subscriptions/{id}/resume",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ResumeSubscription",
+						RequestType: "SubscriptionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/subscriptions/{id}/cancel",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CancelSubscription",
+						RequestType: "SubscriptionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/subscriptions/{id}/runs",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListSubscriptionRuns",
+						RequestType: "SubscriptionRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/invoices/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetInvoice",
+						RequestType: "GetInvoiceRequest",
+						PathParams:  []string{"Id"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/orders/{orderid}/invoice",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetInvoice",
+						RequestType: "GetInvoiceRequest",
+						PathParams:  []string{"OrderId"},
+					},
+					{
+						Method:      "POST",
+						Path:        "/discounts",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "CreateCoupon",
+						RequestType: "CreateCouponRequest",
+					},
+					{
+						Method:      "GET",
+						Path:        "/discounts",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "ListCoupons",
+						RequestType: "ListCouponsRequest",
+						QueryParams: []string{"Page", "PageSize"},
+					},
+					{
+						Method:      "GET",
+						Path:        "/discounts/{code}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "GetCoupon",
+						RequestType: "GetCouponRequest",
+						PathParams:  []string{"Code"},
+					},
+					{
+						Method:      "DELETE",
+						Path:        "/discounts/{id}",
+						GRPCService: "OrdersService",
+						GRPCMethod:  "DeactivateCoupon",
+						RequestType: "DeactivateCouponRequest",
+						PathParams:  []string{"Id"},
+					},
+				},
+			},
 		},
 		CorsURLs: getEnv("CORS_URLS", "*"),
 	}
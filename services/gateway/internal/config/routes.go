@@ -8,4 +8,6 @@ type RouteConfig struct {
 	RequestType string   `yaml:"request_type"`
 	PathParams  []string `yaml:"path_params"`
 	QueryParams []string `yaml:"query_params"`
+	// Stream marks a server-streaming method, relayed as Server-Sent Events.
+	Stream bool `yaml:"stream"`
 }
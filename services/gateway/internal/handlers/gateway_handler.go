@@ -3,17 +3,18 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"reflect"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -21,9 +22,20 @@ import (
 	"github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/clients"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/config"
 	pb "github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/proto"
+	orderspb "github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/proto/orders"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/utils"
 )
 
+// IdempotencyKeyHeader is forwarded to the services as idempotency-key
+// metadata, so a retried request is applied only once.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// apiActor is sent as the actor of every request the gateway forwards. The
+// gateway does not authenticate its clients, so it never forwards an actor a
+// client names; the services record changes made through it as made by the
+// public API.
+const apiActor = "api"
+
 type GatewayHandler struct {
 	service     *config.Service
 	clientPool  *clients.GrpcClientPool
@@ -52,6 +64,42 @@ func NewGatewayHandler(service *config.Service, pool *clients.GrpcClientPool) *G
 	parser.RequestTypeRegistry["DeleteCategoryRequest"] = &pb.DeleteCategoryRequest{}
 	parser.RequestTypeRegistry["ListCategoriesRequest"] = &pb.ListCategoriesRequest{}
 
+	// Register orders service proto types
+	parser.RequestTypeRegistry["CreateOrderRequest"] = &orderspb.CreateOrderRequest{}
+	parser.RequestTypeRegistry["GetOrderRequest"] = &orderspb.GetOrderRequest{}
+	parser.RequestTypeRegistry["UpdateOrderRequest"] = &orderspb.UpdateOrderRequest{}
+	parser.RequestTypeRegistry["DeleteOrderRequest"] = &orderspb.DeleteOrderRequest{}
+	parser.RequestTypeRegistry["CancelOrderRequest"] = &orderspb.CancelOrderRequest{}
+	parser.RequestTypeRegistry["ListOrdersRequest"] = &orderspb.ListOrdersRequest{}
+	parser.RequestTypeRegistry["GetOrderHistoryRequest"] = &orderspb.GetOrderHistoryRequest{}
+	parser.RequestTypeRegistry["EditOrderItemsRequest"] = &orderspb.EditOrderItemsRequest{}
+
+	parser.RequestTypeRegistry["CreatePaymentIntentRequest"] = &orderspb.CreatePaymentIntentRequest{}
+	parser.RequestTypeRegistry["PaymentRequest"] = &orderspb.PaymentRequest{}
+	parser.RequestTypeRegistry["RefundOrderRequest"] = &orderspb.RefundOrderRequest{}
+
+	parser.RequestTypeRegistry["CreateShipmentRequest"] = &orderspb.CreateShipmentRequest{}
+	parser.RequestTypeRegistry["ShipmentRequest"] = &orderspb.ShipmentRequest{}
+	parser.RequestTypeRegistry["MarkShipmentDeliveredRequest"] = &orderspb.MarkShipmentDeliveredRequest{}
+
+	parser.RequestTypeRegistry["RequestReturnRequest"] = &orderspb.RequestReturnRequest{}
+	parser.RequestTypeRegistry["ReturnRequest"] = &orderspb.ReturnRequest{}
+	parser.RequestTypeRegistry["ReturnDecisionRequest"] = &orderspb.ReturnDecisionRequest{}
+	parser.RequestTypeRegistry["ReceiveReturnRequest"] = &orderspb.ReceiveReturnRequest{}
+	parser.RequestTypeRegistry["CartRequest"] = &orderspb.CartRequest{}
+	parser.RequestTypeRegistry["CartItemRequest"] = &orderspb.CartItemRequest{}
+	parser.RequestTypeRegistry["RemoveCartItemRequest"] = &orderspb.RemoveCartItemRequest{}
+	parser.RequestTypeRegistry["CheckoutRequest"] = &orderspb.CheckoutRequest{}
+	parser.RequestTypeRegistry["CreateSubscriptionRequest"] = &orderspb.CreateSubscriptionRequest{}
+	parser.RequestTypeRegistry["SubscriptionRequest"] = &orderspb.SubscriptionRequest{}
+	parser.RequestTypeRegistry["ListUserSubscriptionsRequest"] = &orderspb.ListUserSubscriptionsRequest{}
+	parser.RequestTypeRegistry["GetInvoiceRequest"] = &orderspb.GetInvoiceRequest{}
+
+	parser.RequestTypeRegistry["CreateCouponRequest"] = &orderspb.CreateCouponRequest{}
+	parser.RequestTypeRegistry["GetCouponRequest"] = &orderspb.GetCouponRequest{}
+	parser.RequestTypeRegistry["ListCouponsRequest"] = &orderspb.ListCouponsRequest{}
+	parser.RequestTypeRegistry["DeactivateCouponRequest"] = &orderspb.DeactivateCouponRequest{}
+
 	return &GatewayHandler{
 		service:     service,
 		clientPool:  pool,
@@ -64,7 +112,11 @@ func (h *GatewayHandler) RegisterRoutes(router *mux.Router) {
 	sub := router.PathPrefix(basePath).Subrouter()
 
 	for _, route := range h.service.Routes {
-		sub.HandleFunc(route.Path, h.universalHandler(route)).
+		handler := h.universalHandler(route)
+		if route.Stream {
+			handler = h.streamHandler(route)
+		}
+		sub.HandleFunc(route.Path, handler).
 			Methods(route.Method)
 	}
 }
@@ -88,6 +140,11 @@ func (h *GatewayHandler) universalHandler(route config.RouteConfig) http.Handler
 			return
 		}
 
+		if key := r.Header.Get(IdempotencyKeyHeader); key != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "idempotency-key", key)
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "actor", apiActor)
+
 		// Invoke gRPC method
 		resp, err := h.invokeGRPCMethod(ctx, conn, route, req)
 		if err != nil {
@@ -129,14 +186,7 @@ func (h *GatewayHandler) createGRPCRequest(r *http.Request, route config.RouteCo
 			continue
 		}
 
-		field := reflect.ValueOf(req).Elem().FieldByName(param)
-		if !field.IsValid() || !field.CanSet() {
-			continue
-		}
-
-		strValue := values[0]
-		err := h.setFieldValue(field, strValue)
-		if err != nil {
+		if err := setQueryParam(req.ProtoReflect(), param, values); err != nil {
 			return nil, fmt.Errorf("invalid parameter %s: %w", param, err)
 		}
 	}
@@ -156,44 +206,13 @@ func (h *GatewayHandler) createGRPCRequest(r *http.Request, route config.RouteCo
 	return req, nil
 }
 
-func (h *GatewayHandler) setFieldValue(field reflect.Value, strValue string) error {
-	switch field.Kind() {
-	case reflect.String:
-		field.SetString(strValue)
-	case reflect.Int32, reflect.Int64:
-		intVal, err := strconv.ParseInt(strValue, 10, 64)
-		if err != nil {
-			return err
-		}
-		field.SetInt(intVal)
-	case reflect.Uint32, reflect.Uint64:
-		uintVal, err := strconv.ParseUint(strValue, 10, 64)
-		if err != nil {
-			return err
-		}
-		field.SetUint(uintVal)
-	case reflect.Float32, reflect.Float64:
-		floatVal, err := strconv.ParseFloat(strValue, 64)
-		if err != nil {
-			return err
-		}
-		field.SetFloat(floatVal)
-	case reflect.Bool:
-		boolVal, err := strconv.ParseBool(strValue)
-		if err != nil {
-			return err
-		}
-		field.SetBool(boolVal)
-	default:
-		return fmt.Errorf("unsupported field type: %s", field.Kind())
-	}
-	return nil
-}
-
 var clientConstructors = map[string]func(*grpc.ClientConn) interface{}{
 	"InventoryService": func(cc *grpc.ClientConn) interface{} {
 		return pb.NewInventoryServiceClient(cc)
 	},
+	"OrdersService": func(cc *grpc.ClientConn) interface{} {
+		return orderspb.NewOrdersServiceClient(cc)
+	},
 }
 
 func (h *GatewayHandler) invokeGRPCMethod(
@@ -245,17 +264,19 @@ func (h *GatewayHandler) handleGRPCError(w http.ResponseWriter, err error) {
 
 	switch st.Code() {
 	case codes.NotFound:
-		utils.WriteError(w, http.StatusNotFound, fmt.Errorf(st.Message()))
+		utils.WriteError(w, http.StatusNotFound, errors.New(st.Message()))
 	case codes.InvalidArgument:
-		utils.WriteError(w, http.StatusBadRequest, fmt.Errorf(st.Message()))
+		utils.WriteError(w, http.StatusBadRequest, errors.New(st.Message()))
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		utils.WriteError(w, http.StatusConflict, errors.New(st.Message()))
 	case codes.PermissionDenied:
-		utils.WriteError(w, http.StatusForbidden, fmt.Errorf(st.Message()))
+		utils.WriteError(w, http.StatusForbidden, errors.New(st.Message()))
 	case codes.Unauthenticated:
-		utils.WriteError(w, http.StatusUnauthorized, fmt.Errorf(st.Message()))
+		utils.WriteError(w, http.StatusUnauthorized, errors.New(st.Message()))
 	case codes.DeadlineExceeded:
 		utils.WriteError(w, http.StatusGatewayTimeout, fmt.Errorf("request timed out"))
 	case codes.ResourceExhausted:
-		utils.WriteError(w, http.StatusTooManyRequests, fmt.Errorf(st.Message()))
+		utils.WriteError(w, http.StatusTooManyRequests, errors.New(st.Message()))
 	default:
 		utils.WriteError(w, http.StatusInternalServerError, fmt.Errorf("internal server error"))
 	}
@@ -29,6 +29,12 @@ func NewTimeoutContextMW(timeoutInSec int) func(next http.Handler) http.Handler
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(
 			func(w http.ResponseWriter, r *http.Request) {
+				// Event streams stay open for as long as the client listens.
+				if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+					next.ServeHTTP(w, r)
+					return
+				}
+
 				ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(timeoutInSec))
 				defer cancel()
 
@@ -76,7 +82,7 @@ func NewCORS(CORS_URLS string) func(next http.Handler) http.Handler {
 				if allowOrigin != "" {
 					w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
 					w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-					w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Cookie")
+					w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Cookie, Idempotency-Key, Last-Event-ID")
 					w.Header().Set("Access-Control-Allow-Credentials", "true")
 				}
 
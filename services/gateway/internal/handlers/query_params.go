@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// setQueryParam sets the field of msg named by path, such as "PageSize" or
+// "MinTotal.MinorUnits", from the values of one query parameter. Path
+// segments match a field's Go, proto or JSON name. A repeated field takes
+// every value, and each value may list several elements separated by commas.
+func setQueryParam(msg protoreflect.Message, path string, values []string) error {
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		fd := findField(msg.Descriptor(), segment)
+		if fd == nil {
+			return fmt.Errorf("unknown field %q", segment)
+		}
+
+		if i < len(segments)-1 {
+			if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("field %q has no subfields", segment)
+			}
+			msg = msg.Mutable(fd).Message()
+			continue
+		}
+
+		if fd.IsMap() {
+			return fmt.Errorf("unsupported map field %q", segment)
+		}
+		if !fd.IsList() {
+			v, err := parseFieldValue(fd, values[0])
+			if err != nil {
+				return err
+			}
+			msg.Set(fd, v)
+			return nil
+		}
+
+		list := msg.Mutable(fd).List()
+		for _, value := range values {
+			for _, elem := range strings.Split(value, ",") {
+				elem = strings.TrimSpace(elem)
+				if elem == "" {
+					continue
+				}
+				v, err := parseFieldValue(fd, elem)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+		}
+	}
+	return nil
+}
+
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if normalizeFieldName(string(fd.Name())) == normalizeFieldName(name) {
+			return fd
+		}
+	}
+	return nil
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func parseFieldValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.EnumKind:
+		return parseEnumValue(fd.Enum(), s)
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() == "google.protobuf.Timestamp" {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field type: %s", fd.Kind())
+}
+
+// parseEnumValue accepts an enum value's full name, the name without its
+// type prefix ("pending" for ORDER_STATUS_PENDING) or its number.
+func parseEnumValue(ed protoreflect.EnumDescriptor, s string) (protoreflect.Value, error) {
+	values := ed.Values()
+	name := strings.ToUpper(s)
+	for i := 0; i < values.Len(); i++ {
+		ev := values.Get(i)
+		if full := string(ev.Name()); full == name || strings.HasSuffix(full, "_"+name) {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if ev := values.ByNumber(protoreflect.EnumNumber(n)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("unknown %s value %q", ed.Name(), s)
+}
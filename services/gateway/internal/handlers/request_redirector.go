@@ -44,12 +44,6 @@ func handleInventory(addPrefix func(method, path string) string, svc *config.Ser
 
 	// mux.HandleFunc(addPrefix("DELETE", "/inventory/{id}"), middleware.ReverseProxyHandler(svc, svc.URLBase))
 	// mux.HandleFunc(addPrefix("DELETE", "/inventory/{id}/"), middleware.ReverseProxyHandler(svc, svc.URLBase))
-
-	// mux.HandleFunc(addPrefix("POST", "/discounts"), middleware.ReverseProxyHandler(svc, svc.URLBase))
-	// mux.HandleFunc(addPrefix("DELETE", "/discounts/"), middleware.ReverseProxyHandler(svc, svc.URLBase))
-
-	// mux.HandleFunc(addPrefix("DELETE", "/discounts/{id}"), middleware.ReverseProxyHandler(svc, svc.URLBase))
-	// mux.HandleFunc(addPrefix("DELETE", "/discounts/{id}/"), middleware.ReverseProxyHandler(svc, svc.URLBase))
 }
 
 func handleOrders(addPrefix func(method, path string) string, svc *config.Service, mux *http.ServeMux) {
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/config"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/gateway/internal/utils"
+)
+
+// LastEventIDHeader is sent by a reconnecting EventSource with the id of the
+// last event it received. It is passed on as the request's resume_token.
+const LastEventIDHeader = "Last-Event-ID"
+
+// streamKeepAlive is how often an idle event stream gets a comment line, so
+// proxies do not close it.
+const streamKeepAlive = 15 * time.Second
+
+var streamMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+type streamMessage struct {
+	msg proto.Message
+	err error
+}
+
+// streamHandler relays a server-streaming method as Server-Sent Events. Each
+// message becomes one event whose data is the message as JSON and whose id is
+// its resume_token. A failed stream ends with an "error" event.
+func (h *GatewayHandler) streamHandler(route config.RouteConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			utils.WriteError(w, http.StatusInternalServerError, errors.New("streaming is not supported"))
+			return
+		}
+
+		conn, err := h.clientPool.GetConn()
+		if err != nil {
+			utils.WriteError(w, http.StatusBadGateway, err)
+			return
+		}
+
+		req, err := h.createGRPCRequest(r, route)
+		if err != nil {
+			utils.WriteError(w, http.StatusBadRequest, err)
+			return
+		}
+		if lastEventID := r.Header.Get(LastEventIDHeader); lastEventID != "" {
+			field := reflect.ValueOf(req).Elem().FieldByName("ResumeToken")
+			if field.IsValid() && field.CanSet() && field.Kind() == reflect.String {
+				field.SetString(lastEventID)
+			}
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		recv, err := h.openGRPCStream(ctx, conn, route, req)
+		if err != nil {
+			h.handleGRPCError(w, err)
+			return
+		}
+
+		messages := make(chan streamMessage)
+		go func() {
+			defer close(messages)
+			for {
+				msg, err := recv()
+				select {
+				case messages <- streamMessage{msg: msg, err: err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+				flusher.Flush()
+			case m, ok := <-messages:
+				if !ok || errors.Is(m.err, io.EOF) {
+					return
+				}
+				if m.err != nil {
+					writeStreamError(w, m.err)
+					flusher.Flush()
+					return
+				}
+				if err := writeStreamEvent(w, m.msg); err != nil {
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// openGRPCStream starts a server-streaming method and returns a function that
+// receives its next message.
+func (h *GatewayHandler) openGRPCStream(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	route config.RouteConfig,
+	req proto.Message,
+) (func() (proto.Message, error), error) {
+	constructor, ok := clientConstructors[route.GRPCService]
+	if !ok {
+		return nil, fmt.Errorf("service %s not registered", route.GRPCService)
+	}
+
+	client := constructor(conn)
+
+	method := reflect.ValueOf(client).MethodByName(route.GRPCMethod)
+	if !method.IsValid() {
+		return nil, fmt.Errorf("method %s not found in service %s",
+			route.GRPCMethod,
+			route.GRPCService)
+	}
+
+	results := method.Call([]reflect.Value{
+		reflect.ValueOf(ctx),
+		reflect.ValueOf(req),
+	})
+	if len(results) != 2 {
+		return nil, fmt.Errorf("invalid method signature for %s.%s",
+			route.GRPCService,
+			route.GRPCMethod)
+	}
+	if err := results[1].Interface(); err != nil {
+		return nil, err.(error)
+	}
+
+	recvMethod := results[0].MethodByName("Recv")
+	if !recvMethod.IsValid() {
+		return nil, fmt.Errorf("%s.%s is not a streaming method",
+			route.GRPCService,
+			route.GRPCMethod)
+	}
+
+	return func() (proto.Message, error) {
+		out := recvMethod.Call(nil)
+		if err := out[1].Interface(); err != nil {
+			return nil, err.(error)
+		}
+		return out[0].Interface().(proto.Message), nil
+	}, nil
+}
+
+func writeStreamEvent(w io.Writer, msg proto.Message) error {
+	data, err := streamMarshaler.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	fields := msg.ProtoReflect()
+	if fd := fields.Descriptor().Fields().ByName("resume_token"); fd != nil {
+		if id := fields.Get(fd).String(); id != "" {
+			if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+				return err
+			}
+		}
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+func writeStreamError(w io.Writer, err error) {
+	st, _ := status.FromError(err)
+	data, _ := protojson.Marshal(st.Proto())
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
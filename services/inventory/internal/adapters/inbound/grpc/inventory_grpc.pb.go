@@ -19,17 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_CreateProduct_FullMethodName   = "/inventory.InventoryService/CreateProduct"
-	InventoryService_GetProductByID_FullMethodName  = "/inventory.InventoryService/GetProductByID"
-	InventoryService_UpdateProduct_FullMethodName   = "/inventory.InventoryService/UpdateProduct"
-	InventoryService_DeleteProduct_FullMethodName   = "/inventory.InventoryService/DeleteProduct"
-	InventoryService_ListProducts_FullMethodName    = "/inventory.InventoryService/ListProducts"
-	InventoryService_CreateCategory_FullMethodName  = "/inventory.InventoryService/CreateCategory"
-	InventoryService_GetCategoryByID_FullMethodName = "/inventory.InventoryService/GetCategoryByID"
-	InventoryService_UpdateCategory_FullMethodName  = "/inventory.InventoryService/UpdateCategory"
-	InventoryService_DeleteCategory_FullMethodName  = "/inventory.InventoryService/DeleteCategory"
-	InventoryService_ListCategories_FullMethodName  = "/inventory.InventoryService/ListCategories"
-	InventoryService_ReserveProducts_FullMethodName = "/inventory.InventoryService/ReserveProducts"
+	InventoryService_CreateProduct_FullMethodName        = "/inventory.InventoryService/CreateProduct"
+	InventoryService_GetProductByID_FullMethodName       = "/inventory.InventoryService/GetProductByID"
+	InventoryService_UpdateProduct_FullMethodName        = "/inventory.InventoryService/UpdateProduct"
+	InventoryService_DeleteProduct_FullMethodName        = "/inventory.InventoryService/DeleteProduct"
+	InventoryService_ListProducts_FullMethodName         = "/inventory.InventoryService/ListProducts"
+	InventoryService_CreateCategory_FullMethodName       = "/inventory.InventoryService/CreateCategory"
+	InventoryService_GetCategoryByID_FullMethodName      = "/inventory.InventoryService/GetCategoryByID"
+	InventoryService_UpdateCategory_FullMethodName       = "/inventory.InventoryService/UpdateCategory"
+	InventoryService_DeleteCategory_FullMethodName       = "/inventory.InventoryService/DeleteCategory"
+	InventoryService_ListCategories_FullMethodName       = "/inventory.InventoryService/ListCategories"
+	InventoryService_ReserveProducts_FullMethodName      = "/inventory.InventoryService/ReserveProducts"
+	InventoryService_ReleaseProducts_FullMethodName      = "/inventory.InventoryService/ReleaseProducts"
+	InventoryService_BatchReserveProducts_FullMethodName = "/inventory.InventoryService/BatchReserveProducts"
+	InventoryService_ConfirmReservation_FullMethodName   = "/inventory.InventoryService/ConfirmReservation"
+	InventoryService_ReleaseReservation_FullMethodName   = "/inventory.InventoryService/ReleaseReservation"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -47,6 +51,10 @@ type InventoryServiceClient interface {
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	ReserveProducts(ctx context.Context, in *ReserveProductRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReleaseProducts(ctx context.Context, in *ReleaseProductRequest, opts ...grpc.CallOption) (*Empty, error)
+	BatchReserveProducts(ctx context.Context, in *BatchReserveProductsRequest, opts ...grpc.CallOption) (*BatchReserveProductsResponse, error)
+	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type inventoryServiceClient struct {
@@ -167,6 +175,46 @@ func (c *inventoryServiceClient) ReserveProducts(ctx context.Context, in *Reserv
 	return out, nil
 }
 
+func (c *inventoryServiceClient) ReleaseProducts(ctx context.Context, in *ReleaseProductRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) BatchReserveProducts(ctx context.Context, in *BatchReserveProductsRequest, opts ...grpc.CallOption) (*BatchReserveProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchReserveProductsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchReserveProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, InventoryService_ConfirmReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -182,6 +230,10 @@ type InventoryServiceServer interface {
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*CategoryResponse, error)
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
 	ReserveProducts(context.Context, *ReserveProductRequest) (*Empty, error)
+	ReleaseProducts(context.Context, *ReleaseProductRequest) (*Empty, error)
+	BatchReserveProducts(context.Context, *BatchReserveProductsRequest) (*BatchReserveProductsResponse, error)
+	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*Empty, error)
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*Empty, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -225,6 +277,18 @@ func (UnimplementedInventoryServiceServer) ListCategories(context.Context, *List
 func (UnimplementedInventoryServiceServer) ReserveProducts(context.Context, *ReserveProductRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveProducts not implemented")
 }
+func (UnimplementedInventoryServiceServer) ReleaseProducts(context.Context, *ReleaseProductRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseProducts not implemented")
+}
+func (UnimplementedInventoryServiceServer) BatchReserveProducts(context.Context, *BatchReserveProductsRequest) (*BatchReserveProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReserveProducts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -444,6 +508,78 @@ func _InventoryService_ReserveProducts_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_ReleaseProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseProducts(ctx, req.(*ReleaseProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BatchReserveProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReserveProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchReserveProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchReserveProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchReserveProducts(ctx, req.(*BatchReserveProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ConfirmReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -495,6 +631,22 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReserveProducts",
 			Handler:    _InventoryService_ReserveProducts_Handler,
 		},
+		{
+			MethodName: "ReleaseProducts",
+			Handler:    _InventoryService_ReleaseProducts_Handler,
+		},
+		{
+			MethodName: "BatchReserveProducts",
+			Handler:    _InventoryService_BatchReserveProducts_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _InventoryService_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _InventoryService_ReleaseReservation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory.proto",
@@ -16,6 +16,7 @@ import (
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/utils"
+	"github.com/ExonegeS/money"
 )
 
 type InventoryServer struct {
@@ -31,13 +32,13 @@ func NewInventoryServer(service application.InventoryService, logger *slog.Logge
 	}
 }
 
-func StartGRPCServer(grpcPort string, invService application.InventoryService, logger *slog.Logger) error {
+func StartGRPCServer(grpcPort string, invService application.InventoryService, idempotencyService application.IdempotencyService, logger *slog.Logger) error {
 	lis, err := net.Listen("tcp", ":"+grpcPort)
 	if err != nil {
 		return fmt.Errorf("failed to listen on port %s: %w", grpcPort, err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(NewIdempotencyInterceptor(idempotencyService, logger)))
 	invServer := NewInventoryServer(invService, logger)
 	RegisterInventoryServiceServer(grpcServer, invServer)
 	reflection.Register(grpcServer)
@@ -65,23 +66,7 @@ func (s *InventoryServer) GetProductByID(ctx context.Context, req *GetProductReq
 		return nil, status.Error(codes.Internal, "failed to retrieve product")
 	}
 
-	respProduct := &Product{
-		Id:          product.ID.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Category: &Category{
-			Id:          string(product.Category.ID),
-			Name:        product.Category.Name,
-			Description: product.Category.Description,
-			CreatedAt:   timestamppb.New(product.Category.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.Category.UpdatedAt),
-		},
-		Price:     product.Price,
-		Quantity:  product.Quantity,
-		Unit:      product.Unit,
-		CreatedAt: timestamppb.New(product.CreatedAt),
-		UpdatedAt: timestamppb.New(product.UpdatedAt),
-	}
+	respProduct := convertDomainProductToPB(product)
 
 	return &ProductResponse{Product: respProduct}, nil
 }
@@ -99,7 +84,7 @@ func (s *InventoryServer) CreateProduct(ctx context.Context, req *CreateProductR
 		Category: entity.Category{
 			ID: entity.UUID(req.CategoryId),
 		},
-		Price:    req.Price,
+		Price:    priceFromPB(req.GetPriceMoney(), req.GetPrice()),
 		Quantity: req.Quantity,
 		Unit:     req.Unit,
 	}
@@ -113,23 +98,7 @@ func (s *InventoryServer) CreateProduct(ctx context.Context, req *CreateProductR
 		return nil, status.Error(codes.Internal, "failed to create product")
 	}
 
-	respProduct := &Product{
-		Id:          product.ID.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Category: &Category{
-			Id:          string(product.Category.ID),
-			Name:        product.Category.Name,
-			Description: product.Category.Description,
-			CreatedAt:   timestamppb.New(product.Category.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.Category.UpdatedAt),
-		},
-		Price:     product.Price,
-		Quantity:  product.Quantity,
-		Unit:      product.Unit,
-		CreatedAt: timestamppb.New(product.CreatedAt),
-		UpdatedAt: timestamppb.New(product.UpdatedAt),
-	}
+	respProduct := convertDomainProductToPB(&product)
 
 	return &ProductResponse{
 		Product: respProduct,
@@ -138,7 +107,7 @@ func (s *InventoryServer) CreateProduct(ctx context.Context, req *CreateProductR
 
 func ValidateUpdateProductRequest(req *UpdateProductRequest) error {
 	if req.Name == nil && req.Description == nil && req.CategoryId == nil &&
-		req.Price == nil && req.Quantity == nil && req.Unit == nil {
+		req.Price == nil && req.PriceMoney == nil && req.Quantity == nil && req.Unit == nil {
 		return status.Error(codes.InvalidArgument, "at least one field must be provided")
 	}
 	if req.Name != nil && len(*req.Name) == 0 {
@@ -152,8 +121,10 @@ func ValidateUpdateProductRequest(req *UpdateProductRequest) error {
 			return status.Error(codes.InvalidArgument, "invalid category ID format")
 		}
 	}
-	if req.Price != nil && *req.Price <= 0 {
-		return status.Error(codes.InvalidArgument, "price must be greater than zero")
+	if req.Price != nil || req.PriceMoney != nil {
+		if err := validatePrice(priceFromPB(req.GetPriceMoney(), req.GetPrice())); err != nil {
+			return err
+		}
 	}
 	if req.Quantity != nil && *req.Quantity <= 0 {
 		return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
@@ -182,37 +153,28 @@ func (s *InventoryServer) UpdateProduct(ctx context.Context, req *UpdateProductR
 		Name:        req.Name,
 		Description: req.Description,
 		CategoryID:  (*entity.UUID)(req.CategoryId),
-		Price:       req.Price,
 		Quantity:    req.Quantity,
 		Unit:        req.Unit,
 	}
 
+	if req.Price != nil || req.PriceMoney != nil {
+		price := priceFromPB(req.GetPriceMoney(), req.GetPrice())
+		params.Price = &price
+	}
+
 	product, err := s.service.UpdateInventoryItem(ctx, id, params)
 	if err != nil {
 		if errors.Is(err, entity.ErrItemNotFound) {
 			return nil, status.Error(codes.NotFound, "product not found")
 		}
+		if errors.Is(err, entity.ErrInvalidQuantity) {
+			return nil, status.Error(codes.InvalidArgument, "quantity cannot be lower than reserved stock")
+		}
 		s.logger.Error("Failed to update product", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update product")
 	}
 
-	respProduct := &Product{
-		Id:          id.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Category: &Category{
-			Id:          string(product.Category.ID),
-			Name:        product.Category.Name,
-			Description: product.Category.Description,
-			CreatedAt:   timestamppb.New(product.Category.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.Category.UpdatedAt),
-		},
-		Price:     product.Price,
-		Quantity:  product.Quantity,
-		Unit:      product.Unit,
-		CreatedAt: timestamppb.New(product.CreatedAt),
-		UpdatedAt: timestamppb.New(product.UpdatedAt),
-	}
+	respProduct := convertDomainProductToPB(product)
 
 	return &ProductResponse{Product: respProduct}, nil
 }
@@ -235,23 +197,7 @@ func (s *InventoryServer) DeleteProduct(ctx context.Context, req *DeleteProductR
 		return nil, status.Error(codes.Internal, "failed to delete product")
 	}
 
-	respProduct := &Product{
-		Id:          id.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Category: &Category{
-			Id:          string(product.Category.ID),
-			Name:        product.Category.Name,
-			Description: product.Category.Description,
-			CreatedAt:   timestamppb.New(product.Category.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.Category.UpdatedAt),
-		},
-		Price:     product.Price,
-		Quantity:  product.Quantity,
-		Unit:      product.Unit,
-		CreatedAt: timestamppb.New(product.CreatedAt),
-		UpdatedAt: timestamppb.New(product.UpdatedAt),
-	}
+	respProduct := convertDomainProductToPB(product)
 
 	return &ProductResponse{Product: respProduct}, nil
 }
@@ -283,24 +229,7 @@ func (s *InventoryServer) ListProducts(ctx context.Context, req *ListProductsReq
 
 	grpcData := make([]*Product, 0)
 	for _, product := range paginatedData.Data {
-		grpcProduct := Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Category: &Category{
-				Id:          string(product.Category.ID),
-				Name:        product.Category.Name,
-				Description: product.Category.Description,
-				CreatedAt:   timestamppb.New(product.Category.CreatedAt),
-				UpdatedAt:   timestamppb.New(product.Category.UpdatedAt),
-			},
-			Price:     product.Price,
-			Quantity:  product.Quantity,
-			Unit:      product.Unit,
-			CreatedAt: timestamppb.New(product.CreatedAt),
-			UpdatedAt: timestamppb.New(product.UpdatedAt),
-		}
-		grpcData = append(grpcData, &grpcProduct)
+		grpcData = append(grpcData, convertDomainProductToPB(product))
 	}
 
 	resp := ListProductsResponse{
@@ -325,6 +254,7 @@ func (s *InventoryServer) CreateCategory(ctx context.Context, req *CreateCategor
 	category := entity.Category{
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
+		TaxClass:    req.GetTaxClass(),
 	}
 
 	err := s.service.CreateCategory(ctx, &category)
@@ -378,6 +308,7 @@ func (s *InventoryServer) UpdateCategory(ctx context.Context, req *UpdateCategor
 	updateParams := application.UpdateCategoryParams{
 		Name:        req.Name,
 		Description: req.Description,
+		TaxClass:    req.TaxClass,
 	}
 
 	updatedCategory, err := s.service.UpdateCategory(ctx, entity.UUID(categoryID), updateParams)
@@ -469,11 +400,150 @@ func (s *InventoryServer) ReserveProducts(ctx context.Context, req *ReserveProdu
 		if errors.Is(err, entity.ErrItemNotFound) {
 			return nil, status.Error(codes.NotFound, "item not found")
 		}
-		s.logger.Error("Failed to UpdateCategory", "error", err.Error())
+		if errors.Is(err, entity.ErrInsufficientQuantity) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient item quantity in storage")
+		}
+		s.logger.Error("Failed to ReserveProduct", "error", err.Error())
 		return nil, status.Error(codes.Internal, "failed to reserve item")
 	}
 
-	return nil, nil
+	return &Empty{}, nil
+}
+
+func (s *InventoryServer) ReleaseProducts(ctx context.Context, req *ReleaseProductRequest) (*Empty, error) {
+	s.logger.Info("Received ReleaseProducts gRPC request", "release_id", req.GetReleaseId(), "order_id", req.GetOrderId())
+
+	if err := ValidateReleaseProductRequest(req); err != nil {
+		s.logger.Error("Failed to ValidateReleaseProductRequest", "error", err.Error())
+		return nil, err
+	}
+
+	release := entity.StockRelease{
+		ID:      req.GetReleaseId(),
+		OrderID: entity.UUID(req.GetOrderId()),
+		Lines:   make([]entity.ReservationLine, 0, len(req.GetItems())),
+	}
+	for _, item := range req.GetItems() {
+		release.Lines = append(release.Lines, entity.ReservationLine{
+			OrderID:   release.OrderID,
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+		})
+	}
+
+	err := s.service.ReleaseProducts(ctx, release)
+	if err != nil {
+		if errors.Is(err, entity.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, entity.ErrReleaseExceedsTaken) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to ReleaseProducts", "error", err.Error())
+		return nil, status.Error(codes.Internal, "failed to release items")
+	}
+
+	return &Empty{}, nil
+}
+
+func (s *InventoryServer) BatchReserveProducts(ctx context.Context, req *BatchReserveProductsRequest) (*BatchReserveProductsResponse, error) {
+	s.logger.Info("Received BatchReserveProducts gRPC request", "lines", len(req.GetItems()))
+
+	if err := ValidateBatchReserveProductsRequest(req); err != nil {
+		s.logger.Error("Failed to ValidateBatchReserveProductsRequest", "error", err.Error())
+		return nil, err
+	}
+
+	lines := make([]entity.ReservationLine, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		line := entity.ReservationLine{
+			OrderID:   entity.UUID(item.GetOrderId()),
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+		}
+		if expected := item.GetExpectedPrice(); expected != nil {
+			price := money.New(expected.GetMinorUnits(), expected.GetCurrencyCode())
+			line.ExpectedPrice = &price
+		}
+		lines = append(lines, line)
+	}
+
+	results, err := s.service.ReserveProducts(ctx, lines)
+	if err != nil {
+		if errors.Is(err, entity.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		var mismatch *entity.PriceMismatchError
+		if errors.As(err, &mismatch) {
+			return nil, priceMismatchStatus(mismatch)
+		}
+		if errors.Is(err, entity.ErrInsufficientQuantity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to ReserveProducts", "error", err.Error())
+		return nil, status.Error(codes.Internal, "failed to reserve items")
+	}
+
+	resp := &BatchReserveProductsResponse{
+		Results: make([]*ReservationResult, 0, len(results)),
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, &ReservationResult{
+			ProductId:         result.ProductID.String(),
+			Quantity:          int32(result.Quantity),
+			RemainingQuantity: result.Remaining,
+			ReservationId:     result.ReservationID.String(),
+			OrderId:           result.OrderID.String(),
+			ExpiresAt:         timestamppb.New(result.ExpiresAt),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *InventoryServer) ConfirmReservation(ctx context.Context, req *ConfirmReservationRequest) (*Empty, error) {
+	s.logger.Info("Received ConfirmReservation gRPC request", "order_id", req.GetOrderId())
+
+	orderID, err := utils.ParseUUID(req.GetOrderId())
+	if err != nil {
+		s.logger.Error("Failed to parse order id", "error", err.Error())
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	err = s.service.ConfirmReservation(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, entity.ErrReservationNotFound) {
+			return nil, status.Error(codes.NotFound, "reservation not found")
+		}
+		if errors.Is(err, entity.ErrReservationNotHeld) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to ConfirmReservation", "error", err.Error())
+		return nil, status.Error(codes.Internal, "failed to confirm reservation")
+	}
+
+	return &Empty{}, nil
+}
+
+func (s *InventoryServer) ReleaseReservation(ctx context.Context, req *ReleaseReservationRequest) (*Empty, error) {
+	s.logger.Info("Received ReleaseReservation gRPC request", "order_id", req.GetOrderId())
+
+	orderID, err := utils.ParseUUID(req.GetOrderId())
+	if err != nil {
+		s.logger.Error("Failed to parse order id", "error", err.Error())
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	err = s.service.ReleaseReservation(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, entity.ErrReservationNotFound) {
+			return nil, status.Error(codes.NotFound, "reservation not found")
+		}
+		s.logger.Error("Failed to ReleaseReservation", "error", err.Error())
+		return nil, status.Error(codes.Internal, "failed to release reservation")
+	}
+
+	return &Empty{}, nil
 }
 
 func ValidateCreateProductRequest(req *CreateProductRequest) error {
@@ -486,8 +556,8 @@ func ValidateCreateProductRequest(req *CreateProductRequest) error {
 	if _, err := utils.ParseUUID(req.GetCategoryId()); err != nil {
 		return status.Error(codes.InvalidArgument, "invalid category ID format")
 	}
-	if req.Price <= 0 {
-		return status.Error(codes.InvalidArgument, "price must be greater than zero")
+	if err := validatePrice(priceFromPB(req.GetPriceMoney(), req.GetPrice())); err != nil {
+		return err
 	}
 	if req.Quantity <= 0 {
 		return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
@@ -509,7 +579,7 @@ func ValidateCreateCategoryRequest(req *CreateCategoryRequest) error {
 }
 
 func ValidateUpdateCategoryRequest(req *UpdateCategoryRequest) error {
-	if req.Name == nil && req.Description == nil {
+	if req.Name == nil && req.Description == nil && req.TaxClass == nil {
 		return status.Error(codes.InvalidArgument, "at least one field must be provided")
 	}
 	if req.Name != nil && len(*req.Name) == 0 {
@@ -531,6 +601,85 @@ func ValidateReserveProductRequest(req *ReserveProductRequest) error {
 	return nil
 }
 
+func ValidateReleaseProductRequest(req *ReleaseProductRequest) error {
+	if req.GetReleaseId() == "" {
+		return status.Error(codes.InvalidArgument, "release ID is required")
+	}
+	if _, err := utils.ParseUUID(req.GetOrderId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+	if len(req.GetItems()) == 0 {
+		return status.Error(codes.InvalidArgument, "items cannot be empty")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+	}
+	return nil
+}
+
+func ValidateBatchReserveProductsRequest(req *BatchReserveProductsRequest) error {
+	if len(req.GetItems()) == 0 {
+		return status.Error(codes.InvalidArgument, "items cannot be empty")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if _, err := utils.ParseUUID(item.GetOrderId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid order ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+		if expected := item.GetExpectedPrice(); expected != nil && len(expected.GetCurrencyCode()) != 3 {
+			return status.Error(codes.InvalidArgument, "expected price needs a 3-letter currency code")
+		}
+	}
+	return nil
+}
+
+// priceMismatchStatus reports the mismatches in the message and, for
+// clients that want to re-quote, as PriceMismatchDetails.
+func priceMismatchStatus(err *entity.PriceMismatchError) error {
+	details := &PriceMismatchDetails{
+		Mismatches: make([]*PriceMismatch, 0, len(err.Mismatches)),
+	}
+	for _, m := range err.Mismatches {
+		details.Mismatches = append(details.Mismatches, &PriceMismatch{
+			ProductId:     m.ProductID.String(),
+			ExpectedPrice: moneyToPB(m.Expected),
+			CurrentPrice:  moneyToPB(m.Current),
+		})
+	}
+	st, detailErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(details)
+	if detailErr != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return st.Err()
+}
+
+func convertDomainProductToPB(product *entity.InventoryItem) *Product {
+	return &Product{
+		Id:                product.ID.String(),
+		Name:              product.Name,
+		Description:       product.Description,
+		Category:          convertDomainCategoryToPB(&product.Category),
+		Price:             product.Price.Float64(),
+		PriceMoney:        moneyToPB(product.Price),
+		Quantity:          product.Quantity,
+		ReservedQuantity:  product.Reserved,
+		AvailableQuantity: product.Available(),
+		Unit:              product.Unit,
+		CreatedAt:         timestamppb.New(product.CreatedAt),
+		UpdatedAt:         timestamppb.New(product.UpdatedAt),
+	}
+}
+
 func convertDomainCategoryToPB(category *entity.Category) *Category {
 	return &Category{
 		Id:          category.ID.String(),
@@ -538,5 +687,29 @@ func convertDomainCategoryToPB(category *entity.Category) *Category {
 		Description: category.Description,
 		CreatedAt:   timestamppb.New(category.CreatedAt),
 		UpdatedAt:   timestamppb.New(category.UpdatedAt),
+		TaxClass:    category.TaxClass,
 	}
 }
+
+// priceFromPB prefers the exact price and falls back to the deprecated double.
+// A missing currency is left empty for the service to fill in.
+func priceFromPB(price *Money, legacy float64) money.Money {
+	if price != nil {
+		return money.New(price.GetMinorUnits(), price.GetCurrencyCode())
+	}
+	return money.FromFloat(legacy, "")
+}
+
+func validatePrice(price money.Money) error {
+	if !price.IsPositive() {
+		return status.Error(codes.InvalidArgument, "price must be greater than zero")
+	}
+	if price.Currency != "" && len(price.Currency) != 3 {
+		return status.Error(codes.InvalidArgument, "currency must be a 3-letter ISO code")
+	}
+	return nil
+}
+
+func moneyToPB(m money.Money) *Money {
+	return &Money{MinorUnits: m.Amount, CurrencyCode: m.Currency}
+}
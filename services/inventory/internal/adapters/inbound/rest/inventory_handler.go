@@ -12,6 +12,7 @@ import (
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/config"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/utils"
+	"github.com/ExonegeS/money"
 )
 
 type InventoryHandler struct {
@@ -92,7 +93,8 @@ func (h *InventoryHandler) GetInventoryItemById(w http.ResponseWriter, r *http.R
 			Name:        item.Category.Name,
 			Description: item.Category.Description,
 		},
-		Price:     item.Price,
+		Price:     item.Price.Float64(),
+		Currency:  item.Price.Currency,
 		Quantity:  item.Quantity,
 		Unit:      item.Unit,
 		CreatedAt: item.CreatedAt.Format(time.RFC3339),
@@ -109,6 +111,7 @@ type (
 		Description string      `json:"description"`
 		CategoryID  entity.UUID `json:"category_id"`
 		Price       float64     `json:"price"`
+		Currency    string      `json:"currency"`
 		Quantity    float64     `json:"quantity"`
 		Unit        string      `json:"unit"`
 	}
@@ -118,6 +121,7 @@ type (
 		Description string      `json:"description"`
 		Category    Category    `json:"category"`
 		Price       float64     `json:"price"`
+		Currency    string      `json:"currency"`
 		Quantity    float64     `json:"quantity"`
 		Unit        string      `json:"unit"`
 		CreatedAt   string      `json:"created_at"`
@@ -172,7 +176,7 @@ func (h *InventoryHandler) CreateInventoryItem(w http.ResponseWriter, r *http.Re
 		Category: entity.Category{
 			ID: req.CategoryID,
 		},
-		Price:    req.Price,
+		Price:    money.FromFloat(req.Price, req.Currency),
 		Quantity: req.Quantity,
 		Unit:     req.Unit,
 	}
@@ -199,7 +203,8 @@ func (h *InventoryHandler) CreateInventoryItem(w http.ResponseWriter, r *http.Re
 			Name:        e.Category.Name,
 			Description: e.Category.Description,
 		},
-		Price:     e.Price,
+		Price:     e.Price.Float64(),
+		Currency:  e.Price.Currency,
 		Quantity:  e.Quantity,
 		Unit:      e.Unit,
 		CreatedAt: e.CreatedAt.Format(time.RFC3339),
@@ -240,7 +245,8 @@ func (h *InventoryHandler) GetPaginatedInventoryItems(w http.ResponseWriter, r *
 				Name:        item.Category.Name,
 				Description: item.Category.Description,
 			},
-			Price:     item.Price,
+			Price:     item.Price.Float64(),
+			Currency:  item.Price.Currency,
 			Quantity:  item.Quantity,
 			Unit:      item.Unit,
 			CreatedAt: item.CreatedAt.Format(time.RFC3339),
@@ -257,6 +263,7 @@ type (
 		Description *string      `json:"description"`
 		CategoryID  *entity.UUID `json:"category_id"`
 		Price       *float64     `json:"price"`
+		Currency    *string      `json:"currency"`
 		Quantity    *float64     `json:"quantity"`
 		Unit        *string      `json:"unit"`
 	}
@@ -266,6 +273,7 @@ type (
 		Description string      `json:"description"`
 		Category    Category    `json:"category"`
 		Price       float64     `json:"price"`
+		Currency    string      `json:"currency"`
 		Quantity    float64     `json:"quantity"`
 		Unit        string      `json:"unit"`
 		CreatedAt   string      `json:"created_at"`
@@ -288,6 +296,9 @@ func (r *UpdateInventoryItemRequest) Validate() error {
 	if r.Price != nil && *r.Price <= 0 {
 		return fmt.Errorf("price must be greater than zero")
 	}
+	if r.Currency != nil && r.Price == nil {
+		return fmt.Errorf("currency can only be changed together with price")
+	}
 	if r.Quantity != nil && *r.Quantity <= 0 {
 		return fmt.Errorf("quantity must be greater than zero")
 	}
@@ -320,10 +331,17 @@ func (h *InventoryHandler) UpdateInventoryItemById(w http.ResponseWriter, r *htt
 		Name:        req.Name,
 		Description: req.Description,
 		CategoryID:  req.CategoryID,
-		Price:       req.Price,
 		Quantity:    req.Quantity,
 		Unit:        req.Unit,
 	}
+	if req.Price != nil {
+		var currency string
+		if req.Currency != nil {
+			currency = *req.Currency
+		}
+		price := money.FromFloat(*req.Price, currency)
+		params.Price = &price
+	}
 
 	e, err := h.service.UpdateInventoryItem(r.Context(), id, params)
 	if err != nil {
@@ -342,7 +360,8 @@ func (h *InventoryHandler) UpdateInventoryItemById(w http.ResponseWriter, r *htt
 			Name:        e.Category.Name,
 			Description: e.Category.Description,
 		},
-		Price:     e.Price,
+		Price:     e.Price.Float64(),
+		Currency:  e.Price.Currency,
 		Quantity:  e.Quantity,
 		Unit:      e.Unit,
 		CreatedAt: e.CreatedAt.Format(time.RFC3339),
@@ -10,6 +10,7 @@ type Category struct {
 	ID          string    `json:"id"`
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
+	TaxClass    string    `json:"tax_class"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -19,6 +20,7 @@ func CategoryToModel(c *entity.Category) *Category {
 		ID:          string(c.ID),
 		Name:        c.Name,
 		Description: c.Description,
+		TaxClass:    c.TaxClass,
 		CreatedAt:   c.CreatedAt,
 		UpdatedAt:   c.UpdatedAt,
 	}
@@ -29,6 +31,7 @@ func ModelToCategory(m *Category) (*entity.Category, error) {
 		ID:          entity.UUID(m.ID),
 		Name:        m.Name,
 		Description: m.Description,
+		TaxClass:    m.TaxClass,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 	}, nil
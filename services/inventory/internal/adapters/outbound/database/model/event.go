@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+// ProductEvent is the payload of product.created and product.updated events.
+type ProductEvent struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CategoryID  string    `json:"category_id,omitempty"`
+	Price       string    `json:"price"`
+	Currency    string    `json:"currency"`
+	Quantity    float64   `json:"quantity"`
+	Reserved    float64   `json:"reserved"`
+	Unit        string    `json:"unit"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// StockChangedEvent is the payload of stock.changed events.
+type StockChangedEvent struct {
+	ProductID        string  `json:"product_id"`
+	Quantity         float64 `json:"quantity"`
+	Reserved         float64 `json:"reserved"`
+	Available        float64 `json:"available"`
+	PreviousQuantity float64 `json:"previous_quantity"`
+	PreviousReserved float64 `json:"previous_reserved"`
+}
+
+// ProductDeletedEvent is the payload of product.deleted events.
+type ProductDeletedEvent struct {
+	ID string `json:"id"`
+}
+
+func InventoryItemToEvent(item *entity.InventoryItem) ProductEvent {
+	return ProductEvent{
+		ID:          item.ID.String(),
+		Name:        item.Name,
+		Description: item.Description,
+		CategoryID:  item.Category.ID.String(),
+		Price:       item.Price.Decimal(),
+		Currency:    item.Price.Currency,
+		Quantity:    item.Quantity,
+		Reserved:    item.Reserved,
+		Unit:        item.Unit,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
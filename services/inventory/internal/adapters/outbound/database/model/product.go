@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/money"
 )
 
 type Product struct {
@@ -13,8 +14,10 @@ type Product struct {
 	Name        string         `json:"name"`
 	Description string         `json:"description"`
 	CategoryID  sql.NullString `json:"category_id"`
-	Price       float64        `json:"price"`
+	Price       string         `json:"price"`
+	Currency    string         `json:"currency"`
 	Stock       float64        `json:"stock_quantity"`
+	Reserved    float64        `json:"reserved_quantity"`
 	Unit        string         `json:"unit"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
@@ -30,6 +33,7 @@ func InventoryItemToModel(item *entity.InventoryItem) (*Product, *Category, erro
 		ID:          item.Category.ID.String(),
 		Name:        item.Category.Name,
 		Description: item.Category.Description,
+		TaxClass:    item.Category.TaxClass,
 		CreatedAt:   item.Category.CreatedAt,
 		UpdatedAt:   item.Category.UpdatedAt,
 	}
@@ -38,9 +42,11 @@ func InventoryItemToModel(item *entity.InventoryItem) (*Product, *Category, erro
 		ID:          item.ID.String(),
 		Name:        item.Name,
 		Description: item.Description,
-		CategoryID:  sql.NullString{String: item.Category.ID.String(), Valid: true},
-		Price:       item.Price,
+		CategoryID:  sql.NullString{String: item.Category.ID.String(), Valid: item.Category.ID != ""},
+		Price:       item.Price.Decimal(),
+		Currency:    item.Price.Currency,
 		Stock:       item.Quantity,
+		Reserved:    item.Reserved,
 		Unit:        item.Unit,
 		CreatedAt:   item.CreatedAt,
 		UpdatedAt:   item.UpdatedAt,
@@ -50,12 +56,18 @@ func InventoryItemToModel(item *entity.InventoryItem) (*Product, *Category, erro
 }
 
 func ModelToInventoryItem(p *Product, c *Category) (*entity.InventoryItem, error) {
+	price, err := money.Parse(p.Price, p.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	item := &entity.InventoryItem{
 		ID:          entity.UUID(p.ID),
 		Name:        p.Name,
 		Description: p.Description,
-		Price:       p.Price,
+		Price:       price,
 		Quantity:    p.Stock,
+		Reserved:    p.Reserved,
 		Unit:        p.Unit,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
@@ -66,6 +78,7 @@ func ModelToInventoryItem(p *Product, c *Category) (*entity.InventoryItem, error
 			ID:          entity.UUID(c.ID),
 			Name:        c.Name,
 			Description: c.Description,
+			TaxClass:    c.TaxClass,
 			CreatedAt:   c.CreatedAt,
 			UpdatedAt:   c.UpdatedAt,
 		}
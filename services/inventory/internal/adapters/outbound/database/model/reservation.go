@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+type Reservation struct {
+	ID        string    `json:"id"`
+	OrderID   string    `json:"order_id"`
+	ProductID string    `json:"product_id"`
+	Quantity  int64     `json:"quantity"`
+	Released  int64     `json:"released_quantity"`
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func ReservationToModel(r *entity.Reservation) *Reservation {
+	return &Reservation{
+		ID:        r.ID.String(),
+		OrderID:   r.OrderID.String(),
+		ProductID: r.ProductID.String(),
+		Quantity:  r.Quantity,
+		Released:  r.Released,
+		Status:    string(r.Status),
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func ModelToReservation(m *Reservation) *entity.Reservation {
+	return &entity.Reservation{
+		ID:        entity.UUID(m.ID),
+		OrderID:   entity.UUID(m.OrderID),
+		ProductID: entity.UUID(m.ProductID),
+		Quantity:  m.Quantity,
+		Released:  m.Released,
+		Status:    entity.ReservationStatus(m.Status),
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
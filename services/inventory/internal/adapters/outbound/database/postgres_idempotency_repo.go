@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/ports"
+)
+
+type postgresIdempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresIdempotencyRepository(db *sql.DB) ports.IdempotencyRepository {
+	return &postgresIdempotencyRepository{db: db}
+}
+
+func (r *postgresIdempotencyRepository) ClaimIdempotencyKey(ctx context.Context, record entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	const op = "postgresIdempotencyRepository.ClaimIdempotencyKey"
+
+	// The upsert only overwrites an expired record, so of two concurrent
+	// claims exactly one inserts or takes over the row.
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO idempotency_keys (scope, key, fingerprint, completed, response, created_at, expires_at)
+		VALUES ($1, $2, $3, FALSE, NULL, $4, $5)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			completed = FALSE,
+			response = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`,
+		record.Scope, record.Key, record.Fingerprint, record.CreatedAt, record.ExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	claimed, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if claimed > 0 {
+		return nil, nil
+	}
+
+	var existing entity.IdempotencyRecord
+	err = r.db.QueryRowContext(ctx,
+		`SELECT scope, key, fingerprint, completed, response, created_at, expires_at
+		FROM idempotency_keys WHERE scope = $1 AND key = $2`,
+		record.Scope, record.Key,
+	).Scan(
+		&existing.Scope,
+		&existing.Key,
+		&existing.Fingerprint,
+		&existing.Completed,
+		&existing.Response,
+		&existing.CreatedAt,
+		&existing.ExpiresAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The holder released its claim in the meantime.
+		return nil, entity.ErrIdempotencyKeyInProgress
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &existing, nil
+}
+
+func (r *postgresIdempotencyRepository) RenewIdempotencyKey(ctx context.Context, scope, key string, expiresAt time.Time) error {
+	const op = "postgresIdempotencyRepository.RenewIdempotencyKey"
+
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE idempotency_keys SET expires_at = $1
+		WHERE scope = $2 AND key = $3 AND completed = FALSE`,
+		expiresAt, scope, key,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *postgresIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, scope, key string, response []byte, expiresAt time.Time) error {
+	const op = "postgresIdempotencyRepository.CompleteIdempotencyKey"
+
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE idempotency_keys SET completed = TRUE, response = $1, expires_at = $2
+		WHERE scope = $3 AND key = $4`,
+		response, expiresAt, scope, key,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *postgresIdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, scope, key string) error {
+	const op = "postgresIdempotencyRepository.ReleaseIdempotencyKey"
+
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND completed = FALSE`,
+		scope, key,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
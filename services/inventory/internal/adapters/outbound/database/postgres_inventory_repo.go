@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/outbound/database/model"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
@@ -32,7 +34,9 @@ func (r *postgresInventoryRepository) GetByID(ctx context.Context, id entity.UUI
 			description,
 			category_id,
 			price,
+			currency,
 			stock_quantity,
+			reserved_quantity,
 			unit,
 			created_at,
 			updated_at
@@ -44,7 +48,9 @@ func (r *postgresInventoryRepository) GetByID(ctx context.Context, id entity.UUI
 		&product.Description,
 		&product.CategoryID,
 		&product.Price,
+		&product.Currency,
 		&product.Stock,
+		&product.Reserved,
 		&product.Unit,
 		&product.CreatedAt,
 		&product.UpdatedAt,
@@ -61,6 +67,7 @@ func (r *postgresInventoryRepository) GetByID(ctx context.Context, id entity.UUI
 				id,
 				name,
 				description,
+				tax_class,
 				created_at,
 				updated_at
 			FROM categories
@@ -69,6 +76,7 @@ func (r *postgresInventoryRepository) GetByID(ctx context.Context, id entity.UUI
 			&category.ID,
 			&category.Name,
 			&category.Description,
+			&category.TaxClass,
 			&category.CreatedAt,
 			&category.UpdatedAt,
 		)
@@ -95,15 +103,23 @@ func (r *postgresInventoryRepository) Save(ctx context.Context, item entity.Inve
 			return fmt.Errorf("%s: %w", op, err)
 		}
 		_, err = tx.ExecContext(ctx,
-			`INSERT INTO products (id, name, description, category_id, price, stock_quantity, unit, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			`INSERT INTO products (id, name, description, category_id, price, currency, stock_quantity, unit, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
 			m.ID, m.Name, m.Description,
-			m.CategoryID, m.Price, m.Stock,
+			m.CategoryID, m.Price, m.Currency, m.Stock,
 			m.Unit, m.CreatedAt, m.UpdatedAt,
 		)
 		if err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
+
+		event, err := entity.NewEvent(entity.AggregateProduct, item.ID.String(), entity.EventProductCreated, model.InventoryItemToEvent(&item), item.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOutboxEvents(ctx, tx, event); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 		return nil
 	})
 }
@@ -111,56 +127,15 @@ func (r *postgresInventoryRepository) Save(ctx context.Context, item entity.Inve
 func (r *postgresInventoryRepository) UpdateByID(ctx context.Context, id entity.UUID, updateFn func(*entity.InventoryItem) (bool, error)) error {
 	const op = "postgresInventoryRepository.UpdateByID"
 	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
-		var product model.Product
-		var category model.Category
-		err := r.db.QueryRowContext(ctx,
-			`SELECT
-				name,
-				description,
-				category_id,
-				price,
-				stock_quantity,
-				unit,
-				created_at
-			FROM products
-			WHERE id = $1 FOR UPDATE`, id,
-		).Scan(
-			&product.Name,
-			&product.Description,
-			&product.CategoryID,
-			&product.Price,
-			&product.Stock,
-			&product.Unit,
-			&product.CreatedAt,
-		)
+		e, err := lockProduct(ctx, tx, id)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return model.ErrProductNotFound
+			if errors.Is(err, entity.ErrItemNotFound) || errors.Is(err, model.ErrCategoryNotFound) {
+				return err
 			}
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		if product.CategoryID.Valid {
-			err = r.db.QueryRowContext(ctx,
-				`SELECT id, name, description FROM categories WHERE id = $1`, product.CategoryID,
-			).Scan(
-				&category.ID,
-				&category.Name,
-				&category.Description,
-			)
-			if err != nil {
-				if errors.Is(err, sql.ErrNoRows) {
-					return model.ErrCategoryNotFound
-				}
-				return fmt.Errorf("%s: %w", op, err)
-			}
-		}
-
-		e, err := model.ModelToInventoryItem(&product, &category)
-		if err != nil {
-			return err
-		}
-
+		before := snapshotStock(e)
 		updated, err := updateFn(e)
 		if err != nil {
 			return err
@@ -170,32 +145,185 @@ func (r *postgresInventoryRepository) UpdateByID(ctx context.Context, id entity.
 			return entity.ErrNotUpdated
 		}
 
-		p, _, err := model.InventoryItemToModel(e)
-		if err != nil {
+		if err := updateProduct(ctx, tx, id, e); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 
-		_, err = tx.ExecContext(ctx,
-			"UPDATE products SET name = $1, description = $2, category_id = $3, price = $4, stock_quantity = $5, unit = $6, updated_at = $7 WHERE id = $8",
-			p.Name, p.Description, p.CategoryID, p.Price, p.Stock, p.Unit, p.UpdatedAt, id)
+		event, err := entity.NewEvent(entity.AggregateProduct, id.String(), entity.EventProductUpdated, model.InventoryItemToEvent(e), e.UpdatedAt)
 		if err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
-
+		stockEvents, err := stockChangedEvents(before, []*entity.InventoryItem{e}, e.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOutboxEvents(ctx, tx, append([]entity.Event{event}, stockEvents...)...); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 		return nil
 	})
 }
 
+// lockProducts locks the products in ascending id order, skipping duplicates.
+// A missing product is reported as a ReservationError.
+func lockProducts(ctx context.Context, tx *sql.Tx, ids []entity.UUID) ([]*entity.InventoryItem, error) {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	items := make([]*entity.InventoryItem, 0, len(sorted))
+	for _, id := range sorted {
+		e, err := lockProduct(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, entity.ErrItemNotFound) {
+				return nil, &entity.ReservationError{ProductID: id, Err: entity.ErrItemNotFound}
+			}
+			return nil, err
+		}
+		items = append(items, e)
+	}
+	return items, nil
+}
+
+// lockProduct reads a product with its category and holds a row lock on it
+// until the surrounding transaction ends.
+func lockProduct(ctx context.Context, tx *sql.Tx, id entity.UUID) (*entity.InventoryItem, error) {
+	var product model.Product
+	var category model.Category
+	err := tx.QueryRowContext(ctx,
+		`SELECT
+			id,
+			name,
+			description,
+			category_id,
+			price,
+			currency,
+			stock_quantity,
+			reserved_quantity,
+			unit,
+			created_at,
+			updated_at
+		FROM products
+		WHERE id = $1 FOR UPDATE`, id,
+	).Scan(
+		&product.ID,
+		&product.Name,
+		&product.Description,
+		&product.CategoryID,
+		&product.Price,
+		&product.Currency,
+		&product.Stock,
+		&product.Reserved,
+		&product.Unit,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrItemNotFound
+		}
+		return nil, err
+	}
+
+	if product.CategoryID.Valid {
+		err = tx.QueryRowContext(ctx,
+			`SELECT id, name, description, tax_class, created_at, updated_at FROM categories WHERE id = $1`, product.CategoryID,
+		).Scan(
+			&category.ID,
+			&category.Name,
+			&category.Description,
+			&category.TaxClass,
+			&category.CreatedAt,
+			&category.UpdatedAt,
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, model.ErrCategoryNotFound
+			}
+			return nil, err
+		}
+	}
+
+	return model.ModelToInventoryItem(&product, &category)
+}
+
+func updateProduct(ctx context.Context, tx *sql.Tx, id entity.UUID, e *entity.InventoryItem) error {
+	p, _, err := model.InventoryItemToModel(e)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE products SET name = $1, description = $2, category_id = $3, price = $4, currency = $5, stock_quantity = $6, reserved_quantity = $7, unit = $8, updated_at = $9 WHERE id = $10",
+		p.Name, p.Description, p.CategoryID, p.Price, p.Currency, p.Stock, p.Reserved, p.Unit, p.UpdatedAt, id)
+	return err
+}
+
+// stockLevel is a product's stock before a change, kept to tell whether the
+// change moved it.
+type stockLevel struct {
+	quantity float64
+	reserved float64
+}
+
+func snapshotStock(items ...*entity.InventoryItem) map[entity.UUID]stockLevel {
+	levels := make(map[entity.UUID]stockLevel, len(items))
+	for _, item := range items {
+		levels[item.ID] = stockLevel{quantity: item.Quantity, reserved: item.Reserved}
+	}
+	return levels
+}
+
+// stockChangedEvents returns a stock.changed event for every item whose stock
+// differs from its level in before.
+func stockChangedEvents(before map[entity.UUID]stockLevel, items []*entity.InventoryItem, occurredAt time.Time) ([]entity.Event, error) {
+	var events []entity.Event
+	for _, item := range items {
+		previous := before[item.ID]
+		if previous.quantity == item.Quantity && previous.reserved == item.Reserved {
+			continue
+		}
+		event, err := entity.NewEvent(entity.AggregateProduct, item.ID.String(), entity.EventStockChanged, model.StockChangedEvent{
+			ProductID:        item.ID.String(),
+			Quantity:         item.Quantity,
+			Reserved:         item.Reserved,
+			Available:        item.Available(),
+			PreviousQuantity: previous.quantity,
+			PreviousReserved: previous.reserved,
+		}, occurredAt)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
 func (r *postgresInventoryRepository) DeleteByID(ctx context.Context, id entity.UUID) error {
 	const op = "postgresInventoryRepository.DeleteByID"
 
 	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
-		_, err := tx.ExecContext(ctx,
+		res, err := tx.ExecContext(ctx,
 			`DELETE FROM products WHERE id = $1`, id,
 		)
 		if err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		event, err := entity.NewEvent(entity.AggregateProduct, id.String(), entity.EventProductDeleted, model.ProductDeletedEvent{ID: id.String()}, time.Now())
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOutboxEvents(ctx, tx, event); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 		return nil
 	})
 }
@@ -204,7 +332,7 @@ func (r *postgresInventoryRepository) GetAllInventoryItems(ctx context.Context,
 	const op = "postgresInventoryRepository.GetAllInventoryItems"
 
 	query := `
-		SELECT id, name, description, category_id, price, stock_quantity, unit, created_at, updated_at
+		SELECT id, name, description, category_id, price, currency, stock_quantity, reserved_quantity, unit, created_at, updated_at
 		FROM products
 	`
 
@@ -230,7 +358,9 @@ func (r *postgresInventoryRepository) GetAllInventoryItems(ctx context.Context,
 			&model.Description,
 			&model.CategoryID,
 			&model.Price,
+			&model.Currency,
 			&model.Stock,
+			&model.Reserved,
 			&model.Unit,
 			&model.CreatedAt,
 			&model.UpdatedAt,
@@ -265,11 +395,12 @@ func (r *postgresInventoryRepository) fetchCategoryData(ctx context.Context, cat
 	const op = "postgresInventoryRepository.fetchCategoryData"
 
 	err = r.db.QueryRowContext(ctx,
-		`SELECT id, name, description, created_at, updated_at FROM categories WHERE id = $1`, categoryID,
+		`SELECT id, name, description, tax_class, created_at, updated_at FROM categories WHERE id = $1`, categoryID,
 	).Scan(
 		&category.ID,
 		&category.Name,
 		&category.Description,
+		&category.TaxClass,
 		&category.CreatedAt,
 		&category.UpdatedAt,
 	)
@@ -300,11 +431,12 @@ func (r *postgresInventoryRepository) GetCategoryByID(ctx context.Context, id en
 	var category model.Category
 
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, name, description, created_at, updated_at FROM categories WHERE id = $1`, id,
+		`SELECT id, name, description, tax_class, created_at, updated_at FROM categories WHERE id = $1`, id,
 	).Scan(
 		&category.ID,
 		&category.Name,
 		&category.Description,
+		&category.TaxClass,
 		&category.CreatedAt,
 		&category.UpdatedAt,
 	)
@@ -329,9 +461,9 @@ func (r *postgresInventoryRepository) SaveCategory(ctx context.Context, category
 		m := model.CategoryToModel(&category)
 
 		_, err := tx.ExecContext(ctx,
-			`INSERT INTO categories (id, name, description, created_at, updated_at)
-            VALUES ($1, $2, $3, $4, $5)`,
-			m.ID, m.Name, m.Description,
+			`INSERT INTO categories (id, name, description, tax_class, created_at, updated_at)
+            VALUES ($1, $2, $3, $4, $5, $6)`,
+			m.ID, m.Name, m.Description, m.TaxClass,
 			m.CreatedAt, m.UpdatedAt,
 		)
 		if err != nil {
@@ -346,10 +478,11 @@ func (r *postgresInventoryRepository) UpdateCategoryByID(ctx context.Context, id
 	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
 		var category model.Category
 		err := r.db.QueryRowContext(ctx,
-			`SELECT name, description, created_at FROM categories WHERE id = $1 FOR UPDATE`, id,
+			`SELECT name, description, tax_class, created_at FROM categories WHERE id = $1 FOR UPDATE`, id,
 		).Scan(
 			&category.Name,
 			&category.Description,
+			&category.TaxClass,
 			&category.CreatedAt,
 		)
 		if err != nil {
@@ -376,8 +509,8 @@ func (r *postgresInventoryRepository) UpdateCategoryByID(ctx context.Context, id
 		c := model.CategoryToModel(e)
 
 		_, err = tx.ExecContext(ctx,
-			"UPDATE categories SET name = $1, description = $2, updated_at = $3 WHERE id = $4",
-			c.Name, c.Description, c.UpdatedAt, id)
+			"UPDATE categories SET name = $1, description = $2, tax_class = $3, updated_at = $4 WHERE id = $5",
+			c.Name, c.Description, c.TaxClass, c.UpdatedAt, id)
 		if err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
@@ -415,7 +548,7 @@ func (r *postgresInventoryRepository) GetAllCategories(ctx context.Context, pagi
 	const op = "postgresInventoryRepository.GetAllInventoryItems"
 
 	query := `
-		SELECT id, name, description, created_at, updated_at
+		SELECT id, name, description, tax_class, created_at, updated_at
 		FROM categories
 	`
 	if column := pagination.SortBy.ColumnName(); column != "" {
@@ -438,6 +571,7 @@ func (r *postgresInventoryRepository) GetAllCategories(ctx context.Context, pagi
 			&model.ID,
 			&model.Name,
 			&model.Description,
+			&model.TaxClass,
 			&model.CreatedAt,
 			&model.UpdatedAt,
 		)
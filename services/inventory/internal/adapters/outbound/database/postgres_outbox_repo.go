@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) ports.OutboxRepository {
+	return &postgresOutboxRepository{db: db}
+}
+
+// PublishOutboxEvents holds row locks on the batch while publishFn runs, and
+// SKIP LOCKED lets several relays share the outbox without taking the same
+// events.
+func (r *postgresOutboxRepository) PublishOutboxEvents(ctx context.Context, limit int, publishFn func([]entity.Event) (int, error)) (int, error) {
+	const op = "postgresOutboxRepository.PublishOutboxEvents"
+
+	var published int
+	var publishErr error
+	err := runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT seq, id, aggregate_type, aggregate_id, event_type, payload, occurred_at
+			FROM outbox_events
+			WHERE published_at IS NULL
+			ORDER BY seq
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED`,
+			limit,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer rows.Close()
+
+		var seqs []int64
+		var events []entity.Event
+		for rows.Next() {
+			var seq int64
+			var event entity.Event
+			err := rows.Scan(&seq, &event.ID, &event.AggregateType, &event.AggregateID, &event.Type, &event.Payload, &event.OccurredAt)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			seqs = append(seqs, seq)
+			events = append(events, event)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		published, publishErr = publishFn(events)
+		published = min(max(published, 0), len(events))
+
+		if published > 0 {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE outbox_events SET published_at = NOW(), attempts = attempts + 1, last_error = ''
+				WHERE seq = ANY($1)`,
+				pq.Array(seqs[:published]),
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		if publishErr != nil && published < len(events) {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE outbox_events SET attempts = attempts + 1, last_error = $1 WHERE seq = $2`,
+				publishErr.Error(), seqs[published],
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, publishErr
+}
+
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, events ...entity.Event) error {
+	for _, event := range events {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO outbox_events (id, aggregate_type, aggregate_id, event_type, payload, occurred_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			event.ID, event.AggregateType, event.AggregateID, event.Type, []byte(event.Payload), event.OccurredAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/lib/pq"
+)
+
+func (r *postgresInventoryRepository) CreateReservations(ctx context.Context, productIDs []entity.UUID, createFn func([]*entity.InventoryItem) ([]entity.Reservation, error)) error {
+	const op = "postgresInventoryRepository.CreateReservations"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		items, err := lockProducts(ctx, tx, productIDs)
+		if err != nil {
+			var reservationErr *entity.ReservationError
+			if errors.As(err, &reservationErr) || errors.Is(err, model.ErrCategoryNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		before := snapshotStock(items...)
+		reservations, err := createFn(items)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range items {
+			if err := updateProduct(ctx, tx, e.ID, e); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		if err := insertStockChangedEvents(ctx, tx, before, items); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, reservation := range reservations {
+			m := model.ReservationToModel(&reservation)
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO reservations (id, order_id, product_id, quantity, status, expires_at, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+				m.ID, m.OrderID, m.ProductID, m.Quantity,
+				m.Status, m.ExpiresAt, m.CreatedAt, m.UpdatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateReservations locks reservations before products. Both are locked in a
+// stable order, so confirmations, releases and the sweeper cannot deadlock
+// each other or a concurrent CreateReservations.
+func (r *postgresInventoryRepository) UpdateReservations(ctx context.Context, filter entity.ReservationFilter, updateFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) (bool, error)) error {
+	const op = "postgresInventoryRepository.UpdateReservations"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		reservations, err := lockReservations(ctx, tx, filter)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		productIDs := make([]entity.UUID, 0, len(reservations))
+		for _, reservation := range reservations {
+			productIDs = append(productIDs, reservation.ProductID)
+		}
+		locked, err := lockProducts(ctx, tx, productIDs)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		items := make(map[entity.UUID]*entity.InventoryItem, len(locked))
+		for _, item := range locked {
+			items[item.ID] = item
+		}
+
+		before := snapshotStock(locked...)
+		updated, err := updateFn(reservations, items)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		for _, e := range locked {
+			if err := updateProduct(ctx, tx, e.ID, e); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		if err := insertStockChangedEvents(ctx, tx, before, locked); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := updateReservations(ctx, tx, reservations); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresInventoryRepository) ApplyStockRelease(ctx context.Context, release entity.StockRelease, applyFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) error) error {
+	const op = "postgresInventoryRepository.ApplyStockRelease"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`INSERT INTO stock_releases (id, order_id, created_at) VALUES ($1, $2, $3)
+			ON CONFLICT (id) DO NOTHING`,
+			release.ID, release.OrderID, release.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if inserted == 0 {
+			return entity.ErrReleaseAlreadyApplied
+		}
+
+		holds, err := lockReservations(ctx, tx, entity.ReservationFilter{
+			OrderID:  release.OrderID,
+			Statuses: []entity.ReservationStatus{entity.ReservationHeld, entity.ReservationConfirmed},
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		productIDs := make([]entity.UUID, 0, len(release.Lines)+len(holds))
+		for _, line := range release.Lines {
+			productIDs = append(productIDs, line.ProductID)
+		}
+		for _, hold := range holds {
+			productIDs = append(productIDs, hold.ProductID)
+		}
+		locked, err := lockProducts(ctx, tx, productIDs)
+		if err != nil {
+			var reservationErr *entity.ReservationError
+			if errors.As(err, &reservationErr) || errors.Is(err, model.ErrCategoryNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		items := make(map[entity.UUID]*entity.InventoryItem, len(locked))
+		for _, item := range locked {
+			items[item.ID] = item
+		}
+
+		before := snapshotStock(locked...)
+		if err := applyFn(holds, items); err != nil {
+			return err
+		}
+
+		for _, e := range locked {
+			if err := updateProduct(ctx, tx, e.ID, e); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		if err := insertStockChangedEvents(ctx, tx, before, locked); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := updateReservations(ctx, tx, holds); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		for _, line := range release.Lines {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO stock_release_lines (release_id, product_id, quantity) VALUES ($1, $2, $3)`,
+				release.ID, line.ProductID, line.Quantity,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+}
+
+// insertStockChangedEvents records the stock movements a reservation change
+// made to items.
+func insertStockChangedEvents(ctx context.Context, tx *sql.Tx, before map[entity.UUID]stockLevel, items []*entity.InventoryItem) error {
+	events, err := stockChangedEvents(before, items, time.Now())
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, events...)
+}
+
+func updateReservations(ctx context.Context, tx *sql.Tx, reservations []*entity.Reservation) error {
+	for _, reservation := range reservations {
+		m := model.ReservationToModel(reservation)
+		_, err := tx.ExecContext(ctx,
+			`UPDATE reservations SET released_quantity = $1, status = $2, updated_at = $3 WHERE id = $4`,
+			m.Released, m.Status, m.UpdatedAt, m.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lockReservations(ctx context.Context, tx *sql.Tx, filter entity.ReservationFilter) ([]*entity.Reservation, error) {
+	var conditions []string
+	var args []any
+	if filter.OrderID != "" {
+		args = append(args, filter.OrderID)
+		conditions = append(conditions, "order_id = $"+strconv.Itoa(len(args)))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		args = append(args, pq.Array(statuses))
+		conditions = append(conditions, "status = ANY($"+strconv.Itoa(len(args))+")")
+	}
+	if !filter.ExpiredBefore.IsZero() {
+		args = append(args, filter.ExpiredBefore)
+		conditions = append(conditions, "expires_at < $"+strconv.Itoa(len(args)))
+	}
+
+	query := `SELECT id, order_id, product_id, quantity, released_quantity, status, expires_at, created_at, updated_at
+		FROM reservations`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY order_id, product_id"
+	if filter.Limit > 0 {
+		query += " LIMIT " + strconv.Itoa(filter.Limit)
+	}
+	query += " FOR UPDATE"
+	if filter.SkipLocked {
+		query += " SKIP LOCKED"
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reservations []*entity.Reservation
+	for rows.Next() {
+		var m model.Reservation
+		err := rows.Scan(
+			&m.ID,
+			&m.OrderID,
+			&m.ProductID,
+			&m.Quantity,
+			&m.Released,
+			&m.Status,
+			&m.ExpiresAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		reservations = append(reservations, model.ModelToReservation(&m))
+	}
+
+	return reservations, rows.Err()
+}
@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+// MemoryPublisher keeps published events in memory. It is meant for tests and
+// for running without any consumer.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []entity.Event
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, event entity.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events returns a copy of everything published so far, in publish order.
+func (p *MemoryPublisher) Events() []entity.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]entity.Event(nil), p.events...)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/inbound/rest"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/inbound/rest/middleware"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/outbound/database"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/outbound/events"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/config"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/ports"
 
 	"log/slog"
 )
@@ -35,7 +39,23 @@ func NewAPIServer(cfg config.Config, db *sql.DB, logger *slog.Logger) *APIServer
 func (s *APIServer) Run() error {
 	invRepo := database.NewPostgresInventoryRepository(s.db)
 
-	invService := application.NewInventoryService(invRepo, time.Now)
+	invService := application.NewInventoryService(invRepo, s.cfg.Reservation.HoldTTL, entity.PriceTolerance{
+		MinorUnits:  s.cfg.Reservation.PriceToleranceMinorUnits,
+		BasisPoints: s.cfg.Reservation.PriceToleranceBPS,
+	}, time.Now)
+	idempotencyService := application.NewIdempotencyService(database.NewPostgresIdempotencyRepository(s.db), s.cfg.Idempotency.Window, time.Now)
+
+	sweeper := application.NewReservationSweeper(invService, s.cfg.Reservation.SweepInterval, s.cfg.Reservation.SweepBatchSize, s.logger)
+	go sweeper.Run(context.Background())
+
+	publisher, closePublisher, err := s.newEventPublisher()
+	if err != nil {
+		return err
+	}
+	defer closePublisher()
+
+	relay := application.NewOutboxRelay(database.NewPostgresOutboxRepository(s.db), publisher, s.cfg.Events.RelayInterval, s.cfg.Events.BatchSize, s.logger)
+	go relay.Run(context.Background())
 
 	invHandler := rest.NewInventoryHandler(invService, s.logger)
 	healthHandler := rest.NewHealthHandler(s.logger, s.db)
@@ -46,9 +66,24 @@ func (s *APIServer) Run() error {
 	loggerMW := middleware.NewLoggerMW(s.logger)
 	MWChain := middleware.NewMiddlewareChain(middleware.RecoveryMW, loggerMW)
 
-	go grpc.StartGRPCServer(s.cfg.Server.GRPCPort, invService, s.logger)
+	go grpc.StartGRPCServer(s.cfg.Server.GRPCPort, invService, idempotencyService, s.logger)
 
 	serverAddress := fmt.Sprintf(":%s", s.cfg.Server.Port)
 	s.logger.Info("HTTP server started", "port", s.cfg.Server.Port)
 	return http.ListenAndServe(serverAddress, MWChain(s.mux))
 }
+
+func (s *APIServer) newEventPublisher() (ports.EventPublisher, func() error, error) {
+	switch s.cfg.Events.Publisher {
+	case "memory":
+		return events.NewMemoryPublisher(), func() error { return nil }, nil
+	case "jsonl":
+		publisher, err := events.NewJSONLPublisher(s.cfg.Events.File)
+		if err != nil {
+			return nil, nil, err
+		}
+		return publisher, publisher.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown events publisher %q", s.cfg.Events.Publisher)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/ports"
+	"github.com/ExonegeS/money"
 )
 
 type InventoryService interface {
@@ -24,31 +25,42 @@ type InventoryService interface {
 	GetPaginatedCategories(ctx context.Context, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Category], error)
 
 	ReserveProduct(ctx context.Context, id entity.UUID, quantity int64) error
+	ReleaseProducts(ctx context.Context, release entity.StockRelease) error
+	ReserveProducts(ctx context.Context, lines []entity.ReservationLine) ([]entity.ReservationResult, error)
+	ConfirmReservation(ctx context.Context, orderID entity.UUID) error
+	ReleaseReservation(ctx context.Context, orderID entity.UUID) error
+	ExpireReservations(ctx context.Context, limit int) (int, error)
 }
 
 type UpdateInventoryItemParams struct {
 	Name        *string
 	Description *string
 	CategoryID  *entity.UUID
-	Price       *float64
-	Quantity    *float64
-	Unit        *string
+	// Price without a currency keeps the item's current currency.
+	Price    *money.Money
+	Quantity *float64
+	Unit     *string
 }
 
 type UpdateCategoryParams struct {
 	Name        *string
 	Description *string
+	TaxClass    *string
 }
 
 type inventoryService struct {
-	inventoryRepo ports.InventoryRepository
-	timeSource    func() time.Time
+	inventoryRepo  ports.InventoryRepository
+	holdTTL        time.Duration
+	priceTolerance entity.PriceTolerance
+	timeSource     func() time.Time
 }
 
-func NewInventoryService(inventoryRepo ports.InventoryRepository, timeSource func() time.Time) InventoryService {
+func NewInventoryService(inventoryRepo ports.InventoryRepository, holdTTL time.Duration, priceTolerance entity.PriceTolerance, timeSource func() time.Time) InventoryService {
 	return &inventoryService{
-		inventoryRepo: inventoryRepo,
-		timeSource:    timeSource,
+		inventoryRepo:  inventoryRepo,
+		holdTTL:        holdTTL,
+		priceTolerance: priceTolerance,
+		timeSource:     timeSource,
 	}
 }
 
@@ -71,6 +83,9 @@ func (s *inventoryService) CreateInventoryItem(ctx context.Context, item *entity
 	if err := item.Validate(); err != nil {
 		return fmt.Errorf("invalid inventory item: %w", err)
 	}
+	if item.Price.Currency == "" {
+		item.Price.Currency = entity.DefaultCurrency
+	}
 
 	category, err := s.inventoryRepo.GetCategoryByID(ctx, item.Category.ID)
 	if err != nil {
@@ -215,6 +230,11 @@ func (s *inventoryService) UpdateCategory(ctx context.Context, id entity.UUID, p
 			updated = true
 		}
 
+		if params.TaxClass != nil && *params.TaxClass != category.TaxClass {
+			category.TaxClass = *params.TaxClass
+			updated = true
+		}
+
 		if !updated {
 			return
 		}
@@ -280,17 +300,23 @@ func (s *inventoryService) UpdateInventoryItem(ctx context.Context, id entity.UU
 			item.Category.ID = *params.CategoryID
 			updated = true
 		}
-		if params.Price != nil && *params.Price != item.Price {
-			if *params.Price < 0 {
-				err = entity.ErrInvalidPrice
-				return
+		if params.Price != nil {
+			price := *params.Price
+			if price.Currency == "" {
+				price.Currency = item.Price.Currency
+			}
+			if price != item.Price {
+				if price.IsNegative() {
+					err = entity.ErrInvalidPrice
+					return
+				}
+				item.Price = price
+				updated = true
 			}
-			item.Price = *params.Price
-			updated = true
 		}
 
 		if params.Quantity != nil && *params.Quantity != item.Quantity {
-			if *params.Quantity < 0 {
+			if *params.Quantity < 0 || *params.Quantity < item.Reserved {
 				err = entity.ErrInvalidQuantity
 				return
 			}
@@ -320,7 +346,7 @@ func (s *inventoryService) ReserveProduct(ctx context.Context, id entity.UUID, q
 			err = entity.ErrInvalidQuantity
 			return
 		}
-		if quantity > int64(item.Quantity) {
+		if float64(quantity) > item.Available() {
 			err = entity.ErrInsufficientQuantity
 			return
 		}
@@ -331,3 +357,308 @@ func (s *inventoryService) ReserveProduct(ctx context.Context, id entity.UUID, q
 		return true, nil
 	})
 }
+
+// ReleaseProducts gives back stock taken for an order. Stock the order still
+// holds is released from its hold; the rest is credited back to stock on
+// hand, up to what the order's confirmed reservations took and earlier
+// releases have not yet returned. A release asking for more is rejected
+// whole. Every release ID is applied once, so a retry never credits twice.
+func (s *inventoryService) ReleaseProducts(ctx context.Context, release entity.StockRelease) error {
+	if release.ID == "" || release.OrderID == "" || len(release.Lines) == 0 {
+		return entity.ErrInvalidRequestPayload
+	}
+
+	lines := make([]entity.ReservationLine, 0, len(release.Lines))
+	index := make(map[entity.UUID]int, len(release.Lines))
+	for _, line := range release.Lines {
+		if line.Quantity <= 0 {
+			return entity.ErrInvalidQuantity
+		}
+		if i, ok := index[line.ProductID]; ok {
+			lines[i].Quantity += line.Quantity
+			continue
+		}
+		index[line.ProductID] = len(lines)
+		lines = append(lines, entity.ReservationLine{
+			OrderID:   release.OrderID,
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+		})
+	}
+	release.Lines = lines
+	release.CreatedAt = s.timeSource().UTC()
+
+	err := s.inventoryRepo.ApplyStockRelease(ctx, release, func(reservations []*entity.Reservation, items map[entity.UUID]*entity.InventoryItem) error {
+		now := release.CreatedAt
+		for _, line := range release.Lines {
+			item := items[line.ProductID]
+			remaining := line.Quantity
+
+			// Holds are released before confirmed stock is credited.
+			for _, status := range []entity.ReservationStatus{entity.ReservationHeld, entity.ReservationConfirmed} {
+				for _, reservation := range reservations {
+					if remaining == 0 {
+						break
+					}
+					if reservation.ProductID != line.ProductID || reservation.Status != status || reservation.Outstanding() == 0 {
+						continue
+					}
+
+					released := min(reservation.Outstanding(), remaining)
+					if status == entity.ReservationHeld {
+						item.Reserved -= float64(released)
+					} else {
+						item.Quantity += float64(released)
+					}
+					reservation.Released += released
+					if status == entity.ReservationHeld && reservation.Outstanding() == 0 {
+						reservation.Status = entity.ReservationReleased
+					}
+					reservation.UpdatedAt = now
+					remaining -= released
+				}
+			}
+
+			if remaining > 0 {
+				return fmt.Errorf("%w: product %s: %d more than the order has left", entity.ErrReleaseExceedsTaken, line.ProductID, remaining)
+			}
+			item.UpdatedAt = now
+		}
+		return nil
+	})
+	if errors.Is(err, entity.ErrReleaseAlreadyApplied) {
+		return nil
+	}
+	return err
+}
+
+// ReserveProducts holds stock for every line atomically. Lines for the same
+// order and product are merged, and the first product that cannot be
+// satisfied rejects the batch. Lines with an expected price are checked
+// against the locked products first; every price outside the tolerance is
+// reported in one PriceMismatchError. Holds expire after holdTTL unless
+// confirmed.
+func (s *inventoryService) ReserveProducts(ctx context.Context, lines []entity.ReservationLine) ([]entity.ReservationResult, error) {
+	if len(lines) == 0 {
+		return nil, entity.ErrInvalidRequestPayload
+	}
+
+	type holdKey struct {
+		orderID   entity.UUID
+		productID entity.UUID
+	}
+	requested := make(map[entity.UUID]int64, len(lines))
+	holds := make(map[holdKey]int64, len(lines))
+	keys := make([]holdKey, 0, len(lines))
+	ids := make([]entity.UUID, 0, len(lines))
+	for _, line := range lines {
+		if line.OrderID == "" {
+			return nil, entity.ErrInvalidRequestPayload
+		}
+		if line.Quantity <= 0 {
+			return nil, entity.ErrInvalidQuantity
+		}
+		if _, ok := requested[line.ProductID]; !ok {
+			ids = append(ids, line.ProductID)
+		}
+		requested[line.ProductID] += line.Quantity
+
+		key := holdKey{orderID: line.OrderID, productID: line.ProductID}
+		if _, ok := holds[key]; !ok {
+			keys = append(keys, key)
+		}
+		holds[key] += line.Quantity
+	}
+
+	var results []entity.ReservationResult
+	err := s.inventoryRepo.CreateReservations(ctx, ids, func(items []*entity.InventoryItem) ([]entity.Reservation, error) {
+		now := s.timeSource().UTC()
+		expiresAt := now.Add(s.holdTTL)
+
+		if err := s.checkPrices(lines, items); err != nil {
+			return nil, err
+		}
+
+		byID := make(map[entity.UUID]*entity.InventoryItem, len(items))
+		for _, item := range items {
+			quantity := requested[item.ID]
+			if float64(quantity) > item.Available() {
+				return nil, &entity.ReservationError{
+					ProductID: item.ID,
+					Requested: quantity,
+					Available: item.Available(),
+					Err:       entity.ErrInsufficientQuantity,
+				}
+			}
+
+			item.Reserved += float64(quantity)
+			item.UpdatedAt = now
+			byID[item.ID] = item
+		}
+
+		reservations := make([]entity.Reservation, 0, len(keys))
+		results = make([]entity.ReservationResult, 0, len(keys))
+		for _, key := range keys {
+			reservation := entity.Reservation{
+				ID:        entity.NewUUID(),
+				OrderID:   key.orderID,
+				ProductID: key.productID,
+				Quantity:  holds[key],
+				Status:    entity.ReservationHeld,
+				ExpiresAt: expiresAt,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			reservations = append(reservations, reservation)
+			results = append(results, entity.ReservationResult{
+				ReservationID: reservation.ID,
+				OrderID:       reservation.OrderID,
+				ProductID:     reservation.ProductID,
+				Quantity:      reservation.Quantity,
+				Remaining:     byID[key.productID].Available(),
+				ExpiresAt:     expiresAt,
+			})
+		}
+		return reservations, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (s *inventoryService) checkPrices(lines []entity.ReservationLine, items []*entity.InventoryItem) error {
+	prices := make(map[entity.UUID]money.Money, len(items))
+	for _, item := range items {
+		prices[item.ID] = item.Price
+	}
+
+	type quote struct {
+		productID entity.UUID
+		expected  money.Money
+	}
+	seen := make(map[quote]bool)
+	var mismatches []entity.PriceMismatch
+	for _, line := range lines {
+		if line.ExpectedPrice == nil {
+			continue
+		}
+		current, ok := prices[line.ProductID]
+		if !ok {
+			continue
+		}
+		q := quote{productID: line.ProductID, expected: *line.ExpectedPrice}
+		if seen[q] || s.priceTolerance.Allows(*line.ExpectedPrice, current) {
+			continue
+		}
+		seen[q] = true
+		mismatches = append(mismatches, entity.PriceMismatch{
+			ProductID: line.ProductID,
+			Expected:  *line.ExpectedPrice,
+			Current:   current,
+		})
+	}
+	if len(mismatches) > 0 {
+		return &entity.PriceMismatchError{Mismatches: mismatches}
+	}
+	return nil
+}
+
+// ConfirmReservation turns the order's held stock into a permanent deduction.
+// Confirming an already confirmed order is a no-op. Released holds are
+// skipped; an expired one fails the confirmation, as its stock is gone.
+func (s *inventoryService) ConfirmReservation(ctx context.Context, orderID entity.UUID) error {
+	filter := entity.ReservationFilter{OrderID: orderID}
+	err := s.inventoryRepo.UpdateReservations(ctx, filter, func(reservations []*entity.Reservation, items map[entity.UUID]*entity.InventoryItem) (updated bool, err error) {
+		if len(reservations) == 0 {
+			return false, entity.ErrReservationNotFound
+		}
+
+		now := s.timeSource().UTC()
+		for _, reservation := range reservations {
+			switch reservation.Status {
+			case entity.ReservationConfirmed, entity.ReservationReleased:
+				// Released stock was given back on purpose, such as by an
+				// order edit that was rolled back.
+				continue
+			case entity.ReservationHeld:
+			default:
+				return false, fmt.Errorf("%w: reservation %s is %s", entity.ErrReservationNotHeld, reservation.ID, reservation.Status)
+			}
+
+			item := items[reservation.ProductID]
+			item.Quantity -= float64(reservation.Outstanding())
+			item.Reserved -= float64(reservation.Outstanding())
+			item.UpdatedAt = now
+
+			reservation.Status = entity.ReservationConfirmed
+			reservation.UpdatedAt = now
+			updated = true
+		}
+		return updated, nil
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return nil
+	}
+	return err
+}
+
+// ReleaseReservation returns the order's held stock to available stock.
+// Confirmed reservations are left untouched.
+func (s *inventoryService) ReleaseReservation(ctx context.Context, orderID entity.UUID) error {
+	filter := entity.ReservationFilter{OrderID: orderID}
+	err := s.inventoryRepo.UpdateReservations(ctx, filter, func(reservations []*entity.Reservation, items map[entity.UUID]*entity.InventoryItem) (bool, error) {
+		if len(reservations) == 0 {
+			return false, entity.ErrReservationNotFound
+		}
+		return s.returnHolds(reservations, items, entity.ReservationReleased), nil
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return nil
+	}
+	return err
+}
+
+// ExpireReservations returns up to limit expired holds to available stock and
+// reports how many were expired. Holds locked by a concurrent confirmation
+// are skipped and picked up on the next run if still held.
+func (s *inventoryService) ExpireReservations(ctx context.Context, limit int) (int, error) {
+	filter := entity.ReservationFilter{
+		Statuses:      []entity.ReservationStatus{entity.ReservationHeld},
+		ExpiredBefore: s.timeSource().UTC(),
+		Limit:         limit,
+		SkipLocked:    true,
+	}
+
+	expired := 0
+	err := s.inventoryRepo.UpdateReservations(ctx, filter, func(reservations []*entity.Reservation, items map[entity.UUID]*entity.InventoryItem) (bool, error) {
+		expired = len(reservations)
+		return s.returnHolds(reservations, items, entity.ReservationExpired), nil
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+func (s *inventoryService) returnHolds(reservations []*entity.Reservation, items map[entity.UUID]*entity.InventoryItem, status entity.ReservationStatus) (updated bool) {
+	now := s.timeSource().UTC()
+	for _, reservation := range reservations {
+		if reservation.Status != entity.ReservationHeld {
+			continue
+		}
+
+		item := items[reservation.ProductID]
+		item.Reserved -= float64(reservation.Outstanding())
+		item.UpdatedAt = now
+
+		reservation.Status = status
+		reservation.UpdatedAt = now
+		updated = true
+	}
+	return updated
+}
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/ports"
+)
+
+// OutboxRelay periodically publishes events recorded in the outbox. An event
+// is marked published only after the publisher accepted it, so a crash in
+// between delivers it again on the next run.
+type OutboxRelay struct {
+	repo      ports.OutboxRepository
+	publisher ports.EventPublisher
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewOutboxRelay(repo ports.OutboxRepository, publisher ports.EventPublisher, interval time.Duration, batchSize int, logger *slog.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		repo:      repo,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run relays until ctx is cancelled. A full batch is followed immediately by
+// another one, so a backlog of events is drained without waiting.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			published, err := r.RelayBatch(ctx)
+			if err != nil {
+				r.logger.Error("Failed to relay outbox events", "error", err)
+				break
+			}
+			if published < r.batchSize {
+				break
+			}
+		}
+	}
+}
+
+// RelayBatch publishes the oldest unpublished events in order and stops at
+// the first one the publisher rejects, leaving it and the rest for a retry.
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	return r.repo.PublishOutboxEvents(ctx, r.batchSize, func(events []entity.Event) (int, error) {
+		for i, event := range events {
+			if err := r.publisher.Publish(ctx, event); err != nil {
+				return i, err
+			}
+		}
+		return len(events), nil
+	})
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ReservationSweeper periodically returns expired holds to available stock.
+type ReservationSweeper struct {
+	service   InventoryService
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewReservationSweeper(service InventoryService, interval time.Duration, batchSize int, logger *slog.Logger) *ReservationSweeper {
+	return &ReservationSweeper{
+		service:   service,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run sweeps until ctx is cancelled. A full batch is followed immediately by
+// another one, so a backlog of expired holds is drained without waiting.
+func (s *ReservationSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			expired, err := s.service.ExpireReservations(ctx, s.batchSize)
+			if err != nil {
+				s.logger.Error("Failed to expire reservations", "error", err)
+				break
+			}
+			if expired > 0 {
+				s.logger.Info("Expired reservations", "count", expired)
+			}
+			if expired < s.batchSize {
+				break
+			}
+		}
+	}
+}
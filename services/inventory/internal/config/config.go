@@ -4,7 +4,9 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -12,6 +14,9 @@ type Config struct {
 	Version     string
 	Server      Server
 	DB          DataBase
+	Reservation Reservation
+	Idempotency Idempotency
+	Events      Events
 }
 
 type Server struct {
@@ -20,6 +25,29 @@ type Server struct {
 	GRPCPort string
 }
 
+type Reservation struct {
+	HoldTTL        time.Duration
+	SweepInterval  time.Duration
+	SweepBatchSize int
+	// A reservation's expected price matches when the current price is
+	// within PriceToleranceMinorUnits or PriceToleranceBPS basis points of it.
+	PriceToleranceMinorUnits int64
+	PriceToleranceBPS        int64
+}
+
+type Idempotency struct {
+	// Window is how long a response is replayed for a retried key.
+	Window time.Duration
+}
+
+type Events struct {
+	// Publisher is "memory" or "jsonl"; the latter appends to File.
+	Publisher     string
+	File          string
+	RelayInterval time.Duration
+	BatchSize     int
+}
+
 type DataBase struct {
 	DBUser     string
 	DBPassword string
@@ -50,6 +78,23 @@ func NewConfig(filename string) Config {
 			DBPort:     getEnv("DB_PORT", "5432"),
 			DBName:     getEnv("DB_NAME", "inventory_db"),
 		},
+		Reservation: Reservation{
+			HoldTTL:        getEnvDuration("RESERVATION_HOLD_TTL", 15*time.Minute),
+			SweepInterval:  getEnvDuration("RESERVATION_SWEEP_INTERVAL", 30*time.Second),
+			SweepBatchSize: getEnvInt("RESERVATION_SWEEP_BATCH_SIZE", 100),
+
+			PriceToleranceMinorUnits: int64(getEnvInt("PRICE_TOLERANCE_MINOR_UNITS", 0)),
+			PriceToleranceBPS:        int64(getEnvInt("PRICE_TOLERANCE_BPS", 0)),
+		},
+		Idempotency: Idempotency{
+			Window: getEnvDuration("IDEMPOTENCY_WINDOW", 24*time.Hour),
+		},
+		Events: Events{
+			Publisher:     getEnv("EVENTS_PUBLISHER", "memory"),
+			File:          getEnv("EVENTS_FILE", "events.jsonl"),
+			RelayInterval: getEnvDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+			BatchSize:     getEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -86,3 +131,21 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	if value, ok := os.LookupEnv(key); ok {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if value, ok := os.LookupEnv(key); ok {
+		if parsed, err := time.ParseDuration(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
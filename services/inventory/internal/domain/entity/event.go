@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is a domain event recorded in the outbox in the same transaction as
+// the change it describes. Delivery is at least once, so consumers should
+// deduplicate by ID.
+type Event struct {
+	ID            UUID
+	AggregateType string
+	AggregateID   string
+	Type          string
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+}
+
+const (
+	AggregateProduct = "product"
+
+	EventProductCreated = "product.created"
+	EventProductUpdated = "product.updated"
+	EventProductDeleted = "product.deleted"
+	// EventStockChanged is emitted whenever stock on hand or the reserved part
+	// of it moves, whether by an edit, a reservation or a release.
+	EventStockChanged = "stock.changed"
+)
+
+// NewEvent encodes payload as JSON and stamps the event with a fresh ID.
+func NewEvent(aggregateType, aggregateID, eventType string, payload any, occurredAt time.Time) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+	return Event{
+		ID:            NewUUID(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Type:          eventType,
+		Payload:       data,
+		OccurredAt:    occurredAt.UTC(),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// IdempotencyRecord remembers a request made under an idempotency key. While
+// the request runs the record is a short-lived claim; once it succeeds the
+// record keeps the encoded response for the replay window.
+type IdempotencyRecord struct {
+	Scope       string
+	Key         string
+	Fingerprint string
+	Completed   bool
+	Response    []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+var (
+	ErrIdempotencyKeyReused     = fmt.Errorf("idempotency key was used for a different request")
+	ErrIdempotencyKeyInProgress = fmt.Errorf("a request with this idempotency key is still in progress")
+)
+
+// IsLive reports whether the record still holds its key at now.
+func (r *IdempotencyRecord) IsLive(now time.Time) bool {
+	return now.Before(r.ExpiresAt)
+}
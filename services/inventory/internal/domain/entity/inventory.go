@@ -4,27 +4,38 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ExonegeS/money"
 	"github.com/google/uuid"
 )
 
+// DefaultCurrency prices amounts that arrive without a currency, such as the
+// deprecated double price fields.
+const DefaultCurrency = "USD"
+
 type InventoryItem struct {
 	ID          UUID
 	Name        string
 	Description string
 	Category    Category
-	Price       float64
-	Quantity    float64
-	Unit        string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Price       money.Money
+	// Quantity is the stock on hand. Reserved is the part of it held for
+	// orders that are not confirmed yet.
+	Quantity  float64
+	Reserved  float64
+	Unit      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type Category struct {
 	ID          UUID
 	Name        string
 	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// TaxClass names the tax rate the orders service applies to the
+	// category's products, such as "reduced". Empty is the standard rate.
+	TaxClass  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type UUID string
@@ -52,11 +63,16 @@ var (
 	ErrInsufficientQuantity  = fmt.Errorf("insufficient stored items")
 )
 
+// Available is the stock that can still be reserved.
+func (i *InventoryItem) Available() float64 {
+	return i.Quantity - i.Reserved
+}
+
 func (i *InventoryItem) Validate() error {
 	if i.Quantity < 0 {
 		return fmt.Errorf("quantity cannot be negative")
 	}
-	if i.Price < 0 {
+	if i.Price.IsNegative() {
 		return fmt.Errorf("price cannot be negative")
 	}
 	if i.Name == "" {
@@ -0,0 +1,160 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+type ReservationLine struct {
+	OrderID   UUID
+	ProductID UUID
+	Quantity  int64
+	// ExpectedPrice is the unit price the order was quoted. When set, the
+	// line is only reserved while the product's price is within the
+	// configured tolerance of it.
+	ExpectedPrice *money.Money
+}
+
+type ReservationResult struct {
+	ReservationID UUID
+	OrderID       UUID
+	ProductID     UUID
+	Quantity      int64
+	Remaining     float64
+	ExpiresAt     time.Time
+}
+
+// Reservation is stock held for an order. A held reservation counts against
+// the product's available quantity until it is confirmed, released or it
+// expires.
+type Reservation struct {
+	ID        UUID
+	OrderID   UUID
+	ProductID UUID
+	Quantity  int64
+	// Released is how much of Quantity stock releases gave back, either
+	// from the hold or, once confirmed, to stock on hand.
+	Released  int64
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Outstanding is the quantity still held, or still taken from stock once the
+// reservation is confirmed.
+func (r *Reservation) Outstanding() int64 {
+	return r.Quantity - r.Released
+}
+
+type ReservationStatus string
+
+const (
+	ReservationHeld      ReservationStatus = "held"
+	ReservationConfirmed ReservationStatus = "confirmed"
+	ReservationReleased  ReservationStatus = "released"
+	ReservationExpired   ReservationStatus = "expired"
+)
+
+// ReservationFilter selects the reservations to lock for an update. Zero
+// fields are ignored.
+type ReservationFilter struct {
+	OrderID       UUID
+	Statuses      []ReservationStatus
+	ExpiredBefore time.Time
+	Limit         int
+	// SkipLocked skips reservations locked by another transaction instead of
+	// waiting for them.
+	SkipLocked bool
+}
+
+// StockRelease gives back stock taken for an order. The ID is chosen by the
+// caller and identifies the release across retries.
+type StockRelease struct {
+	ID        string
+	OrderID   UUID
+	Lines     []ReservationLine
+	CreatedAt time.Time
+}
+
+var (
+	ErrReservationNotFound   = fmt.Errorf("reservation not found")
+	ErrReservationNotHeld    = fmt.Errorf("reservation is no longer held")
+	ErrReleaseAlreadyApplied = fmt.Errorf("stock release already applied")
+	ErrReleaseExceedsTaken   = fmt.Errorf("release exceeds the stock taken for the order")
+	ErrPriceMismatch         = fmt.Errorf("price changed since the order was quoted")
+)
+
+// ReservationError describes the line that caused a batch reservation to be
+// rejected. It unwraps to ErrItemNotFound or ErrInsufficientQuantity.
+type ReservationError struct {
+	ProductID UUID
+	Requested int64
+	Available float64
+	Err       error
+}
+
+func (e *ReservationError) Error() string {
+	if e.Err == ErrItemNotFound {
+		return fmt.Sprintf("product %s: %v", e.ProductID, e.Err)
+	}
+	return fmt.Sprintf("product %s: %v (requested %d, available %v)", e.ProductID, e.Err, e.Requested, e.Available)
+}
+
+func (e *ReservationError) Unwrap() error {
+	return e.Err
+}
+
+// PriceTolerance is how far a product's price may drift from the price a
+// reservation expects. A difference is accepted when it is within MinorUnits
+// or within BasisPoints of the expected price, whichever allows more.
+type PriceTolerance struct {
+	MinorUnits  int64
+	BasisPoints int64
+}
+
+// Allows reports whether current is close enough to expected. Prices in
+// different currencies never match.
+func (t PriceTolerance) Allows(expected, current money.Money) bool {
+	diff, err := current.Sub(expected)
+	if err != nil {
+		return false
+	}
+	if diff.Amount < 0 {
+		diff.Amount = -diff.Amount
+	}
+	allowed := expected.MulRate(t.BasisPoints, 10000).Amount
+	if allowed < 0 {
+		allowed = -allowed
+	}
+	return diff.Amount <= max(allowed, t.MinorUnits)
+}
+
+// PriceMismatch is a reservation line whose product no longer has the
+// expected price.
+type PriceMismatch struct {
+	ProductID UUID
+	Expected  money.Money
+	Current   money.Money
+}
+
+// PriceMismatchError lists every line of a batch whose price drifted too far.
+// It unwraps to ErrPriceMismatch.
+type PriceMismatchError struct {
+	Mismatches []PriceMismatch
+}
+
+func (e *PriceMismatchError) Error() string {
+	parts := make([]string, 0, len(e.Mismatches))
+	for _, m := range e.Mismatches {
+		parts = append(parts, fmt.Sprintf("product %s: expected %s, now %s", m.ProductID, m.Expected, m.Current))
+	}
+	return fmt.Sprintf("%v: %s", ErrPriceMismatch, strings.Join(parts, "; "))
+}
+
+func (e *PriceMismatchError) Unwrap() error {
+	return ErrPriceMismatch
+}
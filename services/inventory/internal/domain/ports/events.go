@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+// EventPublisher delivers outbox events to their consumers. Publish may be
+// called again for an event it already delivered.
+type EventPublisher interface {
+	Publish(ctx context.Context, event entity.Event) error
+}
+
+type OutboxRepository interface {
+	// PublishOutboxEvents locks up to limit unpublished events, oldest first,
+	// and hands them to publishFn. publishFn returns how many of them it
+	// delivered, in order; those are marked published even when it also
+	// returns an error, and the rest stay in the outbox for the next call.
+	PublishOutboxEvents(ctx context.Context, limit int, publishFn func([]entity.Event) (int, error)) (int, error)
+}
@@ -14,4 +14,5 @@ type InventoryRepository interface {
 	GetTotalCount(ctx context.Context) (int64, error)
 	GetAllInventoryItems(ctx context.Context, pagination *entity.Pagination) ([]*entity.InventoryItem, error)
 	CategoryRepository
+	ReservationRepository
 }
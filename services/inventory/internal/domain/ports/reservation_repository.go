@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+type ReservationRepository interface {
+	// CreateReservations locks the products, lets createFn adjust them and
+	// stores the reservations it returns in the same transaction.
+	CreateReservations(ctx context.Context, productIDs []entity.UUID, createFn func([]*entity.InventoryItem) ([]entity.Reservation, error)) error
+	// UpdateReservations locks the reservations matching filter together with
+	// their products, keyed by product ID, and stores both after updateFn.
+	UpdateReservations(ctx context.Context, filter entity.ReservationFilter, updateFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) (bool, error)) error
+	// ApplyStockRelease records the release and lets applyFn adjust the
+	// order's held and confirmed reservations and the released products in
+	// one transaction.
+	// It returns entity.ErrReleaseAlreadyApplied if the release ID was seen before.
+	ApplyStockRelease(ctx context.Context, release entity.StockRelease, applyFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) error) error
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/outbound/database"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/config"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
 	"github.com/ExonegeS/prettyslog"
 
 	_ "github.com/lib/pq"
@@ -31,7 +32,7 @@ func TestHTTPGetInventoryItem(t *testing.T) {
 	logger := prettyslog.SetupPrettySlog(os.Stdout)
 
 	inventoryRepo := database.NewPostgresInventoryRepository(db)
-	service := application.NewInventoryService(inventoryRepo, time.Now)
+	service := application.NewInventoryService(inventoryRepo, time.Minute, entity.PriceTolerance{}, time.Now)
 	handler := rest.NewInventoryHandler(service, logger)
 
 	mux := http.NewServeMux()
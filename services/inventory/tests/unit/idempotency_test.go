@@ -0,0 +1,122 @@
+package unit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	invgrpc "github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/inbound/grpc"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+type mockIdempotencyRepository struct {
+	records map[string]*entity.IdempotencyRecord
+}
+
+func (m *mockIdempotencyRepository) ClaimIdempotencyKey(ctx context.Context, record entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	id := record.Scope + "|" + record.Key
+	if existing, ok := m.records[id]; ok && existing.IsLive(record.CreatedAt) {
+		copied := *existing
+		return &copied, nil
+	}
+	m.records[id] = &record
+	return nil, nil
+}
+
+func (m *mockIdempotencyRepository) RenewIdempotencyKey(ctx context.Context, scope, key string, expiresAt time.Time) error {
+	if record, ok := m.records[scope+"|"+key]; ok && !record.Completed {
+		record.ExpiresAt = expiresAt
+	}
+	return nil
+}
+
+func (m *mockIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, scope, key string, response []byte, expiresAt time.Time) error {
+	record := m.records[scope+"|"+key]
+	record.Completed = true
+	record.Response = response
+	record.ExpiresAt = expiresAt
+	return nil
+}
+
+func (m *mockIdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, scope, key string) error {
+	if record, ok := m.records[scope+"|"+key]; ok && !record.Completed {
+		delete(m.records, scope+"|"+key)
+	}
+	return nil
+}
+
+func TestReserveProducts_IdempotencyKey(t *testing.T) {
+	repo := &mockIdempotencyRepository{records: make(map[string]*entity.IdempotencyRecord)}
+	service := application.NewIdempotencyService(repo, time.Hour, time.Now)
+	interceptor := invgrpc.NewIdempotencyInterceptor(service, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	reserved := 0
+	reserve := func(key string, req *invgrpc.ReserveProductRequest) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(invgrpc.IdempotencyKeyHeader, key))
+		info := &grpc.UnaryServerInfo{FullMethod: invgrpc.InventoryService_ReserveProducts_FullMethodName}
+		_, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			reserved += int(req.(*invgrpc.ReserveProductRequest).GetQuantity())
+			return &invgrpc.Empty{}, nil
+		})
+		return err
+	}
+
+	req := &invgrpc.ReserveProductRequest{Id: "p1", Quantity: 2}
+	for i := 0; i < 3; i++ {
+		if err := reserve("key-1", req); err != nil {
+			t.Fatalf("attempt %d: expected no error, got %v", i+1, err)
+		}
+	}
+	if reserved != 2 {
+		t.Errorf("expected retries to reserve once, got %d reserved", reserved)
+	}
+
+	err := reserve("key-1", &invgrpc.ReserveProductRequest{Id: "p1", Quantity: 5})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists for a reused key, got %v", err)
+	}
+}
+
+func TestReleaseProducts_IdempotencyKeyAfterFailure(t *testing.T) {
+	repo := &mockIdempotencyRepository{records: make(map[string]*entity.IdempotencyRecord)}
+	service := application.NewIdempotencyService(repo, time.Hour, time.Now)
+	interceptor := invgrpc.NewIdempotencyInterceptor(service, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	calls := 0
+	release := func(key string, handlerErr error, withResponse bool) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(invgrpc.IdempotencyKeyHeader, key))
+		info := &grpc.UnaryServerInfo{FullMethod: invgrpc.InventoryService_ReleaseProducts_FullMethodName}
+		_, err := interceptor(ctx, &invgrpc.ReleaseProductRequest{}, info, func(ctx context.Context, req any) (any, error) {
+			calls++
+			if !withResponse {
+				return nil, handlerErr
+			}
+			return &invgrpc.Empty{}, handlerErr
+		})
+		return err
+	}
+
+	// A failure without a response made no change, so the key is free again.
+	if err := release("key-1", status.Error(codes.Unavailable, "database unavailable"), false); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected the handler's error, got %v", err)
+	}
+	if err := release("key-1", nil, true); err != nil || calls != 2 {
+		t.Fatalf("expected the retry to run, got %d calls: %v", calls, err)
+	}
+
+	// A failure with a response made its change, so a retry replays it.
+	if err := release("key-2", status.Error(codes.Internal, "released, but not every step finished"), true); status.Code(err) != codes.Internal {
+		t.Fatalf("expected the handler's error, got %v", err)
+	}
+	if err := release("key-2", nil, true); err != nil || calls != 3 {
+		t.Errorf("expected the retry to be replayed, got %d calls: %v", calls, err)
+	}
+}
@@ -3,17 +3,22 @@ package unit
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/money"
 )
 
 type mockInventoryRepository struct {
-	getByIDFunc func(ctx context.Context, id entity.UUID) (*entity.InventoryItem, error)
-	saveFunc    func(ctx context.Context, item entity.InventoryItem) error
-	updateFunc  func(ctx context.Context, id entity.UUID, updateFn func(*entity.InventoryItem) (bool, error)) error
+	getByIDFunc            func(ctx context.Context, id entity.UUID) (*entity.InventoryItem, error)
+	saveFunc               func(ctx context.Context, item entity.InventoryItem) error
+	updateFunc             func(ctx context.Context, id entity.UUID, updateFn func(*entity.InventoryItem) (bool, error)) error
+	createReservationsFunc func(ctx context.Context, ids []entity.UUID, createFn func([]*entity.InventoryItem) ([]entity.Reservation, error)) error
+	updateReservationsFunc func(ctx context.Context, filter entity.ReservationFilter, updateFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) (bool, error)) error
+	applyStockReleaseFunc  func(ctx context.Context, release entity.StockRelease, applyFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) error) error
 }
 
 func (m *mockInventoryRepository) GetByID(ctx context.Context, id entity.UUID) (*entity.InventoryItem, error) {
@@ -57,6 +62,15 @@ func (m mockInventoryRepository) GetAllCategories(ctx context.Context, paginatio
 func (m *mockInventoryRepository) GetTotalCategoriesCount(ctx context.Context) (int64, error) {
 	return 0, nil
 }
+func (m *mockInventoryRepository) CreateReservations(ctx context.Context, ids []entity.UUID, createFn func([]*entity.InventoryItem) ([]entity.Reservation, error)) error {
+	return m.createReservationsFunc(ctx, ids, createFn)
+}
+func (m *mockInventoryRepository) UpdateReservations(ctx context.Context, filter entity.ReservationFilter, updateFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) (bool, error)) error {
+	return m.updateReservationsFunc(ctx, filter, updateFn)
+}
+func (m *mockInventoryRepository) ApplyStockRelease(ctx context.Context, release entity.StockRelease, applyFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) error) error {
+	return m.applyStockReleaseFunc(ctx, release, applyFn)
+}
 
 func TestGetInventoryItemByID_Success(t *testing.T) {
 	expectedItem := &entity.InventoryItem{
@@ -64,7 +78,7 @@ func TestGetInventoryItemByID_Success(t *testing.T) {
 		Name:     "Test Item",
 		Quantity: 10,
 		Unit:     "pcs",
-		Price:    19.99,
+		Price:    money.New(1999, "USD"),
 	}
 	repo := &mockInventoryRepository{
 		getByIDFunc: func(ctx context.Context, id entity.UUID) (*entity.InventoryItem, error) {
@@ -75,7 +89,7 @@ func TestGetInventoryItemByID_Success(t *testing.T) {
 		},
 	}
 
-	service := application.NewInventoryService(repo, nil)
+	service := application.NewInventoryService(repo, time.Minute, entity.PriceTolerance{}, nil)
 	item, err := service.GetInventoryItemByID(context.Background(), "1")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -91,7 +105,7 @@ func TestGetInventoryItemByID_NotFound(t *testing.T) {
 			return nil, entity.ErrItemNotFound
 		},
 	}
-	service := application.NewInventoryService(repo, nil)
+	service := application.NewInventoryService(repo, time.Minute, entity.PriceTolerance{}, nil)
 	_, err := service.GetInventoryItemByID(context.Background(), "")
 	if err == nil {
 		t.Fatalf("expected error, got nil")
@@ -107,7 +121,7 @@ func TestCreateInventoryItem_Success(t *testing.T) {
 		Description: "Test Description",
 		Quantity:    10,
 		Unit:        "pcs",
-		Price:       19.99,
+		Price:       money.New(1999, "USD"),
 	}
 	repo := &mockInventoryRepository{
 		saveFunc: func(ctx context.Context, item entity.InventoryItem) error {
@@ -124,10 +138,464 @@ func TestCreateInventoryItem_Success(t *testing.T) {
 	timeSource := func() time.Time {
 		return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	}
-	service := application.NewInventoryService(repo, timeSource)
+	service := application.NewInventoryService(repo, time.Minute, entity.PriceTolerance{}, timeSource)
 	err := service.CreateInventoryItem(context.Background(), &expectedItem)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
 }
+
+// reservationStore keeps products and reservations in memory. Updates work on
+// copies, so a rejected call leaves the stored state untouched.
+type reservationStore struct {
+	items        map[entity.UUID]*entity.InventoryItem
+	reservations []*entity.Reservation
+	releases     map[string]bool
+}
+
+func (s *reservationStore) repository() *mockInventoryRepository {
+	return &mockInventoryRepository{
+		createReservationsFunc: func(ctx context.Context, ids []entity.UUID, createFn func([]*entity.InventoryItem) ([]entity.Reservation, error)) error {
+			sorted := append([]entity.UUID(nil), ids...)
+			slices.Sort(sorted)
+
+			copies := make([]*entity.InventoryItem, 0, len(sorted))
+			for _, id := range sorted {
+				item, ok := s.items[id]
+				if !ok {
+					return &entity.ReservationError{ProductID: id, Err: entity.ErrItemNotFound}
+				}
+				copied := *item
+				copies = append(copies, &copied)
+			}
+			reservations, err := createFn(copies)
+			if err != nil {
+				return err
+			}
+			for _, item := range copies {
+				s.items[item.ID] = item
+			}
+			for _, reservation := range reservations {
+				s.reservations = append(s.reservations, &reservation)
+			}
+			return nil
+		},
+		updateReservationsFunc: func(ctx context.Context, filter entity.ReservationFilter, updateFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) (bool, error)) error {
+			var matched []*entity.Reservation
+			items := make(map[entity.UUID]*entity.InventoryItem)
+			for _, reservation := range s.reservations {
+				if filter.OrderID != "" && reservation.OrderID != filter.OrderID {
+					continue
+				}
+				if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, reservation.Status) {
+					continue
+				}
+				if !filter.ExpiredBefore.IsZero() && !reservation.ExpiresAt.Before(filter.ExpiredBefore) {
+					continue
+				}
+				copied := *reservation
+				matched = append(matched, &copied)
+				item := *s.items[reservation.ProductID]
+				items[item.ID] = &item
+			}
+
+			updated, err := updateFn(matched, items)
+			if err != nil {
+				return err
+			}
+			if !updated {
+				return entity.ErrNotUpdated
+			}
+			for id, item := range items {
+				s.items[id] = item
+			}
+			s.storeReservations(matched)
+			return nil
+		},
+		applyStockReleaseFunc: func(ctx context.Context, release entity.StockRelease, applyFn func([]*entity.Reservation, map[entity.UUID]*entity.InventoryItem) error) error {
+			if s.releases[release.ID] {
+				return entity.ErrReleaseAlreadyApplied
+			}
+
+			var holds []*entity.Reservation
+			for _, reservation := range s.reservations {
+				if reservation.OrderID != release.OrderID {
+					continue
+				}
+				if reservation.Status == entity.ReservationHeld || reservation.Status == entity.ReservationConfirmed {
+					copied := *reservation
+					holds = append(holds, &copied)
+				}
+			}
+			items := make(map[entity.UUID]*entity.InventoryItem)
+			for _, line := range release.Lines {
+				item, ok := s.items[line.ProductID]
+				if !ok {
+					return &entity.ReservationError{ProductID: line.ProductID, Err: entity.ErrItemNotFound}
+				}
+				copied := *item
+				items[item.ID] = &copied
+			}
+
+			if err := applyFn(holds, items); err != nil {
+				return err
+			}
+			for id, item := range items {
+				s.items[id] = item
+			}
+			s.storeReservations(holds)
+			if s.releases == nil {
+				s.releases = make(map[string]bool)
+			}
+			s.releases[release.ID] = true
+			return nil
+		},
+	}
+}
+
+func (s *reservationStore) storeReservations(updated []*entity.Reservation) {
+	for _, reservation := range updated {
+		for i := range s.reservations {
+			if s.reservations[i].ID == reservation.ID {
+				s.reservations[i] = reservation
+			}
+		}
+	}
+}
+
+func TestReserveProducts_HoldsStock(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"b": {ID: "b", Quantity: 5},
+		"a": {ID: "a", Quantity: 3},
+	}}
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, time.Now)
+
+	results, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{
+		{OrderID: "o1", ProductID: "b", Quantity: 2},
+		{OrderID: "o1", ProductID: "a", Quantity: 1},
+		{OrderID: "o1", ProductID: "b", Quantity: 1},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].ProductID != "b" || results[0].Quantity != 3 || results[0].Remaining != 2 {
+		t.Errorf("unexpected result for product b: %+v", results[0])
+	}
+	if items := store.items; items["a"].Quantity != 3 || items["b"].Quantity != 5 {
+		t.Errorf("expected stock on hand to be untouched: a=%v b=%v", items["a"].Quantity, items["b"].Quantity)
+	}
+	if items := store.items; items["a"].Reserved != 1 || items["b"].Reserved != 3 {
+		t.Errorf("unexpected reserved stock: a=%v b=%v", items["a"].Reserved, items["b"].Reserved)
+	}
+	if len(store.reservations) != 2 {
+		t.Fatalf("expected 2 stored reservations, got %d", len(store.reservations))
+	}
+}
+
+func TestReserveProducts_RejectsWholeBatch(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 3},
+		"b": {ID: "b", Quantity: 3, Reserved: 2},
+	}}
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, time.Now)
+
+	_, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{
+		{OrderID: "o1", ProductID: "a", Quantity: 1},
+		{OrderID: "o1", ProductID: "b", Quantity: 2},
+	})
+	if !errors.Is(err, entity.ErrInsufficientQuantity) {
+		t.Fatalf("expected ErrInsufficientQuantity, got %v", err)
+	}
+	var reservationErr *entity.ReservationError
+	if !errors.As(err, &reservationErr) || reservationErr.ProductID != "b" {
+		t.Errorf("expected error for product b, got %v", err)
+	}
+	if store.items["a"].Reserved != 0 || store.items["b"].Reserved != 2 {
+		t.Errorf("expected reserved stock to be untouched: a=%v b=%v", store.items["a"].Reserved, store.items["b"].Reserved)
+	}
+	if len(store.reservations) != 0 {
+		t.Errorf("expected no reservations, got %d", len(store.reservations))
+	}
+}
+
+func TestReserveProducts_RejectsPriceDrift(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 3, Price: money.New(1000, "USD")},
+		"b": {ID: "b", Quantity: 3, Price: money.New(2500, "USD")},
+	}}
+	tolerance := entity.PriceTolerance{MinorUnits: 5}
+	service := application.NewInventoryService(store.repository(), time.Minute, tolerance, time.Now)
+
+	quoteA := money.New(1003, "USD")
+	quoteB := money.New(2000, "USD")
+	_, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{
+		{OrderID: "o1", ProductID: "a", Quantity: 1, ExpectedPrice: &quoteA},
+		{OrderID: "o1", ProductID: "b", Quantity: 1, ExpectedPrice: &quoteB},
+	})
+	if !errors.Is(err, entity.ErrPriceMismatch) {
+		t.Fatalf("expected ErrPriceMismatch, got %v", err)
+	}
+	var mismatch *entity.PriceMismatchError
+	if !errors.As(err, &mismatch) || len(mismatch.Mismatches) != 1 {
+		t.Fatalf("expected one mismatch, got %v", err)
+	}
+	if m := mismatch.Mismatches[0]; m.ProductID != "b" || m.Current != money.New(2500, "USD") {
+		t.Errorf("unexpected mismatch: %+v", m)
+	}
+	if store.items["a"].Reserved != 0 || len(store.reservations) != 0 {
+		t.Errorf("expected nothing to be held, got reserved=%v reservations=%d", store.items["a"].Reserved, len(store.reservations))
+	}
+
+	// Within the tolerance the quote is still honoured.
+	_, err = service.ReserveProducts(context.Background(), []entity.ReservationLine{
+		{OrderID: "o2", ProductID: "a", Quantity: 1, ExpectedPrice: &quoteA},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestPriceTolerance_Allows(t *testing.T) {
+	tests := []struct {
+		tolerance         entity.PriceTolerance
+		expected, current money.Money
+		allowed           bool
+	}{
+		{entity.PriceTolerance{}, money.New(1000, "USD"), money.New(1000, "USD"), true},
+		{entity.PriceTolerance{}, money.New(1000, "USD"), money.New(999, "USD"), false},
+		{entity.PriceTolerance{MinorUnits: 10}, money.New(1000, "USD"), money.New(990, "USD"), true},
+		{entity.PriceTolerance{BasisPoints: 100}, money.New(1000, "USD"), money.New(1010, "USD"), true},
+		{entity.PriceTolerance{BasisPoints: 100}, money.New(1000, "USD"), money.New(1011, "USD"), false},
+		{entity.PriceTolerance{MinorUnits: 1000}, money.New(1000, "USD"), money.New(1000, "EUR"), false},
+	}
+	for _, tt := range tests {
+		if got := tt.tolerance.Allows(tt.expected, tt.current); got != tt.allowed {
+			t.Errorf("%+v: %v -> %v: expected %v, got %v", tt.tolerance, tt.expected, tt.current, tt.allowed, got)
+		}
+	}
+}
+
+func TestConfirmReservation(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 5},
+	}}
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, time.Now)
+
+	_, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 2}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.ConfirmReservation(context.Background(), "o1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if item := store.items["a"]; item.Quantity != 3 || item.Reserved != 0 {
+		t.Errorf("expected confirmed stock to be deducted, quantity %v reserved %v", item.Quantity, item.Reserved)
+	}
+	if status := store.reservations[0].Status; status != entity.ReservationConfirmed {
+		t.Errorf("expected confirmed reservation, got %s", status)
+	}
+
+	if err := service.ConfirmReservation(context.Background(), "o1"); err != nil {
+		t.Errorf("expected repeated confirmation to be a no-op, got %v", err)
+	}
+	if err := service.ConfirmReservation(context.Background(), "o2"); !errors.Is(err, entity.ErrReservationNotFound) {
+		t.Errorf("expected ErrReservationNotFound, got %v", err)
+	}
+}
+
+func TestReleaseReservation(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 5},
+	}}
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, time.Now)
+
+	_, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 2}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.ReleaseReservation(context.Background(), "o1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if item := store.items["a"]; item.Quantity != 5 || item.Reserved != 0 {
+		t.Errorf("expected released stock to be available again, quantity %v reserved %v", item.Quantity, item.Reserved)
+	}
+	if status := store.reservations[0].Status; status != entity.ReservationReleased {
+		t.Errorf("expected released reservation, got %s", status)
+	}
+}
+
+func TestExpireReservations(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 5},
+	}}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, func() time.Time { return now })
+
+	_, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 2}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if expired, err := service.ExpireReservations(context.Background(), 10); err != nil || expired != 0 {
+		t.Fatalf("expected nothing to expire yet, got %d (%v)", expired, err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	expired, err := service.ExpireReservations(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expired != 1 {
+		t.Errorf("expected 1 expired reservation, got %d", expired)
+	}
+	if item := store.items["a"]; item.Reserved != 0 {
+		t.Errorf("expected expired hold to be returned, reserved %v", item.Reserved)
+	}
+	if err := service.ConfirmReservation(context.Background(), "o1"); !errors.Is(err, entity.ErrReservationNotHeld) {
+		t.Errorf("expected ErrReservationNotHeld, got %v", err)
+	}
+}
+
+func TestConfirmReservation_SkipsReleasedHolds(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 5},
+	}}
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, time.Now)
+	ctx := context.Background()
+
+	if _, err := service.ReserveProducts(ctx, []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 2}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.ReleaseReservation(ctx, "o1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.ReserveProducts(ctx, []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 1}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := service.ConfirmReservation(ctx, "o1"); err != nil {
+		t.Fatalf("expected the released hold to be skipped, got %v", err)
+	}
+	if item := store.items["a"]; item.Quantity != 4 || item.Reserved != 0 {
+		t.Errorf("expected only the held unit to be deducted, got quantity %v, reserved %v", item.Quantity, item.Reserved)
+	}
+}
+
+func TestReleaseProducts_AppliesOnce(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 5},
+		"b": {ID: "b", Quantity: 4},
+	}}
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, time.Now)
+
+	_, err := service.ReserveProducts(context.Background(), []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 2}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.ConfirmReservation(context.Background(), "o1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err = service.ReserveProducts(context.Background(), []entity.ReservationLine{{OrderID: "o1", ProductID: "b", Quantity: 3}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	release := entity.StockRelease{
+		ID:      "cancel:o1",
+		OrderID: "o1",
+		Lines: []entity.ReservationLine{
+			{ProductID: "a", Quantity: 2},
+			{ProductID: "b", Quantity: 3},
+		},
+	}
+	for i := 0; i < 3; i++ {
+		if err := service.ReleaseProducts(context.Background(), release); err != nil {
+			t.Fatalf("attempt %d: expected no error, got %v", i+1, err)
+		}
+	}
+
+	if item := store.items["a"]; item.Quantity != 5 || item.Reserved != 0 {
+		t.Errorf("expected confirmed stock to be credited once, quantity %v reserved %v", item.Quantity, item.Reserved)
+	}
+	if item := store.items["b"]; item.Quantity != 4 || item.Reserved != 0 {
+		t.Errorf("expected held stock to be released without a credit, quantity %v reserved %v", item.Quantity, item.Reserved)
+	}
+	if hold := store.reservations[1]; hold.Status != entity.ReservationReleased || hold.Quantity != 3 || hold.Released != 3 {
+		t.Errorf("expected a released reservation keeping its quantity, got %+v", hold)
+	}
+}
+
+func TestReleaseProducts_CreditsNoMoreThanTaken(t *testing.T) {
+	store := &reservationStore{items: map[entity.UUID]*entity.InventoryItem{
+		"a": {ID: "a", Quantity: 5},
+	}}
+	now := time.Now()
+	service := application.NewInventoryService(store.repository(), time.Minute, entity.PriceTolerance{}, func() time.Time { return now })
+	ctx := context.Background()
+
+	if _, err := service.ReserveProducts(ctx, []entity.ReservationLine{{OrderID: "o1", ProductID: "a", Quantity: 3}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.ConfirmReservation(ctx, "o1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	release := func(id string, quantity int64) error {
+		return service.ReleaseProducts(ctx, entity.StockRelease{
+			ID:      id,
+			OrderID: "o1",
+			Lines:   []entity.ReservationLine{{ProductID: "a", Quantity: quantity}},
+		})
+	}
+
+	if err := release("return:r1", 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := release("cancel:o1", 2); !errors.Is(err, entity.ErrReleaseExceedsTaken) {
+		t.Fatalf("expected ErrReleaseExceedsTaken, got %v", err)
+	}
+	if err := release("cancel:o1", 1); err != nil {
+		t.Fatalf("expected the rejected release ID to stay usable, got %v", err)
+	}
+	if err := release("return:r2", 1); !errors.Is(err, entity.ErrReleaseExceedsTaken) {
+		t.Fatalf("expected ErrReleaseExceedsTaken, got %v", err)
+	}
+	if item := store.items["a"]; item.Quantity != 5 {
+		t.Errorf("expected only the taken stock to be credited, got quantity %v", item.Quantity)
+	}
+
+	// Holds that expired gave their stock back already.
+	if _, err := service.ReserveProducts(ctx, []entity.ReservationLine{{OrderID: "o2", ProductID: "a", Quantity: 2}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := service.ExpireReservations(ctx, 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err := service.ReleaseProducts(ctx, entity.StockRelease{
+		ID:      "cancel:o2",
+		OrderID: "o2",
+		Lines:   []entity.ReservationLine{{ProductID: "a", Quantity: 2}},
+	})
+	if !errors.Is(err, entity.ErrReleaseExceedsTaken) {
+		t.Fatalf("expected ErrReleaseExceedsTaken, got %v", err)
+	}
+	if item := store.items["a"]; item.Quantity != 5 || item.Reserved != 0 {
+		t.Errorf("expected no stock to be created, got quantity %v, reserved %v", item.Quantity, item.Reserved)
+	}
+}
+
+func TestReleaseProducts_RequiresReleaseID(t *testing.T) {
+	service := application.NewInventoryService(&mockInventoryRepository{}, time.Minute, entity.PriceTolerance{}, time.Now)
+
+	err := service.ReleaseProducts(context.Background(), entity.StockRelease{
+		OrderID: "o1",
+		Lines:   []entity.ReservationLine{{ProductID: "a", Quantity: 1}},
+	})
+	if !errors.Is(err, entity.ErrInvalidRequestPayload) {
+		t.Errorf("expected ErrInvalidRequestPayload, got %v", err)
+	}
+}
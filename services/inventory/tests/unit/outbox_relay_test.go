@@ -0,0 +1,76 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/outbound/events"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+)
+
+type mockOutboxRepository struct {
+	events    []entity.Event
+	published map[entity.UUID]bool
+}
+
+func (m *mockOutboxRepository) PublishOutboxEvents(ctx context.Context, limit int, publishFn func([]entity.Event) (int, error)) (int, error) {
+	var batch []entity.Event
+	for _, event := range m.events {
+		if !m.published[event.ID] && len(batch) < limit {
+			batch = append(batch, event)
+		}
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+	n, err := publishFn(batch)
+	for _, event := range batch[:n] {
+		m.published[event.ID] = true
+	}
+	return n, err
+}
+
+// failOncePublisher rejects its first call and forwards the rest.
+type failOncePublisher struct {
+	failed bool
+	next   *events.MemoryPublisher
+}
+
+func (p *failOncePublisher) Publish(ctx context.Context, event entity.Event) error {
+	if !p.failed {
+		p.failed = true
+		return errors.New("broker unavailable")
+	}
+	return p.next.Publish(ctx, event)
+}
+
+func TestOutboxRelay_RedeliversAfterPublishFailure(t *testing.T) {
+	var stored []entity.Event
+	for _, id := range []string{"p1", "p2"} {
+		event, err := entity.NewEvent(entity.AggregateProduct, id, entity.EventStockChanged, map[string]string{"product_id": id}, time.Now())
+		if err != nil {
+			t.Fatalf("NewEvent: %v", err)
+		}
+		stored = append(stored, event)
+	}
+	repo := &mockOutboxRepository{events: stored, published: make(map[entity.UUID]bool)}
+	memory := events.NewMemoryPublisher()
+	relay := application.NewOutboxRelay(repo, &failOncePublisher{next: memory}, time.Second, 10, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	if published, err := relay.RelayBatch(context.Background()); err == nil || published != 0 {
+		t.Fatalf("expected the first batch to fail without publishing, got %d, %v", published, err)
+	}
+	if published, err := relay.RelayBatch(context.Background()); err != nil || published != 2 {
+		t.Fatalf("expected both events on retry, got %d, %v", published, err)
+	}
+
+	got := memory.Events()
+	if len(got) != 2 || got[0].ID != stored[0].ID || got[1].ID != stored[1].ID {
+		t.Fatalf("expected both events in order, got %v", got)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/adapters/inbound/rest"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/inventory/internal/domain/entity"
+	"github.com/ExonegeS/money"
 	"github.com/ExonegeS/prettyslog"
 )
 
@@ -61,6 +62,21 @@ func (m *mockInventoryService) GetPaginatedCategories(ctx context.Context, pagin
 func (m *mockInventoryService) ReserveProduct(context.Context, entity.UUID, int64) error {
 	return nil
 }
+func (m *mockInventoryService) ReleaseProducts(context.Context, entity.StockRelease) error {
+	return nil
+}
+func (m *mockInventoryService) ReserveProducts(context.Context, []entity.ReservationLine) ([]entity.ReservationResult, error) {
+	return nil, nil
+}
+func (m *mockInventoryService) ConfirmReservation(context.Context, entity.UUID) error {
+	return nil
+}
+func (m *mockInventoryService) ReleaseReservation(context.Context, entity.UUID) error {
+	return nil
+}
+func (m *mockInventoryService) ExpireReservations(context.Context, int) (int, error) {
+	return 0, nil
+}
 
 // Test when a valid id is provided and the service returns a valid inventory item.
 func TestGetInventoryItemById_Handler_Success(t *testing.T) {
@@ -71,7 +87,7 @@ func TestGetInventoryItemById_Handler_Success(t *testing.T) {
 				Name:        "Fake Item",
 				Description: "This is a fake item",
 				Category:    entity.Category{},
-				Price:       10.0,
+				Price:       money.New(1000, "USD"),
 				Quantity:    100,
 				Unit:        "pcs",
 				CreatedAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
@@ -96,11 +112,11 @@ func TestGetInventoryItemById_Handler_Success(t *testing.T) {
 		t.Errorf("expected status code 200, got %d", res.StatusCode)
 	}
 
-	var item entity.InventoryItem
+	var item rest.InventoryItemResponse
 	if err := json.NewDecoder(res.Body).Decode(&item); err != nil {
 		t.Errorf("error decoding JSON response: %v", err)
 	}
-	if item.ID != entity.UUID(id) || item.Name != "Fake Item" {
+	if item.ID != entity.UUID(id) || item.Name != "Fake Item" || item.Price != 10 || item.Currency != "USD" {
 		t.Errorf("unexpected item returned: %+v", item)
 	}
 }
@@ -113,7 +129,7 @@ func TestGetInventoryItemById_Handler_InvalidID_1(t *testing.T) {
 				Name:        "Fake Item",
 				Description: "This is a fake item",
 				Category:    entity.Category{},
-				Price:       10.0,
+				Price:       money.New(1000, "USD"),
 				Quantity:    100,
 				Unit:        "pcs",
 				CreatedAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
@@ -182,7 +198,7 @@ func TestGetInventoryItemById_Handler_InvalidID_3(t *testing.T) {
 				Name:        "Fake Item",
 				Description: "This is a fake item",
 				Category:    entity.Category{},
-				Price:       10.0,
+				Price:       money.New(1000, "USD"),
 				Quantity:    100,
 				Unit:        "pcs",
 				CreatedAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
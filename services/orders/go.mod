@@ -4,8 +4,12 @@ go 1.24.2
 
 replace github.com/ExonegeS/prettyslog => ../pkg/lib/prettyslog
 
+replace github.com/ExonegeS/money => ../pkg/lib/money
+
 require github.com/ExonegeS/prettyslog v0.0.0-00010101000000-000000000000
 
+require github.com/ExonegeS/money v0.0.0-00010101000000-000000000000
+
 require (
 	github.com/google/uuid v1.6.0
 	github.com/lib/pq v1.10.9
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ActorHeader is the metadata key naming who makes a request. Status changes
+// the request causes are attributed to it in the order's history.
+const ActorHeader = "actor"
+
+const maxActorLength = 255
+
+// NewActorInterceptor carries the actor header of a request into its context.
+// The header is trusted as sent: the gRPC port is only reachable by internal
+// callers, and the gateway sets it itself rather than passing on what its
+// clients send.
+func NewActorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(ActorHeader); len(values) > 0 && values[0] != "" {
+				actor := values[0]
+				if len(actor) > maxActorLength {
+					actor = actor[:maxActorLength]
+				}
+				ctx = application.WithActor(ctx, actor)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// IdempotencyKeyHeader is the metadata key an idempotency key is sent in.
+// Requests with an idempotency_key field may carry it there instead.
+const IdempotencyKeyHeader = "idempotency-key"
+
+const maxIdempotencyKeyLength = 255
+
+// idempotentMethods are the RPCs that honour an idempotency key.
+var idempotentMethods = map[string]bool{
+	OrdersService_CreateOrder_FullMethodName:         true,
+	OrdersService_UpdateOrder_FullMethodName:         true,
+	OrdersService_DeleteOrder_FullMethodName:         true,
+	OrdersService_CancelOrder_FullMethodName:         true,
+	OrdersService_CreatePaymentIntent_FullMethodName: true,
+	OrdersService_AuthorizePayment_FullMethodName:    true,
+	OrdersService_CapturePayment_FullMethodName:      true,
+	OrdersService_RefundOrder_FullMethodName:         true,
+	OrdersService_Checkout_FullMethodName:            true,
+	OrdersService_CreateSubscription_FullMethodName:  true,
+}
+
+// NewIdempotencyInterceptor replays the stored response of a request retried
+// with the same idempotency key, and rejects the key with AlreadyExists when
+// it comes back with a different request. Requests without a key run as usual.
+//
+// A handler that fails after making its change returns its response along
+// with the error. The client gets the error, but the key is spent: a retry
+// replays the response rather than making the change again.
+func NewIdempotencyInterceptor(service application.IdempotencyService, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok || !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		key := idempotencyKey(ctx, req)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, status.Error(codes.InvalidArgument, "idempotency key is too long")
+		}
+
+		fingerprint, err := requestFingerprint(msg)
+		if err != nil {
+			logger.Error("Failed to fingerprint request", "method", info.FullMethod, "error", err)
+			return nil, status.Error(codes.Internal, "failed to process idempotency key")
+		}
+
+		var resp any
+		var handlerErr error
+		stored, replayed, err := service.Execute(ctx, info.FullMethod, key, fingerprint, func(ctx context.Context) ([]byte, error) {
+			out, err := handler(ctx, req)
+			handlerErr = err
+			if msg, ok := out.(proto.Message); !ok || !msg.ProtoReflect().IsValid() {
+				return nil, err
+			}
+			resp = out
+			encoded, encodeErr := encodeResponse(out)
+			if encodeErr != nil {
+				return nil, errors.Join(err, encodeErr)
+			}
+			return encoded, err
+		})
+		switch {
+		case errors.Is(err, entity.ErrIdempotencyKeyReused):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, entity.ErrIdempotencyKeyInProgress):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case handlerErr != nil:
+			if err != handlerErr {
+				logger.Error("Failed to store idempotent response", "method", info.FullMethod, "error", err)
+			}
+			return nil, handlerErr
+		case err != nil && resp != nil:
+			// The request went through; only remembering it failed.
+			logger.Error("Failed to store idempotent response", "method", info.FullMethod, "error", err)
+			return resp, nil
+		case err != nil:
+			return nil, err
+		case !replayed:
+			return resp, nil
+		}
+
+		logger.Info("Replaying idempotent request", "method", info.FullMethod)
+		replay, err := decodeResponse(stored)
+		if err != nil {
+			logger.Error("Failed to decode stored response", "method", info.FullMethod, "error", err)
+			return nil, status.Error(codes.Internal, "failed to replay request")
+		}
+		return replay, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context, req any) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(IdempotencyKeyHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	if r, ok := req.(interface{ GetIdempotencyKey() string }); ok {
+		return r.GetIdempotencyKey()
+	}
+	return ""
+}
+
+func requestFingerprint(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// encodeResponse keeps the response's type with it, so a replay can rebuild
+// the message without knowing which RPC produced it.
+func encodeResponse(resp any) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, errors.New("response is not a protobuf message")
+	}
+	packed, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(packed)
+}
+
+func decodeResponse(data []byte) (proto.Message, error) {
+	var packed anypb.Any
+	if err := proto.Unmarshal(data, &packed); err != nil {
+		return nil, err
+	}
+	return packed.UnmarshalNew()
+}
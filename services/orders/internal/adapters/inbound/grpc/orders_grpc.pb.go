@@ -19,11 +19,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrdersService_CreateOrder_FullMethodName  = "/orders.OrdersService/CreateOrder"
-	OrdersService_GetOrderByID_FullMethodName = "/orders.OrdersService/GetOrderByID"
-	OrdersService_UpdateOrder_FullMethodName  = "/orders.OrdersService/UpdateOrder"
-	OrdersService_DeleteOrder_FullMethodName  = "/orders.OrdersService/DeleteOrder"
-	OrdersService_ListOrders_FullMethodName   = "/orders.OrdersService/ListOrders"
+	OrdersService_CreateOrder_FullMethodName           = "/orders.OrdersService/CreateOrder"
+	OrdersService_GetOrderByID_FullMethodName          = "/orders.OrdersService/GetOrderByID"
+	OrdersService_UpdateOrder_FullMethodName           = "/orders.OrdersService/UpdateOrder"
+	OrdersService_DeleteOrder_FullMethodName           = "/orders.OrdersService/DeleteOrder"
+	OrdersService_ListOrders_FullMethodName            = "/orders.OrdersService/ListOrders"
+	OrdersService_CancelOrder_FullMethodName           = "/orders.OrdersService/CancelOrder"
+	OrdersService_EditOrderItems_FullMethodName        = "/orders.OrdersService/EditOrderItems"
+	OrdersService_GetOrderHistory_FullMethodName       = "/orders.OrdersService/GetOrderHistory"
+	OrdersService_WatchOrder_FullMethodName            = "/orders.OrdersService/WatchOrder"
+	OrdersService_CreatePaymentIntent_FullMethodName   = "/orders.OrdersService/CreatePaymentIntent"
+	OrdersService_AuthorizePayment_FullMethodName      = "/orders.OrdersService/AuthorizePayment"
+	OrdersService_CapturePayment_FullMethodName        = "/orders.OrdersService/CapturePayment"
+	OrdersService_GetPayment_FullMethodName            = "/orders.OrdersService/GetPayment"
+	OrdersService_ListOrderPayments_FullMethodName     = "/orders.OrdersService/ListOrderPayments"
+	OrdersService_RefundOrder_FullMethodName           = "/orders.OrdersService/RefundOrder"
+	OrdersService_CreateCoupon_FullMethodName          = "/orders.OrdersService/CreateCoupon"
+	OrdersService_GetCoupon_FullMethodName             = "/orders.OrdersService/GetCoupon"
+	OrdersService_ListCoupons_FullMethodName           = "/orders.OrdersService/ListCoupons"
+	OrdersService_DeactivateCoupon_FullMethodName      = "/orders.OrdersService/DeactivateCoupon"
+	OrdersService_CreateShipment_FullMethodName        = "/orders.OrdersService/CreateShipment"
+	OrdersService_MarkShipmentDelivered_FullMethodName = "/orders.OrdersService/MarkShipmentDelivered"
+	OrdersService_GetShipment_FullMethodName           = "/orders.OrdersService/GetShipment"
+	OrdersService_ListOrderShipments_FullMethodName    = "/orders.OrdersService/ListOrderShipments"
+	OrdersService_RequestReturn_FullMethodName         = "/orders.OrdersService/RequestReturn"
+	OrdersService_ApproveReturn_FullMethodName         = "/orders.OrdersService/ApproveReturn"
+	OrdersService_RejectReturn_FullMethodName          = "/orders.OrdersService/RejectReturn"
+	OrdersService_ReceiveReturn_FullMethodName         = "/orders.OrdersService/ReceiveReturn"
+	OrdersService_RefundReturn_FullMethodName          = "/orders.OrdersService/RefundReturn"
+	OrdersService_GetReturn_FullMethodName             = "/orders.OrdersService/GetReturn"
+	OrdersService_ListOrderReturns_FullMethodName      = "/orders.OrdersService/ListOrderReturns"
+	OrdersService_GetCart_FullMethodName               = "/orders.OrdersService/GetCart"
+	OrdersService_AddCartItem_FullMethodName           = "/orders.OrdersService/AddCartItem"
+	OrdersService_UpdateCartItem_FullMethodName        = "/orders.OrdersService/UpdateCartItem"
+	OrdersService_RemoveCartItem_FullMethodName        = "/orders.OrdersService/RemoveCartItem"
+	OrdersService_Checkout_FullMethodName              = "/orders.OrdersService/Checkout"
+	OrdersService_CreateSubscription_FullMethodName    = "/orders.OrdersService/CreateSubscription"
+	OrdersService_GetSubscription_FullMethodName       = "/orders.OrdersService/GetSubscription"
+	OrdersService_ListUserSubscriptions_FullMethodName = "/orders.OrdersService/ListUserSubscriptions"
+	OrdersService_PauseSubscription_FullMethodName     = "/orders.OrdersService/PauseSubscription"
+	OrdersService_ResumeSubscription_FullMethodName    = "/orders.OrdersService/ResumeSubscription"
+	OrdersService_CancelSubscription_FullMethodName    = "/orders.OrdersService/CancelSubscription"
+	OrdersService_ListSubscriptionRuns_FullMethodName  = "/orders.OrdersService/ListSubscriptionRuns"
+	OrdersService_GetInvoice_FullMethodName            = "/orders.OrdersService/GetInvoice"
 )
 
 // OrdersServiceClient is the client API for OrdersService service.
@@ -35,6 +73,84 @@ type OrdersServiceClient interface {
 	UpdateOrder(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	// Replaces the items of a pending or processing order. Stock the edit adds
+	// is reserved first, so an edit inventory rejects changes nothing. Lines
+	// already on the order keep their price; the discounts and tax are worked
+	// out again, and the edit is recorded in the order's history. Fails with
+	// FAILED_PRECONDITION when the order has a payment that has not failed, or
+	// when the new subtotal is below a coupon's minimum order value.
+	EditOrderItems(ctx context.Context, in *EditOrderItemsRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	// Lists the status changes of an order, oldest first, starting with its
+	// creation. The history of a deleted order is kept.
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
+	// Streams the changes of one order as they happen. See OrderEvent for
+	// resuming after a reconnect.
+	WatchOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error)
+	CreatePaymentIntent(ctx context.Context, in *CreatePaymentIntentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	AuthorizePayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	CapturePayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	GetPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	ListOrderPayments(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	// Looks a coupon up by its code, ignoring case.
+	GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error)
+	// Stops a coupon from applying to new orders. Orders already placed with
+	// it keep their discount.
+	DeactivateCoupon(ctx context.Context, in *DeactivateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	// Ships items of a processing order. Without items everything not yet
+	// shipped goes in the shipment.
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*ShipmentResponse, error)
+	// Records a delivery. Once all of an order's items are delivered the order
+	// moves to completed.
+	MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, opts ...grpc.CallOption) (*ShipmentResponse, error)
+	GetShipment(ctx context.Context, in *ShipmentRequest, opts ...grpc.CallOption) (*ShipmentResponse, error)
+	ListOrderShipments(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error)
+	// Returns go requested -> approved -> received -> refunded, and can be
+	// rejected until they are received. Each step shows up in the order's
+	// history.
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	ApproveReturn(ctx context.Context, in *ReturnDecisionRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	RejectReturn(ctx context.Context, in *ReturnDecisionRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	// Records the condition of each item; resellable items are restocked.
+	ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	// Refunds the returned items through the order's payment.
+	RefundReturn(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	GetReturn(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	ListOrderReturns(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error)
+	// Carts hold products and quantities per user; names, prices and stock
+	// are looked up in inventory on every call. A cart expires after a
+	// period without changes.
+	GetCart(ctx context.Context, in *CartRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	// Adds to the quantity already in the cart. Fails with NotFound when
+	// inventory cannot supply the new quantity.
+	AddCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	// Sets the quantity of a product in the cart; zero removes it.
+	UpdateCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	// Places the cart as an order, as CreateOrder would, and takes the ordered
+	// items out of the cart.
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	// Subscriptions place the same order on a cadence until they end. Each
+	// cycle places a regular order, as CreateOrder would, and is recorded as
+	// a run; when inventory is short the stock policy decides whether the
+	// cycle is skipped or ordered in part.
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	GetSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	ListUserSubscriptions(ctx context.Context, in *ListUserSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// A paused subscription orders nothing; resuming it picks up at the next
+	// cycle without ordering the ones it missed.
+	PauseSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	ResumeSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	// Lists the cycles of a subscription, newest first.
+	ListSubscriptionRuns(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*ListSubscriptionRunsResponse, error)
+	// Returns an invoice, by its id or by its order's id, with the invoice
+	// rendered as HTML and plain text. Orders are invoiced when they complete;
+	// an order without an invoice fails with NOT_FOUND.
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
 }
 
 type ordersServiceClient struct {
@@ -95,6 +211,395 @@ func (c *ordersServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequ
 	return out, nil
 }
 
+func (c *ordersServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) EditOrderItems(ctx context.Context, in *EditOrderItemsRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, OrdersService_EditOrderItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) WatchOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[0], OrdersService_WatchOrder_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetOrderRequest, OrderEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_WatchOrderClient = grpc.ServerStreamingClient[OrderEvent]
+
+func (c *ordersServiceClient) CreatePaymentIntent(ctx context.Context, in *CreatePaymentIntentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CreatePaymentIntent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) AuthorizePayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_AuthorizePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CapturePayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CapturePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListOrderPayments(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListOrderPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_RefundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CreateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) DeactivateCoupon(ctx context.Context, in *DeactivateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, OrdersService_DeactivateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*ShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CreateShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, opts ...grpc.CallOption) (*ShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_MarkShipmentDelivered_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetShipment(ctx context.Context, in *ShipmentRequest, opts ...grpc.CallOption) (*ShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListOrderShipments(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShipmentsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListOrderShipments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_RequestReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ApproveReturn(ctx context.Context, in *ReturnDecisionRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ApproveReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RejectReturn(ctx context.Context, in *ReturnDecisionRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_RejectReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ReceiveReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RefundReturn(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_RefundReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetReturn(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListOrderReturns(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReturnsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListOrderReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetCart(ctx context.Context, in *CartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) AddCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, OrdersService_AddCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) UpdateCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, OrdersService_UpdateCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, OrdersService_RemoveCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, OrdersService_Checkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CreateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListUserSubscriptions(ctx context.Context, in *ListUserSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListUserSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) PauseSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, OrdersService_PauseSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ResumeSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ResumeSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CancelSubscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListSubscriptionRuns(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*ListSubscriptionRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionRunsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListSubscriptionRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility.
@@ -104,138 +609,1007 @@ type OrdersServiceServer interface {
 	UpdateOrder(context.Context, *UpdateOrderRequest) (*OrderResponse, error)
 	DeleteOrder(context.Context, *DeleteOrderRequest) (*OrderResponse, error)
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*OrderResponse, error)
+	// Replaces the items of a pending or processing order. Stock the edit adds
+	// is reserved first, so an edit inventory rejects changes nothing. Lines
+	// already on the order keep their price; the discounts and tax are worked
+	// out again, and the edit is recorded in the order's history. Fails with
+	// FAILED_PRECONDITION when the order has a payment that has not failed, or
+	// when the new subtotal is below a coupon's minimum order value.
+	EditOrderItems(context.Context, *EditOrderItemsRequest) (*OrderResponse, error)
+	// Lists the status changes of an order, oldest first, starting with its
+	// creation. The history of a deleted order is kept.
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*OrderHistoryResponse, error)
+	// Streams the changes of one order as they happen. See OrderEvent for
+	// resuming after a reconnect.
+	WatchOrder(*GetOrderRequest, grpc.ServerStreamingServer[OrderEvent]) error
+	CreatePaymentIntent(context.Context, *CreatePaymentIntentRequest) (*PaymentResponse, error)
+	AuthorizePayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	CapturePayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	GetPayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	ListOrderPayments(context.Context, *GetOrderRequest) (*ListPaymentsResponse, error)
+	RefundOrder(context.Context, *RefundOrderRequest) (*PaymentResponse, error)
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error)
+	// Looks a coupon up by its code, ignoring case.
+	GetCoupon(context.Context, *GetCouponRequest) (*CouponResponse, error)
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error)
+	// Stops a coupon from applying to new orders. Orders already placed with
+	// it keep their discount.
+	DeactivateCoupon(context.Context, *DeactivateCouponRequest) (*CouponResponse, error)
+	// Ships items of a processing order. Without items everything not yet
+	// shipped goes in the shipment.
+	CreateShipment(context.Context, *CreateShipmentRequest) (*ShipmentResponse, error)
+	// Records a delivery. Once all of an order's items are delivered the order
+	// moves to completed.
+	MarkShipmentDelivered(context.Context, *MarkShipmentDeliveredRequest) (*ShipmentResponse, error)
+	GetShipment(context.Context, *ShipmentRequest) (*ShipmentResponse, error)
+	ListOrderShipments(context.Context, *GetOrderRequest) (*ListShipmentsResponse, error)
+	// Returns go requested -> approved -> received -> refunded, and can be
+	// rejected until they are received. Each step shows up in the order's
+	// history.
+	RequestReturn(context.Context, *RequestReturnRequest) (*ReturnResponse, error)
+	ApproveReturn(context.Context, *ReturnDecisionRequest) (*ReturnResponse, error)
+	RejectReturn(context.Context, *ReturnDecisionRequest) (*ReturnResponse, error)
+	// Records the condition of each item; resellable items are restocked.
+	ReceiveReturn(context.Context, *ReceiveReturnRequest) (*ReturnResponse, error)
+	// Refunds the returned items through the order's payment.
+	RefundReturn(context.Context, *ReturnRequest) (*ReturnResponse, error)
+	GetReturn(context.Context, *ReturnRequest) (*ReturnResponse, error)
+	ListOrderReturns(context.Context, *GetOrderRequest) (*ListReturnsResponse, error)
+	// Carts hold products and quantities per user; names, prices and stock
+	// are looked up in inventory on every call. A cart expires after a
+	// period without changes.
+	GetCart(context.Context, *CartRequest) (*CartResponse, error)
+	// Adds to the quantity already in the cart. Fails with NotFound when
+	// inventory cannot supply the new quantity.
+	AddCartItem(context.Context, *CartItemRequest) (*CartResponse, error)
+	// Sets the quantity of a product in the cart; zero removes it.
+	UpdateCartItem(context.Context, *CartItemRequest) (*CartResponse, error)
+	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*CartResponse, error)
+	// Places the cart as an order, as CreateOrder would, and takes the ordered
+	// items out of the cart.
+	Checkout(context.Context, *CheckoutRequest) (*OrderResponse, error)
+	// Subscriptions place the same order on a cadence until they end. Each
+	// cycle places a regular order, as CreateOrder would, and is recorded as
+	// a run; when inventory is short the stock policy decides whether the
+	// cycle is skipped or ordered in part.
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*SubscriptionResponse, error)
+	GetSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
+	ListUserSubscriptions(context.Context, *ListUserSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// A paused subscription orders nothing; resuming it picks up at the next
+	// cycle without ordering the ones it missed.
+	PauseSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
+	ResumeSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
+	CancelSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
+	// Lists the cycles of a subscription, newest first.
+	ListSubscriptionRuns(context.Context, *SubscriptionRequest) (*ListSubscriptionRunsResponse, error)
+	// Returns an invoice, by its id or by its order's id, with the invoice
+	// rendered as HTML and plain text. Orders are invoiced when they complete;
+	// an order without an invoice fails with NOT_FOUND.
+	GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error)
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
-// UnimplementedOrdersServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedOrdersServiceServer struct{}
+// UnimplementedOrdersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrdersServiceServer struct{}
+
+func (UnimplementedOrdersServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetOrderByID(context.Context, *GetOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+}
+func (UnimplementedOrdersServiceServer) UpdateOrder(context.Context, *UpdateOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) EditOrderItems(context.Context, *EditOrderItemsRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditOrderItems not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*OrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrdersServiceServer) WatchOrder(*GetOrderRequest, grpc.ServerStreamingServer[OrderEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreatePaymentIntent(context.Context, *CreatePaymentIntentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentIntent not implemented")
+}
+func (UnimplementedOrdersServiceServer) AuthorizePayment(context.Context, *PaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizePayment not implemented")
+}
+func (UnimplementedOrdersServiceServer) CapturePayment(context.Context, *PaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapturePayment not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetPayment(context.Context, *PaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListOrderPayments(context.Context, *GetOrderRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderPayments not implemented")
+}
+func (UnimplementedOrdersServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetCoupon(context.Context, *GetCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoupon not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedOrdersServiceServer) DeactivateCoupon(context.Context, *DeactivateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateCoupon not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateShipment(context.Context, *CreateShipmentRequest) (*ShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedOrdersServiceServer) MarkShipmentDelivered(context.Context, *MarkShipmentDeliveredRequest) (*ShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkShipmentDelivered not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetShipment(context.Context, *ShipmentRequest) (*ShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListOrderShipments(context.Context, *GetOrderRequest) (*ListShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderShipments not implemented")
+}
+func (UnimplementedOrdersServiceServer) RequestReturn(context.Context, *RequestReturnRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) ApproveReturn(context.Context, *ReturnDecisionRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) RejectReturn(context.Context, *ReturnDecisionRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) ReceiveReturn(context.Context, *ReceiveReturnRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) RefundReturn(context.Context, *ReturnRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetReturn(context.Context, *ReturnRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListOrderReturns(context.Context, *GetOrderRequest) (*ListReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderReturns not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetCart(context.Context, *CartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedOrdersServiceServer) AddCartItem(context.Context, *CartItemRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCartItem not implemented")
+}
+func (UnimplementedOrdersServiceServer) UpdateCartItem(context.Context, *CartItemRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartItem not implemented")
+}
+func (UnimplementedOrdersServiceServer) RemoveCartItem(context.Context, *RemoveCartItemRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCartItem not implemented")
+}
+func (UnimplementedOrdersServiceServer) Checkout(context.Context, *CheckoutRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListUserSubscriptions(context.Context, *ListUserSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSubscriptions not implemented")
+}
+func (UnimplementedOrdersServiceServer) PauseSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscription not implemented")
+}
+func (UnimplementedOrdersServiceServer) ResumeSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscription not implemented")
+}
+func (UnimplementedOrdersServiceServer) CancelSubscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListSubscriptionRuns(context.Context, *SubscriptionRequest) (*ListSubscriptionRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionRuns not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
+func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrdersServiceServer will
+// result in compilation errors.
+type UnsafeOrdersServiceServer interface {
+	mustEmbedUnimplementedOrdersServiceServer()
+}
+
+func RegisterOrdersServiceServer(s grpc.ServiceRegistrar, srv OrdersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrdersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrdersService_ServiceDesc, srv)
+}
+
+func _OrdersService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrderByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetOrderByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrderByID(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UpdateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UpdateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UpdateOrder(ctx, req.(*UpdateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).DeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_DeleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).DeleteOrder(ctx, req.(*DeleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_EditOrderItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditOrderItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).EditOrderItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_EditOrderItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).EditOrderItems(ctx, req.(*EditOrderItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersServiceServer).WatchOrder(m, &grpc.GenericServerStream[GetOrderRequest, OrderEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_WatchOrderServer = grpc.ServerStreamingServer[OrderEvent]
+
+func _OrdersService_CreatePaymentIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreatePaymentIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreatePaymentIntent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreatePaymentIntent(ctx, req.(*CreatePaymentIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_AuthorizePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).AuthorizePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_AuthorizePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).AuthorizePayment(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CapturePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CapturePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CapturePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CapturePayment(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetPayment(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListOrderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListOrderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListOrderPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListOrderPayments(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RefundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_DeactivateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).DeactivateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_DeactivateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).DeactivateCoupon(ctx, req.(*DeactivateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_MarkShipmentDelivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkShipmentDeliveredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).MarkShipmentDelivered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_MarkShipmentDelivered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).MarkShipmentDelivered(ctx, req.(*MarkShipmentDeliveredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetShipment(ctx, req.(*ShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListOrderShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListOrderShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListOrderShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListOrderShipments(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RequestReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ApproveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ApproveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ApproveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ApproveReturn(ctx, req.(*ReturnDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_RejectReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RejectReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RejectReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RejectReturn(ctx, req.(*ReturnDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ReceiveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ReceiveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ReceiveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ReceiveReturn(ctx, req.(*ReceiveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_RefundReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RefundReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RefundReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RefundReturn(ctx, req.(*ReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetReturn(ctx, req.(*ReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedOrdersServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+func _OrdersService_ListOrderReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListOrderReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListOrderReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListOrderReturns(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrdersServiceServer) GetOrderByID(context.Context, *GetOrderRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+
+func _OrdersService_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetCart(ctx, req.(*CartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrdersServiceServer) UpdateOrder(context.Context, *UpdateOrderRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrder not implemented")
+
+func _OrdersService_AddCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).AddCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_AddCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).AddCartItem(ctx, req.(*CartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrdersServiceServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+
+func _OrdersService_UpdateCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UpdateCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UpdateCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UpdateCartItem(ctx, req.(*CartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrdersServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+
+func _OrdersService_RemoveCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RemoveCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RemoveCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RemoveCartItem(ctx, req.(*RemoveCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
-func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OrdersServiceServer will
-// result in compilation errors.
-type UnsafeOrdersServiceServer interface {
-	mustEmbedUnimplementedOrdersServiceServer()
+func _OrdersService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_Checkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterOrdersServiceServer(s grpc.ServiceRegistrar, srv OrdersServiceServer) {
-	// If the following call pancis, it indicates UnimplementedOrdersServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _OrdersService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&OrdersService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _OrdersService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrderRequest)
+func _OrdersService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrdersServiceServer).CreateOrder(ctx, in)
+		return srv.(OrdersServiceServer).GetSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrdersService_CreateOrder_FullMethodName,
+		FullMethod: OrdersService_GetSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrdersServiceServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+		return srv.(OrdersServiceServer).GetSubscription(ctx, req.(*SubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrdersService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _OrdersService_ListUserSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrdersServiceServer).GetOrderByID(ctx, in)
+		return srv.(OrdersServiceServer).ListUserSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrdersService_GetOrderByID_FullMethodName,
+		FullMethod: OrdersService_ListUserSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrdersServiceServer).GetOrderByID(ctx, req.(*GetOrderRequest))
+		return srv.(OrdersServiceServer).ListUserSubscriptions(ctx, req.(*ListUserSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrdersService_UpdateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrderRequest)
+func _OrdersService_PauseSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrdersServiceServer).UpdateOrder(ctx, in)
+		return srv.(OrdersServiceServer).PauseSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrdersService_UpdateOrder_FullMethodName,
+		FullMethod: OrdersService_PauseSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrdersServiceServer).UpdateOrder(ctx, req.(*UpdateOrderRequest))
+		return srv.(OrdersServiceServer).PauseSubscription(ctx, req.(*SubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrdersService_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrderRequest)
+func _OrdersService_ResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrdersServiceServer).DeleteOrder(ctx, in)
+		return srv.(OrdersServiceServer).ResumeSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrdersService_DeleteOrder_FullMethodName,
+		FullMethod: OrdersService_ResumeSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrdersServiceServer).DeleteOrder(ctx, req.(*DeleteOrderRequest))
+		return srv.(OrdersServiceServer).ResumeSubscription(ctx, req.(*SubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrdersService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrdersRequest)
+func _OrdersService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrdersServiceServer).ListOrders(ctx, in)
+		return srv.(OrdersServiceServer).CancelSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrdersService_ListOrders_FullMethodName,
+		FullMethod: OrdersService_CancelSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrdersServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+		return srv.(OrdersServiceServer).CancelSubscription(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListSubscriptionRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListSubscriptionRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListSubscriptionRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListSubscriptionRuns(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -267,7 +1641,161 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrders",
 			Handler:    _OrdersService_ListOrders_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrdersService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "EditOrderItems",
+			Handler:    _OrdersService_EditOrderItems_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrdersService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "CreatePaymentIntent",
+			Handler:    _OrdersService_CreatePaymentIntent_Handler,
+		},
+		{
+			MethodName: "AuthorizePayment",
+			Handler:    _OrdersService_AuthorizePayment_Handler,
+		},
+		{
+			MethodName: "CapturePayment",
+			Handler:    _OrdersService_CapturePayment_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _OrdersService_GetPayment_Handler,
+		},
+		{
+			MethodName: "ListOrderPayments",
+			Handler:    _OrdersService_ListOrderPayments_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OrdersService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _OrdersService_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "GetCoupon",
+			Handler:    _OrdersService_GetCoupon_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _OrdersService_ListCoupons_Handler,
+		},
+		{
+			MethodName: "DeactivateCoupon",
+			Handler:    _OrdersService_DeactivateCoupon_Handler,
+		},
+		{
+			MethodName: "CreateShipment",
+			Handler:    _OrdersService_CreateShipment_Handler,
+		},
+		{
+			MethodName: "MarkShipmentDelivered",
+			Handler:    _OrdersService_MarkShipmentDelivered_Handler,
+		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _OrdersService_GetShipment_Handler,
+		},
+		{
+			MethodName: "ListOrderShipments",
+			Handler:    _OrdersService_ListOrderShipments_Handler,
+		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _OrdersService_RequestReturn_Handler,
+		},
+		{
+			MethodName: "ApproveReturn",
+			Handler:    _OrdersService_ApproveReturn_Handler,
+		},
+		{
+			MethodName: "RejectReturn",
+			Handler:    _OrdersService_RejectReturn_Handler,
+		},
+		{
+			MethodName: "ReceiveReturn",
+			Handler:    _OrdersService_ReceiveReturn_Handler,
+		},
+		{
+			MethodName: "RefundReturn",
+			Handler:    _OrdersService_RefundReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _OrdersService_GetReturn_Handler,
+		},
+		{
+			MethodName: "ListOrderReturns",
+			Handler:    _OrdersService_ListOrderReturns_Handler,
+		},
+		{
+			MethodName: "GetCart",
+			Handler:    _OrdersService_GetCart_Handler,
+		},
+		{
+			MethodName: "AddCartItem",
+			Handler:    _OrdersService_AddCartItem_Handler,
+		},
+		{
+			MethodName: "UpdateCartItem",
+			Handler:    _OrdersService_UpdateCartItem_Handler,
+		},
+		{
+			MethodName: "RemoveCartItem",
+			Handler:    _OrdersService_RemoveCartItem_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _OrdersService_Checkout_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _OrdersService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _OrdersService_GetSubscription_Handler,
+		},
+		{
+			MethodName: "ListUserSubscriptions",
+			Handler:    _OrdersService_ListUserSubscriptions_Handler,
+		},
+		{
+			MethodName: "PauseSubscription",
+			Handler:    _OrdersService_PauseSubscription_Handler,
+		},
+		{
+			MethodName: "ResumeSubscription",
+			Handler:    _OrdersService_ResumeSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _OrdersService_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptionRuns",
+			Handler:    _OrdersService_ListSubscriptionRuns_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _OrdersService_GetInvoice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrdersService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders.proto",
 }
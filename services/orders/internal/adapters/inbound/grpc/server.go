@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"strings"
+	"time"
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/utils"
+	"github.com/ExonegeS/money"
 	"google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
@@ -19,25 +22,44 @@ import (
 
 type OrdersServer struct {
 	UnimplementedOrdersServiceServer
-	service application.OrdersService
-	logger  *slog.Logger
+	service       application.OrdersService
+	payments      application.PaymentsService
+	promotions    application.PromotionsService
+	shipments     application.ShipmentsService
+	returns       application.ReturnsService
+	carts         application.CartsService
+	subscriptions application.SubscriptionsService
+	invoices      application.InvoicesService
+	watcher       *application.OrderWatcher
+	logger        *slog.Logger
 }
 
-func NewOrdersServer(service application.OrdersService, logger *slog.Logger) *OrdersServer {
+func NewOrdersServer(service application.OrdersService, payments application.PaymentsService, promotions application.PromotionsService, shipments application.ShipmentsService, returns application.ReturnsService, carts application.CartsService, subscriptions application.SubscriptionsService, invoices application.InvoicesService, watcher *application.OrderWatcher, logger *slog.Logger) *OrdersServer {
 	return &OrdersServer{
-		service: service,
-		logger:  logger,
+		service:       service,
+		payments:      payments,
+		promotions:    promotions,
+		shipments:     shipments,
+		returns:       returns,
+		carts:         carts,
+		subscriptions: subscriptions,
+		invoices:      invoices,
+		watcher:       watcher,
+		logger:        logger,
 	}
 }
 
-func StartGRPCServer(grpcPort string, orderService application.OrdersService, logger *slog.Logger) error {
+func StartGRPCServer(grpcPort string, orderService application.OrdersService, paymentsService application.PaymentsService, promotionsService application.PromotionsService, shipmentsService application.ShipmentsService, returnsService application.ReturnsService, cartsService application.CartsService, subscriptionsService application.SubscriptionsService, invoicesService application.InvoicesService, watcher *application.OrderWatcher, idempotencyService application.IdempotencyService, logger *slog.Logger) error {
 	lis, err := net.Listen("tcp", ":"+grpcPort)
 	if err != nil {
 		return fmt.Errorf("failed to listen on port %s: %w", grpcPort, err)
 	}
 
-	grpcServer := grpc.NewServer()
-	orderServer := NewOrdersServer(orderService, logger)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		NewActorInterceptor(),
+		NewIdempotencyInterceptor(idempotencyService, logger),
+	))
+	orderServer := NewOrdersServer(orderService, paymentsService, promotionsService, shipmentsService, returnsService, cartsService, subscriptionsService, invoicesService, watcher, logger)
 	RegisterOrdersServiceServer(grpcServer, orderServer)
 	reflection.Register(grpcServer)
 
@@ -46,7 +68,7 @@ func StartGRPCServer(grpcPort string, orderService application.OrdersService, lo
 }
 
 func (s *OrdersServer) GetOrderByID(ctx context.Context, req *GetOrderRequest) (*OrderResponse, error) {
-	s.logger.Info("Received GetProductByID gRPC request", "id", req.GetId())
+	s.logger.Info("Received GetOrderByID gRPC request", "id", req.GetId(), "include_history", req.GetIncludeHistory())
 	domainID := req.GetId()
 	id, err := utils.ParseUUID(domainID)
 	if err != nil {
@@ -54,19 +76,49 @@ func (s *OrdersServer) GetOrderByID(ctx context.Context, req *GetOrderRequest) (
 		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
 	}
 
-	product, err := s.service.GetOrderByID(ctx, id)
+	order, err := s.service.GetOrderByID(ctx, id)
 	if err != nil {
-		s.logger.Error("Error fetching product", "error", err.Error())
-		return nil, fmt.Errorf("failed to get product: %w", err)
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		s.logger.Error("Error fetching order", "error", err.Error())
+		return nil, status.Error(codes.Internal, "failed to get order")
+	}
+
+	resp := &OrderResponse{Order: convertDomainOrderToPB(order)}
+	if req.GetIncludeHistory() {
+		history, err := s.service.GetOrderHistory(ctx, id)
+		if err != nil {
+			s.logger.Error("Error fetching order history", "error", err.Error())
+			return nil, status.Error(codes.Internal, "failed to get order history")
+		}
+		resp.History = convertDomainStatusHistoryToPB(history)
+	}
+	return resp, nil
+}
+
+func (s *OrdersServer) GetOrderHistory(ctx context.Context, req *GetOrderHistoryRequest) (*OrderHistoryResponse, error) {
+	s.logger.Info("Received GetOrderHistory gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
 	}
 
-	respOrder := &Order{
-		Id:        product.ID.String(),
-		CreatedAt: timestamppb.New(product.CreatedAt),
-		UpdatedAt: timestamppb.New(product.UpdatedAt),
+	history, err := s.service.GetOrderHistory(ctx, id)
+	if err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		s.logger.Error("Error fetching order history", "error", err.Error())
+		return nil, status.Error(codes.Internal, "failed to get order history")
 	}
 
-	return &OrderResponse{Order: respOrder}, nil
+	return &OrderHistoryResponse{
+		OrderId: id.String(),
+		History: convertDomainStatusHistoryToPB(history),
+	}, nil
 }
 
 func ValidateCreateOrderRequest(req *CreateOrderRequest) error {
@@ -79,6 +131,11 @@ func ValidateCreateOrderRequest(req *CreateOrderRequest) error {
 	if len(req.Items) == 0 {
 		return status.Error(codes.InvalidArgument, "order items cannot be empty")
 	}
+	for _, item := range req.Items {
+		if item.GetExpectedUnitPrice().GetMinorUnits() < 0 {
+			return status.Error(codes.InvalidArgument, "expected unit price cannot be negative")
+		}
+	}
 	return nil
 }
 
@@ -91,39 +148,1817 @@ func (s *OrdersServer) CreateOrder(ctx context.Context, req *CreateOrderRequest)
 	}
 
 	order := entity.Order{
-		UserID:   entity.UUID(req.UserId),
-		UserName: req.Name,
-		Status:   entity.OrderStatusPending,
-		Items:    []entity.OrderItem{},
+		UserID:          entity.UUID(req.UserId),
+		UserName:        req.Name,
+		Status:          entity.OrderStatusPending,
+		Items:           []entity.OrderItem{},
+		CouponCode:      req.GetCouponCode(),
+		TaxRegion:       req.GetTaxRegion(),
+		ShippingAddress: addressFromPB(req.GetShippingAddress()),
+		BillingAddress:  addressFromPB(req.GetBillingAddress()),
 	}
 
 	for _, item := range req.GetItems() {
-		order.Items = append(order.Items, entity.OrderItem{
+		orderItem := entity.OrderItem{
 			ProductID: entity.UUID(item.GetProductId()),
 			Quantity:  int64(item.GetQuantity()),
-		})
+		}
+		if item.GetExpectedUnitPrice() != nil {
+			expected := moneyFromPB(item.GetExpectedUnitPrice())
+			orderItem.ExpectedPrice = &expected
+		}
+		order.Items = append(order.Items, orderItem)
 	}
 
 	err := s.service.CreateOrder(ctx, &order)
+	if errors.Is(err, entity.ErrOrderPlaced) {
+		// The response goes along with the error so a retry under the same
+		// idempotency key replays this order instead of placing another.
+		return &OrderResponse{Order: convertDomainOrderToPB(&order)}, s.createOrderError(err)
+	}
+	if err != nil {
+		return nil, s.createOrderError(err)
+	}
+
+	return &OrderResponse{
+		Order: convertDomainOrderToPB(&order),
+	}, nil
+}
+
+// createOrderError maps the errors of placing an order, from CreateOrder or
+// Checkout, to statuses.
+func (s *OrdersServer) createOrderError(err error) error {
+	if errors.Is(err, entity.ErrOrderPlaced) {
+		s.logger.Error("Order placed with errors", "error", err)
+		return status.Error(codes.Internal, "order was placed, but not every step finished")
+	}
+	if errors.Is(err, entity.ErrInvalidQuantity) {
+		return status.Error(codes.InvalidArgument, "invalid item quantity")
+	}
+	if errors.Is(err, entity.ErrInsufficientQuantity) {
+		return status.Error(codes.NotFound, "insufficient item quantity in storage")
+	}
+	if errors.Is(err, entity.ErrItemNotFound) {
+		return status.Error(codes.InvalidArgument, "specified item not exist")
+	}
+	if errors.Is(err, entity.ErrInvalidRequestPayload) || errors.Is(err, entity.ErrInvalidAddress) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, entity.ErrCouponNotFound) {
+		return status.Error(codes.NotFound, "coupon not found")
+	}
+	if errors.Is(err, entity.ErrCouponNotApplicable) || errors.Is(err, entity.ErrCouponExhausted) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	var mismatch *entity.PriceMismatchError
+	if errors.As(err, &mismatch) {
+		return priceMismatchStatus(mismatch)
+	}
+	s.logger.Error("Failed to create order", "error", err)
+	return status.Error(codes.Internal, "failed to create order")
+}
+
+// priceMismatchStatus reports the mismatches in the message and, for
+// clients that want to re-quote, as PriceMismatchDetails.
+func priceMismatchStatus(err *entity.PriceMismatchError) error {
+	details := &PriceMismatchDetails{
+		Mismatches: make([]*PriceMismatch, 0, len(err.Mismatches)),
+	}
+	for _, m := range err.Mismatches {
+		details.Mismatches = append(details.Mismatches, &PriceMismatch{
+			ProductId:     m.ProductID.String(),
+			ExpectedPrice: moneyToPB(m.Expected),
+			CurrentPrice:  moneyToPB(m.Current),
+		})
+	}
+	st, detailErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(details)
+	if detailErr != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return st.Err()
+}
+
+func ValidateUpdateOrderRequest(req *UpdateOrderRequest) error {
+	if req.UserName == nil && req.Status == nil {
+		return status.Error(codes.InvalidArgument, "at least one field must be provided")
+	}
+	if req.UserName != nil && len(*req.UserName) == 0 {
+		return status.Error(codes.InvalidArgument, "user name cannot be empty")
+	}
+	if req.Status != nil {
+		if _, ok := pbStatusToDomain[*req.Status]; !ok {
+			return status.Error(codes.InvalidArgument, "invalid order status")
+		}
+		// The other statuses belong to flows with side effects of their own:
+		// capturing the payment, delivering the shipments, refunding.
+		if *req.Status != OrderStatus_ORDER_STATUS_CANCELLED {
+			return status.Error(codes.InvalidArgument, "status can only be set to cancelled; payments, deliveries and refunds set the others")
+		}
+	}
+	return nil
+}
+
+func (s *OrdersServer) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) (*OrderResponse, error) {
+	s.logger.Info("Received UpdateOrder gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	if err := ValidateUpdateOrderRequest(req); err != nil {
+		s.logger.Error("Invalid update request", "error", err)
+		return nil, err
+	}
+
+	params := application.UpdateOrderParams{
+		UserName: req.UserName,
+		Reason:   req.GetReason(),
+	}
+	if req.Status != nil {
+		orderStatus := pbStatusToDomain[*req.Status]
+		params.Status = &orderStatus
+	}
+
+	order, err := s.service.UpdateOrder(ctx, id, params)
 	if err != nil {
-		if errors.Is(err, entity.ErrInvalidQuantity) {
-			return nil, status.Error(codes.InvalidArgument, "invalid item quantity")
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, "order not found")
 		}
-		if errors.Is(err, entity.ErrInsufficientQuantity) {
-			return nil, status.Error(codes.NotFound, "insufficient item quantity in storage")
+		if errors.Is(err, entity.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to update order", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update order")
+	}
+
+	return &OrderResponse{Order: convertDomainOrderToPB(order)}, nil
+}
+
+func (s *OrdersServer) DeleteOrder(ctx context.Context, req *DeleteOrderRequest) (*OrderResponse, error) {
+	s.logger.Info("Received DeleteOrder gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	order, err := s.service.DeleteOrder(ctx, id)
+	if err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		if errors.Is(err, entity.ErrOrderNotDeletable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to delete order", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete order")
+	}
+
+	return &OrderResponse{Order: convertDomainOrderToPB(order)}, nil
+}
+
+func (s *OrdersServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*OrderResponse, error) {
+	s.logger.Info("Received CancelOrder gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	order, err := s.service.CancelOrder(ctx, id, req.GetReason())
+	if err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		if errors.Is(err, entity.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to cancel order", "error", err)
+		return nil, status.Error(codes.Internal, "failed to cancel order")
+	}
+
+	return &OrderResponse{Order: convertDomainOrderToPB(order)}, nil
+}
+
+func ValidateEditOrderItemsRequest(req *EditOrderItemsRequest) error {
+	if len(req.GetItems()) == 0 {
+		return status.Error(codes.InvalidArgument, "order items cannot be empty; cancel the order instead")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "invalid item quantity")
 		}
-		if errors.Is(err, entity.ErrItemNotFound) {
+	}
+	return nil
+}
+
+func (s *OrdersServer) EditOrderItems(ctx context.Context, req *EditOrderItemsRequest) (*OrderResponse, error) {
+	s.logger.Info("Received EditOrderItems gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+	if err := ValidateEditOrderItemsRequest(req); err != nil {
+		s.logger.Error("Invalid edit request", "error", err)
+		return nil, err
+	}
+
+	params := application.EditOrderItemsParams{Reason: req.GetReason()}
+	for _, item := range req.GetItems() {
+		params.Items = append(params.Items, entity.OrderItem{
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+		})
+	}
+
+	order, err := s.service.EditOrderItems(ctx, id, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrOrderNotFound):
+			return nil, status.Error(codes.NotFound, "order not found")
+		case errors.Is(err, entity.ErrOrderNotEditable), errors.Is(err, entity.ErrCouponNotApplicable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, entity.ErrOrderEditConflict):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case errors.Is(err, entity.ErrInsufficientQuantity):
+			return nil, status.Error(codes.NotFound, "insufficient item quantity in storage")
+		case errors.Is(err, entity.ErrItemNotFound):
 			return nil, status.Error(codes.InvalidArgument, "specified item not exist")
+		case errors.Is(err, entity.ErrInvalidQuantity), errors.Is(err, entity.ErrInvalidRequestPayload):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		s.logger.Error("Failed to create order", "error", err)
-		return nil, status.Error(codes.Internal, "failed to create order")
+		s.logger.Error("Failed to edit order items", "error", err)
+		return nil, status.Error(codes.Internal, "failed to edit order items")
 	}
 
-	respOrder := &Order{
-		Id: order.ID.String(),
+	return &OrderResponse{Order: convertDomainOrderToPB(order)}, nil
+}
+
+func (s *OrdersServer) WatchOrder(req *GetOrderRequest, stream OrdersService_WatchOrderServer) error {
+	s.logger.Info("Received WatchOrder gRPC request", "id", req.GetId(), "resume_token", req.GetResumeToken())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
 	}
 
-	return &OrderResponse{
-		Order: respOrder,
+	err = s.watcher.Watch(stream.Context(), id, req.GetResumeToken(), func(event *entity.OrderEvent) error {
+		return stream.Send(convertDomainOrderEventToPB(event))
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrOrderNotFound):
+			return status.Error(codes.NotFound, "order not found")
+		case errors.Is(err, entity.ErrInvalidResumeToken):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+			return status.FromContextError(err).Err()
+		}
+		if _, ok := status.FromError(err); ok {
+			// Send failed; the stream is already broken.
+			return err
+		}
+		s.logger.Error("Failed to watch order", "id", id, "error", err)
+		return status.Error(codes.Internal, "failed to watch order")
+	}
+	return nil
+}
+
+func (s *OrdersServer) ListOrders(ctx context.Context, req *ListOrdersRequest) (*ListOrdersResponse, error) {
+	s.logger.Info("Received ListOrders gRPC request",
+		"page", req.GetPage(),
+		"page_size", req.GetPageSize(),
+		"sort_by", req.GetSortBy(),
+		"user_id", req.GetUserId(),
+		"statuses", req.GetStatuses(),
+		"product_id", req.GetProductId(),
+	)
+
+	sortBy, err := entity.ParseSortOption(req.GetSortBy())
+	if err != nil {
+		s.logger.Error("Invalid sort option", "error", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := orderFilterFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := entity.NewPagination(
+		int64(req.GetPage()),
+		int64(req.GetPageSize()),
+		sortBy,
+	)
+
+	paginatedData, err := s.service.GetPaginatedOrders(ctx, filter, pagination)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error("Failed to list orders", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list orders")
+	}
+
+	grpcData := make([]*Order, 0, len(paginatedData.Data))
+	for _, order := range paginatedData.Data {
+		grpcData = append(grpcData, convertDomainOrderToPB(order))
+	}
+
+	return &ListOrdersResponse{
+		CurrentPage: int32(paginatedData.CurrentPage),
+		HasNextPage: paginatedData.HasNextPage,
+		PageSize:    int32(paginatedData.PageSize),
+		TotalPages:  int32(paginatedData.TotalPages),
+		Orders:      grpcData,
 	}, nil
 }
+
+func orderFilterFromPB(req *ListOrdersRequest) (entity.OrderFilter, error) {
+	var filter entity.OrderFilter
+
+	if req.GetUserId() != "" {
+		id, err := utils.ParseUUID(req.GetUserId())
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+		filter.UserID = id
+	}
+	if req.GetProductId() != "" {
+		id, err := utils.ParseUUID(req.GetProductId())
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		filter.ProductID = id
+	}
+	for _, pbStatus := range req.GetStatuses() {
+		s, ok := pbStatusToDomain[pbStatus]
+		if !ok {
+			return filter, status.Error(codes.InvalidArgument, "invalid status filter")
+		}
+		filter.Statuses = append(filter.Statuses, s)
+	}
+	if req.GetCreatedFrom() != nil {
+		filter.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.GetCreatedTo() != nil {
+		filter.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+	if req.GetMinTotal() != nil {
+		m := moneyFromPB(req.GetMinTotal())
+		filter.MinTotal = &m
+	}
+	if req.GetMaxTotal() != nil {
+		m := moneyFromPB(req.GetMaxTotal())
+		filter.MaxTotal = &m
+	}
+	return filter, nil
+}
+
+func ValidateCreatePaymentIntentRequest(req *CreatePaymentIntentRequest) error {
+	if _, err := utils.ParseUUID(req.GetOrderId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+	if req.GetPaymentMethod() == "" {
+		return status.Error(codes.InvalidArgument, "payment method cannot be empty")
+	}
+	if currency := req.GetCurrency(); currency != "" && len(currency) != 3 {
+		return status.Error(codes.InvalidArgument, "currency must be a 3-letter ISO code")
+	}
+	return nil
+}
+
+func (s *OrdersServer) CreatePaymentIntent(ctx context.Context, req *CreatePaymentIntentRequest) (*PaymentResponse, error) {
+	s.logger.Info("Received CreatePaymentIntent gRPC request", "order_id", req.GetOrderId())
+
+	if err := ValidateCreatePaymentIntentRequest(req); err != nil {
+		s.logger.Error("Invalid payment intent request", "error", err)
+		return nil, err
+	}
+
+	payment, err := s.payments.CreatePaymentIntent(ctx, entity.UUID(req.GetOrderId()), req.GetPaymentMethod(), req.GetCurrency())
+	if err != nil {
+		return nil, s.paymentError("Failed to create payment intent", err)
+	}
+
+	return &PaymentResponse{Payment: convertDomainPaymentToPB(payment)}, nil
+}
+
+func (s *OrdersServer) AuthorizePayment(ctx context.Context, req *PaymentRequest) (*PaymentResponse, error) {
+	s.logger.Info("Received AuthorizePayment gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid payment ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid payment ID format")
+	}
+
+	payment, err := s.payments.AuthorizePayment(ctx, id)
+	if err != nil {
+		return nil, s.paymentError("Failed to authorize payment", err)
+	}
+
+	return &PaymentResponse{Payment: convertDomainPaymentToPB(payment)}, nil
+}
+
+func (s *OrdersServer) CapturePayment(ctx context.Context, req *PaymentRequest) (*PaymentResponse, error) {
+	s.logger.Info("Received CapturePayment gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid payment ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid payment ID format")
+	}
+
+	payment, err := s.payments.CapturePayment(ctx, id)
+	if err != nil {
+		return nil, s.paymentError("Failed to capture payment", err)
+	}
+
+	return &PaymentResponse{Payment: convertDomainPaymentToPB(payment)}, nil
+}
+
+func (s *OrdersServer) GetPayment(ctx context.Context, req *PaymentRequest) (*PaymentResponse, error) {
+	s.logger.Info("Received GetPayment gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid payment ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid payment ID format")
+	}
+
+	payment, err := s.payments.GetPayment(ctx, id)
+	if err != nil {
+		return nil, s.paymentError("Failed to get payment", err)
+	}
+
+	return &PaymentResponse{Payment: convertDomainPaymentToPB(payment)}, nil
+}
+
+func (s *OrdersServer) ListOrderPayments(ctx context.Context, req *GetOrderRequest) (*ListPaymentsResponse, error) {
+	s.logger.Info("Received ListOrderPayments gRPC request", "order_id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	payments, err := s.payments.ListOrderPayments(ctx, id)
+	if err != nil {
+		return nil, s.paymentError("Failed to list order payments", err)
+	}
+
+	resp := &ListPaymentsResponse{Payments: make([]*Payment, 0, len(payments))}
+	for _, payment := range payments {
+		resp.Payments = append(resp.Payments, convertDomainPaymentToPB(payment))
+	}
+	return resp, nil
+}
+
+func ValidateRefundOrderRequest(req *RefundOrderRequest) error {
+	if _, err := utils.ParseUUID(req.GetOrderId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+	}
+	return nil
+}
+
+func (s *OrdersServer) RefundOrder(ctx context.Context, req *RefundOrderRequest) (*PaymentResponse, error) {
+	s.logger.Info("Received RefundOrder gRPC request", "order_id", req.GetOrderId(), "lines", len(req.GetItems()))
+
+	if err := ValidateRefundOrderRequest(req); err != nil {
+		s.logger.Error("Invalid refund request", "error", err)
+		return nil, err
+	}
+
+	params := application.RefundParams{Restock: req.GetRestock()}
+	for _, item := range req.GetItems() {
+		params.Lines = append(params.Lines, entity.RefundLine{
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+		})
+	}
+
+	refund, err := s.payments.RefundOrder(ctx, entity.UUID(req.GetOrderId()), params)
+	if err != nil && refund != nil && refund.Status == entity.PaymentStatusRefunded {
+		// The money went out; a retry under the same idempotency key must
+		// replay this refund rather than pay it again.
+		return &PaymentResponse{Payment: convertDomainPaymentToPB(refund)}, s.paymentError("Failed to finish refund", err)
+	}
+	if err != nil {
+		return nil, s.paymentError("Failed to refund order", err)
+	}
+
+	return &PaymentResponse{Payment: convertDomainPaymentToPB(refund)}, nil
+}
+
+func (s *OrdersServer) paymentError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrOrderNotFound):
+		return status.Error(codes.NotFound, "order not found")
+	case errors.Is(err, entity.ErrPaymentNotFound):
+		return status.Error(codes.NotFound, "payment not found")
+	case errors.Is(err, entity.ErrItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidRequestPayload),
+		errors.Is(err, entity.ErrInvalidQuantity),
+		errors.Is(err, entity.ErrRefundExceedsCaptured):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrOrderAlreadyPaid):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrPaymentDeclined),
+		errors.Is(err, entity.ErrInvalidPaymentTransition),
+		errors.Is(err, entity.ErrOrderNotPayable),
+		errors.Is(err, entity.ErrOrderNotPaid),
+		errors.Is(err, entity.ErrOrderNotRefundable),
+		errors.Is(err, entity.ErrNothingToRefund):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+func ValidateCreateShipmentRequest(req *CreateShipmentRequest) error {
+	if _, err := utils.ParseUUID(req.GetOrderId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+	if req.GetCarrier() == "" || req.GetTrackingNumber() == "" {
+		return status.Error(codes.InvalidArgument, "carrier and tracking number cannot be empty")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+	}
+	return nil
+}
+
+func (s *OrdersServer) CreateShipment(ctx context.Context, req *CreateShipmentRequest) (*ShipmentResponse, error) {
+	s.logger.Info("Received CreateShipment gRPC request", "order_id", req.GetOrderId(), "items", len(req.GetItems()))
+
+	if err := ValidateCreateShipmentRequest(req); err != nil {
+		s.logger.Error("Invalid shipment request", "error", err)
+		return nil, err
+	}
+
+	params := application.CreateShipmentParams{
+		Carrier:        req.GetCarrier(),
+		TrackingNumber: req.GetTrackingNumber(),
+	}
+	for _, item := range req.GetItems() {
+		params.Items = append(params.Items, entity.ShipmentItem{
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+		})
+	}
+
+	shipment, err := s.shipments.CreateShipment(ctx, entity.UUID(req.GetOrderId()), params)
+	if err != nil {
+		return nil, s.shipmentError("Failed to create shipment", err)
+	}
+
+	return &ShipmentResponse{Shipment: convertDomainShipmentToPB(shipment)}, nil
+}
+
+func (s *OrdersServer) MarkShipmentDelivered(ctx context.Context, req *MarkShipmentDeliveredRequest) (*ShipmentResponse, error) {
+	s.logger.Info("Received MarkShipmentDelivered gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid shipment ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid shipment ID format")
+	}
+
+	var deliveredAt time.Time
+	if req.GetDeliveredAt() != nil {
+		deliveredAt = req.GetDeliveredAt().AsTime()
+	}
+
+	shipment, err := s.shipments.MarkShipmentDelivered(ctx, id, deliveredAt)
+	if err != nil {
+		return nil, s.shipmentError("Failed to mark shipment delivered", err)
+	}
+
+	return &ShipmentResponse{Shipment: convertDomainShipmentToPB(shipment)}, nil
+}
+
+func (s *OrdersServer) GetShipment(ctx context.Context, req *ShipmentRequest) (*ShipmentResponse, error) {
+	s.logger.Info("Received GetShipment gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid shipment ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid shipment ID format")
+	}
+
+	shipment, err := s.shipments.GetShipment(ctx, id)
+	if err != nil {
+		return nil, s.shipmentError("Failed to get shipment", err)
+	}
+
+	return &ShipmentResponse{Shipment: convertDomainShipmentToPB(shipment)}, nil
+}
+
+func (s *OrdersServer) ListOrderShipments(ctx context.Context, req *GetOrderRequest) (*ListShipmentsResponse, error) {
+	s.logger.Info("Received ListOrderShipments gRPC request", "order_id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	shipments, err := s.shipments.ListOrderShipments(ctx, id)
+	if err != nil {
+		return nil, s.shipmentError("Failed to list order shipments", err)
+	}
+
+	resp := &ListShipmentsResponse{Shipments: make([]*Shipment, 0, len(shipments))}
+	for _, shipment := range shipments {
+		resp.Shipments = append(resp.Shipments, convertDomainShipmentToPB(shipment))
+	}
+	return resp, nil
+}
+
+func (s *OrdersServer) shipmentError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrOrderNotFound):
+		return status.Error(codes.NotFound, "order not found")
+	case errors.Is(err, entity.ErrShipmentNotFound):
+		return status.Error(codes.NotFound, "shipment not found")
+	case errors.Is(err, entity.ErrInvalidShipment),
+		errors.Is(err, entity.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrOrderNotShippable),
+		errors.Is(err, entity.ErrInvalidStatusTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+func ValidateRequestReturnRequest(req *RequestReturnRequest) error {
+	if _, err := utils.ParseUUID(req.GetOrderId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+	if len(req.GetItems()) == 0 {
+		return status.Error(codes.InvalidArgument, "items cannot be empty")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+	}
+	return nil
+}
+
+func ValidateReceiveReturnRequest(req *ReceiveReturnRequest) error {
+	if _, err := utils.ParseUUID(req.GetId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid return ID format")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if _, ok := pbItemConditionToDomain[item.GetCondition()]; !ok {
+			return status.Error(codes.InvalidArgument, "item condition must be resellable or damaged")
+		}
+	}
+	return nil
+}
+
+func (s *OrdersServer) RequestReturn(ctx context.Context, req *RequestReturnRequest) (*ReturnResponse, error) {
+	s.logger.Info("Received RequestReturn gRPC request", "order_id", req.GetOrderId(), "items", len(req.GetItems()))
+
+	if err := ValidateRequestReturnRequest(req); err != nil {
+		s.logger.Error("Invalid return request", "error", err)
+		return nil, err
+	}
+
+	params := application.RequestReturnParams{Reason: req.GetReason()}
+	for _, item := range req.GetItems() {
+		params.Items = append(params.Items, entity.ReturnItem{
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+			Reason:    item.GetReason(),
+		})
+	}
+
+	r, err := s.returns.RequestReturn(ctx, entity.UUID(req.GetOrderId()), params)
+	if err != nil {
+		return nil, s.returnError("Failed to request return", err)
+	}
+
+	return &ReturnResponse{Return: convertDomainReturnToPB(r)}, nil
+}
+
+func (s *OrdersServer) ApproveReturn(ctx context.Context, req *ReturnDecisionRequest) (*ReturnResponse, error) {
+	s.logger.Info("Received ApproveReturn gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid return ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid return ID format")
+	}
+
+	r, err := s.returns.ApproveReturn(ctx, id, req.GetReason())
+	if err != nil {
+		return nil, s.returnError("Failed to approve return", err)
+	}
+
+	return &ReturnResponse{Return: convertDomainReturnToPB(r)}, nil
+}
+
+func (s *OrdersServer) RejectReturn(ctx context.Context, req *ReturnDecisionRequest) (*ReturnResponse, error) {
+	s.logger.Info("Received RejectReturn gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid return ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid return ID format")
+	}
+	if strings.TrimSpace(req.GetReason()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason cannot be empty")
+	}
+
+	r, err := s.returns.RejectReturn(ctx, id, req.GetReason())
+	if err != nil {
+		return nil, s.returnError("Failed to reject return", err)
+	}
+
+	return &ReturnResponse{Return: convertDomainReturnToPB(r)}, nil
+}
+
+func (s *OrdersServer) ReceiveReturn(ctx context.Context, req *ReceiveReturnRequest) (*ReturnResponse, error) {
+	s.logger.Info("Received ReceiveReturn gRPC request", "id", req.GetId(), "items", len(req.GetItems()))
+
+	if err := ValidateReceiveReturnRequest(req); err != nil {
+		s.logger.Error("Invalid receive return request", "error", err)
+		return nil, err
+	}
+
+	conditions := make(map[entity.UUID]entity.ItemCondition, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		conditions[entity.UUID(item.GetProductId())] = pbItemConditionToDomain[item.GetCondition()]
+	}
+
+	r, err := s.returns.ReceiveReturn(ctx, entity.UUID(req.GetId()), conditions)
+	if err != nil {
+		return nil, s.returnError("Failed to receive return", err)
+	}
+
+	return &ReturnResponse{Return: convertDomainReturnToPB(r)}, nil
+}
+
+func (s *OrdersServer) RefundReturn(ctx context.Context, req *ReturnRequest) (*ReturnResponse, error) {
+	s.logger.Info("Received RefundReturn gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid return ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid return ID format")
+	}
+
+	r, err := s.returns.RefundReturn(ctx, id)
+	if err != nil {
+		return nil, s.returnError("Failed to refund return", err)
+	}
+
+	return &ReturnResponse{Return: convertDomainReturnToPB(r)}, nil
+}
+
+func (s *OrdersServer) GetReturn(ctx context.Context, req *ReturnRequest) (*ReturnResponse, error) {
+	s.logger.Info("Received GetReturn gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid return ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid return ID format")
+	}
+
+	r, err := s.returns.GetReturn(ctx, id)
+	if err != nil {
+		return nil, s.returnError("Failed to get return", err)
+	}
+
+	return &ReturnResponse{Return: convertDomainReturnToPB(r)}, nil
+}
+
+func (s *OrdersServer) ListOrderReturns(ctx context.Context, req *GetOrderRequest) (*ListReturnsResponse, error) {
+	s.logger.Info("Received ListOrderReturns gRPC request", "order_id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid order ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	returns, err := s.returns.ListOrderReturns(ctx, id)
+	if err != nil {
+		return nil, s.returnError("Failed to list order returns", err)
+	}
+
+	resp := &ListReturnsResponse{Returns: make([]*Return, 0, len(returns))}
+	for _, r := range returns {
+		resp.Returns = append(resp.Returns, convertDomainReturnToPB(r))
+	}
+	return resp, nil
+}
+
+func (s *OrdersServer) returnError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrOrderNotFound):
+		return status.Error(codes.NotFound, "order not found")
+	case errors.Is(err, entity.ErrReturnNotFound):
+		return status.Error(codes.NotFound, "return not found")
+	case errors.Is(err, entity.ErrInvalidReturn),
+		errors.Is(err, entity.ErrInvalidQuantity),
+		errors.Is(err, entity.ErrRefundExceedsCaptured):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrOrderNotReturnable),
+		errors.Is(err, entity.ErrInvalidReturnTransition),
+		errors.Is(err, entity.ErrOrderNotPaid),
+		errors.Is(err, entity.ErrOrderNotRefundable),
+		errors.Is(err, entity.ErrNothingToRefund):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+func ValidateCartItemRequest(userID, productID string) error {
+	if _, err := utils.ParseUUID(userID); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	if _, err := utils.ParseUUID(productID); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid product ID format")
+	}
+	return nil
+}
+
+func (s *OrdersServer) GetCart(ctx context.Context, req *CartRequest) (*CartResponse, error) {
+	s.logger.Info("Received GetCart gRPC request", "user_id", req.GetUserId())
+
+	userID, err := utils.ParseUUID(req.GetUserId())
+	if err != nil {
+		s.logger.Error("Invalid user ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	cart, err := s.carts.GetCart(ctx, userID)
+	if err != nil {
+		return nil, s.cartError("Failed to get cart", err)
+	}
+
+	return s.cartResponse(cart)
+}
+
+func (s *OrdersServer) AddCartItem(ctx context.Context, req *CartItemRequest) (*CartResponse, error) {
+	s.logger.Info("Received AddCartItem gRPC request", "user_id", req.GetUserId(), "product_id", req.GetProductId(), "quantity", req.GetQuantity())
+
+	if err := ValidateCartItemRequest(req.GetUserId(), req.GetProductId()); err != nil {
+		s.logger.Error("Invalid cart item request", "error", err)
+		return nil, err
+	}
+	if req.GetQuantity() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	cart, err := s.carts.AddCartItem(ctx, entity.UUID(req.GetUserId()), entity.UUID(req.GetProductId()), int64(req.GetQuantity()))
+	if err != nil {
+		return nil, s.cartError("Failed to add cart item", err)
+	}
+
+	return s.cartResponse(cart)
+}
+
+func (s *OrdersServer) UpdateCartItem(ctx context.Context, req *CartItemRequest) (*CartResponse, error) {
+	s.logger.Info("Received UpdateCartItem gRPC request", "user_id", req.GetUserId(), "product_id", req.GetProductId(), "quantity", req.GetQuantity())
+
+	if err := ValidateCartItemRequest(req.GetUserId(), req.GetProductId()); err != nil {
+		s.logger.Error("Invalid cart item request", "error", err)
+		return nil, err
+	}
+	if req.GetQuantity() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity cannot be negative")
+	}
+
+	cart, err := s.carts.UpdateCartItem(ctx, entity.UUID(req.GetUserId()), entity.UUID(req.GetProductId()), int64(req.GetQuantity()))
+	if err != nil {
+		return nil, s.cartError("Failed to update cart item", err)
+	}
+
+	return s.cartResponse(cart)
+}
+
+func (s *OrdersServer) RemoveCartItem(ctx context.Context, req *RemoveCartItemRequest) (*CartResponse, error) {
+	s.logger.Info("Received RemoveCartItem gRPC request", "user_id", req.GetUserId(), "product_id", req.GetProductId())
+
+	if err := ValidateCartItemRequest(req.GetUserId(), req.GetProductId()); err != nil {
+		s.logger.Error("Invalid cart item request", "error", err)
+		return nil, err
+	}
+
+	cart, err := s.carts.RemoveCartItem(ctx, entity.UUID(req.GetUserId()), entity.UUID(req.GetProductId()))
+	if err != nil {
+		return nil, s.cartError("Failed to remove cart item", err)
+	}
+
+	return s.cartResponse(cart)
+}
+
+func (s *OrdersServer) Checkout(ctx context.Context, req *CheckoutRequest) (*OrderResponse, error) {
+	s.logger.Info("Received Checkout gRPC request", "user_id", req.GetUserId())
+
+	userID, err := utils.ParseUUID(req.GetUserId())
+	if err != nil {
+		s.logger.Error("Invalid user ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+
+	order, err := s.carts.Checkout(ctx, userID, &entity.Order{
+		UserName:        req.GetName(),
+		CouponCode:      req.GetCouponCode(),
+		TaxRegion:       req.GetTaxRegion(),
+		ShippingAddress: addressFromPB(req.GetShippingAddress()),
+		BillingAddress:  addressFromPB(req.GetBillingAddress()),
+	})
+	if err != nil && order != nil {
+		// The order is placed; recovery finishes it, and a cart left holding
+		// its items is only stale.
+		s.logger.Error("Checkout placed the order with errors", "user_id", req.GetUserId(), "error", err)
+	} else if err != nil {
+		if errors.Is(err, entity.ErrCartEmpty) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, s.createOrderError(err)
+	}
+
+	return &OrderResponse{Order: convertDomainOrderToPB(order)}, nil
+}
+
+func (s *OrdersServer) cartResponse(cart *entity.Cart) (*CartResponse, error) {
+	pb, err := convertDomainCartToPB(cart)
+	if err != nil {
+		s.logger.Error("Failed to price cart", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "cart items are priced in different currencies")
+	}
+	return &CartResponse{Cart: pb}, nil
+}
+
+func (s *OrdersServer) cartError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrItemNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, entity.ErrCartItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInsufficientQuantity):
+		return status.Error(codes.NotFound, "insufficient item quantity in storage")
+	case errors.Is(err, entity.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, "invalid item quantity")
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+func ValidateCreateSubscriptionRequest(req *CreateSubscriptionRequest) error {
+	sub := req.GetSubscription()
+	if sub == nil {
+		return status.Error(codes.InvalidArgument, "subscription cannot be empty")
+	}
+	if _, err := utils.ParseUUID(sub.GetUserId()); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	if len(sub.GetName()) == 0 {
+		return status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+	if len(sub.GetItems()) == 0 {
+		return status.Error(codes.InvalidArgument, "items cannot be empty")
+	}
+	for _, item := range sub.GetItems() {
+		if _, err := utils.ParseUUID(item.GetProductId()); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid product ID format")
+		}
+		if item.GetQuantity() <= 0 {
+			return status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+	}
+	if (sub.GetCron() == "") == (sub.GetIntervalSeconds() == 0) {
+		return status.Error(codes.InvalidArgument, "exactly one of cron and interval_seconds must be set")
+	}
+	if sub.GetIntervalSeconds() < 0 {
+		return status.Error(codes.InvalidArgument, "interval_seconds cannot be negative")
+	}
+	if _, ok := pbStockPolicyToDomain[sub.GetStockPolicy()]; !ok {
+		return status.Error(codes.InvalidArgument, "invalid stock policy")
+	}
+	return nil
+}
+
+func (s *OrdersServer) CreateSubscription(ctx context.Context, req *CreateSubscriptionRequest) (*SubscriptionResponse, error) {
+	s.logger.Info("Received CreateSubscription gRPC request", "user_id", req.GetSubscription().GetUserId())
+
+	if err := ValidateCreateSubscriptionRequest(req); err != nil {
+		s.logger.Error("Invalid create subscription request", "error", err)
+		return nil, err
+	}
+
+	subscription, err := s.subscriptions.CreateSubscription(ctx, convertPBSubscriptionToDomain(req.GetSubscription()))
+	if err != nil {
+		return nil, s.subscriptionError("Failed to create subscription", err)
+	}
+
+	return &SubscriptionResponse{Subscription: convertDomainSubscriptionToPB(subscription)}, nil
+}
+
+func (s *OrdersServer) GetSubscription(ctx context.Context, req *SubscriptionRequest) (*SubscriptionResponse, error) {
+	s.logger.Info("Received GetSubscription gRPC request", "id", req.GetId())
+	return s.subscriptionAction(ctx, req, "Failed to get subscription", s.subscriptions.GetSubscription)
+}
+
+func (s *OrdersServer) ListUserSubscriptions(ctx context.Context, req *ListUserSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	s.logger.Info("Received ListUserSubscriptions gRPC request", "user_id", req.GetUserId())
+
+	userID, err := utils.ParseUUID(req.GetUserId())
+	if err != nil {
+		s.logger.Error("Invalid user ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	subscriptions, err := s.subscriptions.ListUserSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to list subscriptions", err)
+	}
+
+	resp := &ListSubscriptionsResponse{Subscriptions: make([]*Subscription, 0, len(subscriptions))}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, convertDomainSubscriptionToPB(subscription))
+	}
+	return resp, nil
+}
+
+func (s *OrdersServer) PauseSubscription(ctx context.Context, req *SubscriptionRequest) (*SubscriptionResponse, error) {
+	s.logger.Info("Received PauseSubscription gRPC request", "id", req.GetId())
+	return s.subscriptionAction(ctx, req, "Failed to pause subscription", s.subscriptions.PauseSubscription)
+}
+
+func (s *OrdersServer) ResumeSubscription(ctx context.Context, req *SubscriptionRequest) (*SubscriptionResponse, error) {
+	s.logger.Info("Received ResumeSubscription gRPC request", "id", req.GetId())
+	return s.subscriptionAction(ctx, req, "Failed to resume subscription", s.subscriptions.ResumeSubscription)
+}
+
+func (s *OrdersServer) CancelSubscription(ctx context.Context, req *SubscriptionRequest) (*SubscriptionResponse, error) {
+	s.logger.Info("Received CancelSubscription gRPC request", "id", req.GetId())
+	return s.subscriptionAction(ctx, req, "Failed to cancel subscription", s.subscriptions.CancelSubscription)
+}
+
+func (s *OrdersServer) ListSubscriptionRuns(ctx context.Context, req *SubscriptionRequest) (*ListSubscriptionRunsResponse, error) {
+	s.logger.Info("Received ListSubscriptionRuns gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid subscription ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID format")
+	}
+
+	runs, err := s.subscriptions.ListSubscriptionRuns(ctx, id)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to list subscription runs", err)
+	}
+
+	resp := &ListSubscriptionRunsResponse{Runs: make([]*SubscriptionRun, 0, len(runs))}
+	for _, run := range runs {
+		resp.Runs = append(resp.Runs, convertDomainSubscriptionRunToPB(run))
+	}
+	return resp, nil
+}
+
+// subscriptionAction runs fn on the subscription req names.
+func (s *OrdersServer) subscriptionAction(ctx context.Context, req *SubscriptionRequest, msg string, fn func(context.Context, entity.UUID) (*entity.Subscription, error)) (*SubscriptionResponse, error) {
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid subscription ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID format")
+	}
+
+	subscription, err := fn(ctx, id)
+	if err != nil {
+		return nil, s.subscriptionError(msg, err)
+	}
+
+	return &SubscriptionResponse{Subscription: convertDomainSubscriptionToPB(subscription)}, nil
+}
+
+func (s *OrdersServer) subscriptionError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrSubscriptionNotFound):
+		return status.Error(codes.NotFound, "subscription not found")
+	case errors.Is(err, entity.ErrItemNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, entity.ErrInvalidSubscription),
+		errors.Is(err, entity.ErrInvalidCadence),
+		errors.Is(err, entity.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrInvalidSubscriptionTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+func (s *OrdersServer) GetInvoice(ctx context.Context, req *GetInvoiceRequest) (*InvoiceResponse, error) {
+	s.logger.Info("Received GetInvoice gRPC request", "id", req.GetId(), "order_id", req.GetOrderId())
+
+	var invoice *entity.Invoice
+	switch {
+	case req.GetId() != "" && req.GetOrderId() != "":
+		return nil, status.Error(codes.InvalidArgument, "set either id or order_id")
+	case req.GetId() != "":
+		id, err := utils.ParseUUID(req.GetId())
+		if err != nil {
+			s.logger.Error("Invalid invoice ID", "error", err)
+			return nil, status.Error(codes.InvalidArgument, "invalid invoice ID format")
+		}
+		if invoice, err = s.invoices.GetInvoice(ctx, id); err != nil {
+			return nil, s.invoiceError("Failed to get invoice", err)
+		}
+	default:
+		orderID, err := utils.ParseUUID(req.GetOrderId())
+		if err != nil {
+			s.logger.Error("Invalid order ID", "error", err)
+			return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+		}
+		if invoice, err = s.invoices.GetOrderInvoice(ctx, orderID); err != nil {
+			return nil, s.invoiceError("Failed to get order invoice", err)
+		}
+	}
+
+	rendered, err := s.invoices.RenderInvoice(invoice)
+	if err != nil {
+		return nil, s.invoiceError("Failed to render invoice", err)
+	}
+
+	return &InvoiceResponse{
+		Invoice: convertDomainInvoiceToPB(invoice),
+		Html:    rendered.HTML,
+		Text:    rendered.Text,
+	}, nil
+}
+
+func (s *OrdersServer) invoiceError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrOrderNotFound):
+		return status.Error(codes.NotFound, "order not found")
+	case errors.Is(err, entity.ErrInvoiceNotFound):
+		return status.Error(codes.NotFound, "invoice not found")
+	case errors.Is(err, entity.ErrOrderNotInvoiceable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+func ValidateCreateCouponRequest(req *CreateCouponRequest) error {
+	coupon := req.GetCoupon()
+	if coupon == nil {
+		return status.Error(codes.InvalidArgument, "coupon cannot be empty")
+	}
+	if strings.TrimSpace(coupon.GetCode()) == "" {
+		return status.Error(codes.InvalidArgument, "coupon code cannot be empty")
+	}
+	if _, ok := pbCouponKindToDomain[coupon.GetKind()]; !ok {
+		return status.Error(codes.InvalidArgument, "invalid coupon kind")
+	}
+	return nil
+}
+
+func (s *OrdersServer) CreateCoupon(ctx context.Context, req *CreateCouponRequest) (*CouponResponse, error) {
+	s.logger.Info("Received CreateCoupon gRPC request", "code", req.GetCoupon().GetCode())
+
+	if err := ValidateCreateCouponRequest(req); err != nil {
+		s.logger.Error("Invalid create coupon request", "error", err)
+		return nil, err
+	}
+
+	coupon := convertPBCouponToDomain(req.GetCoupon())
+	if err := s.promotions.CreateCoupon(ctx, coupon); err != nil {
+		return nil, s.couponError("Failed to create coupon", err)
+	}
+
+	return &CouponResponse{Coupon: convertDomainCouponToPB(coupon)}, nil
+}
+
+func (s *OrdersServer) GetCoupon(ctx context.Context, req *GetCouponRequest) (*CouponResponse, error) {
+	s.logger.Info("Received GetCoupon gRPC request", "code", req.GetCode())
+
+	if strings.TrimSpace(req.GetCode()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "coupon code cannot be empty")
+	}
+
+	coupon, err := s.promotions.GetCoupon(ctx, req.GetCode())
+	if err != nil {
+		return nil, s.couponError("Failed to get coupon", err)
+	}
+
+	return &CouponResponse{Coupon: convertDomainCouponToPB(coupon)}, nil
+}
+
+func (s *OrdersServer) ListCoupons(ctx context.Context, req *ListCouponsRequest) (*ListCouponsResponse, error) {
+	s.logger.Info("Received ListCoupons gRPC request", "page", req.GetPage(), "page_size", req.GetPageSize())
+
+	pagination := entity.NewPagination(int64(req.GetPage()), int64(req.GetPageSize()), entity.SortByUnknown)
+	paginatedData, err := s.promotions.ListCoupons(ctx, pagination)
+	if err != nil {
+		return nil, s.couponError("Failed to list coupons", err)
+	}
+
+	coupons := make([]*Coupon, 0, len(paginatedData.Data))
+	for _, coupon := range paginatedData.Data {
+		coupons = append(coupons, convertDomainCouponToPB(coupon))
+	}
+
+	return &ListCouponsResponse{
+		CurrentPage: int32(paginatedData.CurrentPage),
+		HasNextPage: paginatedData.HasNextPage,
+		PageSize:    int32(paginatedData.PageSize),
+		TotalPages:  int32(paginatedData.TotalPages),
+		Coupons:     coupons,
+	}, nil
+}
+
+func (s *OrdersServer) DeactivateCoupon(ctx context.Context, req *DeactivateCouponRequest) (*CouponResponse, error) {
+	s.logger.Info("Received DeactivateCoupon gRPC request", "id", req.GetId())
+
+	id, err := utils.ParseUUID(req.GetId())
+	if err != nil {
+		s.logger.Error("Invalid coupon ID", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid coupon ID format")
+	}
+
+	coupon, err := s.promotions.DeactivateCoupon(ctx, id)
+	if err != nil {
+		return nil, s.couponError("Failed to deactivate coupon", err)
+	}
+
+	return &CouponResponse{Coupon: convertDomainCouponToPB(coupon)}, nil
+}
+
+func (s *OrdersServer) couponError(msg string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrCouponNotFound):
+		return status.Error(codes.NotFound, "coupon not found")
+	case errors.Is(err, entity.ErrCouponAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrInvalidCoupon),
+		errors.Is(err, entity.ErrInvalidRequestPayload):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.logger.Error(msg, "error", err)
+	return status.Error(codes.Internal, strings.ToLower(msg))
+}
+
+var domainStatusToPB = map[entity.OrderStatus]OrderStatus{
+	entity.OrderStatusPending:    OrderStatus_ORDER_STATUS_PENDING,
+	entity.OrderStatusProcessing: OrderStatus_ORDER_STATUS_PROCESSING,
+	entity.OrderStatusCompleted:  OrderStatus_ORDER_STATUS_COMPLETED,
+	entity.OrderStatusCancelled:  OrderStatus_ORDER_STATUS_CANCELLED,
+	entity.OrderStatusRefunded:   OrderStatus_ORDER_STATUS_REFUNDED,
+}
+
+var pbStatusToDomain = map[OrderStatus]entity.OrderStatus{
+	OrderStatus_ORDER_STATUS_PENDING:    entity.OrderStatusPending,
+	OrderStatus_ORDER_STATUS_PROCESSING: entity.OrderStatusProcessing,
+	OrderStatus_ORDER_STATUS_COMPLETED:  entity.OrderStatusCompleted,
+	OrderStatus_ORDER_STATUS_CANCELLED:  entity.OrderStatusCancelled,
+	OrderStatus_ORDER_STATUS_REFUNDED:   entity.OrderStatusRefunded,
+}
+
+func convertDomainOrderToPB(order *entity.Order) *Order {
+	items := make([]*Item, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &Item{
+			ProductId:      item.ProductID.String(),
+			ProductName:    item.ProductName,
+			UnitPrice:      item.ProductPrice.Float64(),
+			UnitPriceMoney: moneyToPB(item.ProductPrice),
+			Quantity:       int32(item.Quantity),
+			CreatedAt:      timestamppb.New(item.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.UpdatedAt),
+		})
+	}
+
+	discounts := make([]*OrderDiscount, 0, len(order.Discounts))
+	for _, d := range order.Discounts {
+		discounts = append(discounts, &OrderDiscount{
+			CouponId:      d.CouponID.String(),
+			Code:          d.Code,
+			Kind:          domainCouponKindToPB[d.Kind],
+			PercentOffBps: d.PercentOff,
+			Amount:        moneyToPB(d.Amount),
+			FreeShipping:  d.FreeShipping,
+		})
+	}
+
+	taxLines := make([]*TaxLine, 0, len(order.TaxLines))
+	for _, line := range order.TaxLines {
+		taxLines = append(taxLines, &TaxLine{
+			ProductId: line.ProductID.String(),
+			TaxClass:  line.TaxClass,
+			Region:    line.Region,
+			Rate:      line.Rate,
+			Taxable:   moneyToPB(line.Taxable),
+			Amount:    moneyToPB(line.Amount),
+			Inclusive: line.Inclusive,
+		})
+	}
+
+	pb := &Order{
+		Id:            order.ID.String(),
+		UserId:        order.UserID.String(),
+		UserName:      order.UserName,
+		TotalAmount:   order.TotalAmount.Float64(),
+		TotalMoney:    moneyToPB(order.TotalAmount),
+		Status:        domainStatusToPB[order.Status],
+		Items:         items,
+		CreatedAt:     timestamppb.New(order.CreatedAt),
+		UpdatedAt:     timestamppb.New(order.UpdatedAt),
+		DiscountMoney: moneyToPB(order.DiscountTotal()),
+		Discounts:     discounts,
+		TaxRegion:     order.TaxRegion,
+		TaxMoney:      moneyToPB(order.TaxTotal()),
+		TaxLines:      taxLines,
+	}
+	if order.ShippingAddress != nil {
+		pb.ShippingAddress = addressToPB(order.ShippingAddress)
+	}
+	if order.BillingAddress != nil {
+		pb.BillingAddress = addressToPB(order.BillingAddress)
+	}
+	if subtotal, err := order.Subtotal(); err == nil {
+		pb.SubtotalMoney = moneyToPB(subtotal)
+	}
+	return pb
+}
+
+var domainCouponKindToPB = map[entity.CouponKind]CouponKind{
+	entity.CouponPercentage:   CouponKind_COUPON_KIND_PERCENTAGE,
+	entity.CouponFixedAmount:  CouponKind_COUPON_KIND_FIXED_AMOUNT,
+	entity.CouponFreeShipping: CouponKind_COUPON_KIND_FREE_SHIPPING,
+}
+
+var pbCouponKindToDomain = map[CouponKind]entity.CouponKind{
+	CouponKind_COUPON_KIND_PERCENTAGE:    entity.CouponPercentage,
+	CouponKind_COUPON_KIND_FIXED_AMOUNT:  entity.CouponFixedAmount,
+	CouponKind_COUPON_KIND_FREE_SHIPPING: entity.CouponFreeShipping,
+}
+
+func convertPBCouponToDomain(c *Coupon) *entity.Coupon {
+	coupon := &entity.Coupon{
+		Code:                  c.GetCode(),
+		Kind:                  pbCouponKindToDomain[c.GetKind()],
+		PercentOff:            c.GetPercentOffBps(),
+		MaxRedemptions:        c.GetMaxRedemptions(),
+		MaxRedemptionsPerUser: c.GetMaxRedemptionsPerUser(),
+	}
+	if c.GetAmountOff() != nil {
+		coupon.AmountOff = moneyFromPB(c.GetAmountOff())
+	}
+	if c.GetMinOrderValue() != nil {
+		coupon.MinOrderValue = moneyFromPB(c.GetMinOrderValue())
+	}
+	if c.GetValidFrom() != nil {
+		coupon.ValidFrom = c.GetValidFrom().AsTime()
+	}
+	if c.GetValidUntil() != nil {
+		coupon.ValidUntil = c.GetValidUntil().AsTime()
+	}
+	return coupon
+}
+
+func convertDomainCouponToPB(coupon *entity.Coupon) *Coupon {
+	pb := &Coupon{
+		Id:                    coupon.ID.String(),
+		Code:                  coupon.Code,
+		Kind:                  domainCouponKindToPB[coupon.Kind],
+		PercentOffBps:         coupon.PercentOff,
+		MaxRedemptions:        coupon.MaxRedemptions,
+		MaxRedemptionsPerUser: coupon.MaxRedemptionsPerUser,
+		Redemptions:           coupon.Redemptions,
+		Active:                coupon.Active,
+		CreatedAt:             timestamppb.New(coupon.CreatedAt),
+		UpdatedAt:             timestamppb.New(coupon.UpdatedAt),
+	}
+	if coupon.Kind == entity.CouponFixedAmount {
+		pb.AmountOff = moneyToPB(coupon.AmountOff)
+	}
+	if coupon.MinOrderValue.IsPositive() {
+		pb.MinOrderValue = moneyToPB(coupon.MinOrderValue)
+	}
+	if !coupon.ValidFrom.IsZero() {
+		pb.ValidFrom = timestamppb.New(coupon.ValidFrom)
+	}
+	if !coupon.ValidUntil.IsZero() {
+		pb.ValidUntil = timestamppb.New(coupon.ValidUntil)
+	}
+	return pb
+}
+
+func convertDomainOrderEventToPB(event *entity.OrderEvent) *OrderEvent {
+	pb := &OrderEvent{
+		Id:          event.ID.String(),
+		OrderId:     event.OrderID.String(),
+		Type:        event.Type,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+		ResumeToken: event.ResumeToken(),
+	}
+	if event.Order != nil {
+		pb.Order = convertDomainOrderToPB(event.Order)
+	}
+	if event.Type == entity.EventOrderStatusChanged {
+		pb.StatusChange = &OrderStatusChange{
+			From: domainStatusToPB[event.FromStatus],
+			To:   domainStatusToPB[event.ToStatus],
+		}
+	}
+	if event.Payment != nil {
+		pb.Payment = convertDomainPaymentToPB(event.Payment)
+	}
+	if event.Shipment != nil {
+		pb.Shipment = convertDomainShipmentToPB(event.Shipment)
+	}
+	if event.Return != nil {
+		pb.Return = convertDomainReturnToPB(event.Return)
+	}
+	return pb
+}
+
+func convertDomainStatusHistoryToPB(history []entity.StatusTransition) []*StatusHistoryEntry {
+	entries := make([]*StatusHistoryEntry, 0, len(history))
+	for _, t := range history {
+		entry := &StatusHistoryEntry{
+			To:        domainStatusToPB[t.To],
+			Actor:     t.Actor,
+			Reason:    t.Reason,
+			ChangedAt: timestamppb.New(t.At),
+		}
+		if t.From != "" {
+			from := domainStatusToPB[t.From]
+			entry.From = &from
+		}
+		if t.ReturnID != "" {
+			returnID := t.ReturnID.String()
+			returnStatus := domainReturnStatusToPB[t.ReturnStatus]
+			entry.ReturnId = &returnID
+			entry.ReturnStatus = &returnStatus
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+var domainShipmentStatusToPB = map[entity.ShipmentStatus]ShipmentStatus{
+	entity.ShipmentStatusShipped:   ShipmentStatus_SHIPMENT_STATUS_SHIPPED,
+	entity.ShipmentStatusDelivered: ShipmentStatus_SHIPMENT_STATUS_DELIVERED,
+}
+
+func convertDomainShipmentToPB(shipment *entity.Shipment) *Shipment {
+	items := make([]*ShipmentItem, 0, len(shipment.Items))
+	for _, item := range shipment.Items {
+		items = append(items, &ShipmentItem{
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+		})
+	}
+
+	pb := &Shipment{
+		Id:             shipment.ID.String(),
+		OrderId:        shipment.OrderID.String(),
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         domainShipmentStatusToPB[shipment.Status],
+		Items:          items,
+		ShippedAt:      timestamppb.New(shipment.ShippedAt),
+		CreatedAt:      timestamppb.New(shipment.CreatedAt),
+		UpdatedAt:      timestamppb.New(shipment.UpdatedAt),
+	}
+	if !shipment.DeliveredAt.IsZero() {
+		pb.DeliveredAt = timestamppb.New(shipment.DeliveredAt)
+	}
+	return pb
+}
+
+var domainReturnStatusToPB = map[entity.ReturnStatus]ReturnStatus{
+	entity.ReturnStatusRequested: ReturnStatus_RETURN_STATUS_REQUESTED,
+	entity.ReturnStatusApproved:  ReturnStatus_RETURN_STATUS_APPROVED,
+	entity.ReturnStatusReceived:  ReturnStatus_RETURN_STATUS_RECEIVED,
+	entity.ReturnStatusRefunded:  ReturnStatus_RETURN_STATUS_REFUNDED,
+	entity.ReturnStatusRejected:  ReturnStatus_RETURN_STATUS_REJECTED,
+}
+
+var domainItemConditionToPB = map[entity.ItemCondition]ItemCondition{
+	entity.ItemConditionResellable: ItemCondition_ITEM_CONDITION_RESELLABLE,
+	entity.ItemConditionDamaged:    ItemCondition_ITEM_CONDITION_DAMAGED,
+}
+
+var pbItemConditionToDomain = map[ItemCondition]entity.ItemCondition{
+	ItemCondition_ITEM_CONDITION_RESELLABLE: entity.ItemConditionResellable,
+	ItemCondition_ITEM_CONDITION_DAMAGED:    entity.ItemConditionDamaged,
+}
+
+func convertDomainReturnToPB(r *entity.Return) *Return {
+	items := make([]*ReturnItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, &ReturnItem{
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+			Reason:    item.Reason,
+			Condition: domainItemConditionToPB[item.Condition],
+		})
+	}
+
+	return &Return{
+		Id:        r.ID.String(),
+		OrderId:   r.OrderID.String(),
+		Status:    domainReturnStatusToPB[r.Status],
+		Items:     items,
+		RefundId:  r.RefundID.String(),
+		Reason:    r.Reason,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func convertDomainCartToPB(cart *entity.Cart) (*Cart, error) {
+	subtotal, err := cart.Subtotal()
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &Cart{
+		UserId:   cart.UserID.String(),
+		Items:    make([]*CartItem, 0, len(cart.Items)),
+		Subtotal: moneyToPB(subtotal),
+		InStock:  true,
+	}
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		pbItem := &CartItem{
+			ProductId:         item.ProductID.String(),
+			Quantity:          int32(item.Quantity),
+			ProductName:       item.ProductName,
+			AvailableQuantity: int32(item.AvailableQuantity),
+			InStock:           item.InStock(),
+			Missing:           item.Missing,
+			AddedAt:           timestamppb.New(item.AddedAt),
+		}
+		if !item.Missing {
+			pbItem.UnitPrice = moneyToPB(item.UnitPrice)
+			pbItem.LineTotal = moneyToPB(item.UnitPrice.Mul(item.Quantity))
+		}
+		pb.InStock = pb.InStock && pbItem.InStock
+		pb.Items = append(pb.Items, pbItem)
+	}
+	if !cart.CreatedAt.IsZero() {
+		pb.CreatedAt = timestamppb.New(cart.CreatedAt)
+		pb.UpdatedAt = timestamppb.New(cart.UpdatedAt)
+		pb.ExpiresAt = timestamppb.New(cart.ExpiresAt)
+	}
+	return pb, nil
+}
+
+var domainSubscriptionStatusToPB = map[entity.SubscriptionStatus]SubscriptionStatus{
+	entity.SubscriptionStatusActive: SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE,
+	entity.SubscriptionStatusPaused: SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED,
+	entity.SubscriptionStatusEnded:  SubscriptionStatus_SUBSCRIPTION_STATUS_ENDED,
+}
+
+var domainStockPolicyToPB = map[entity.StockPolicy]StockPolicy{
+	entity.StockPolicySkip:    StockPolicy_STOCK_POLICY_SKIP,
+	entity.StockPolicyPartial: StockPolicy_STOCK_POLICY_PARTIAL,
+}
+
+var pbStockPolicyToDomain = map[StockPolicy]entity.StockPolicy{
+	StockPolicy_STOCK_POLICY_SKIP:    entity.StockPolicySkip,
+	StockPolicy_STOCK_POLICY_PARTIAL: entity.StockPolicyPartial,
+}
+
+var domainSubscriptionRunStatusToPB = map[entity.SubscriptionRunStatus]SubscriptionRunStatus{
+	entity.SubscriptionRunOrdered: SubscriptionRunStatus_SUBSCRIPTION_RUN_STATUS_ORDERED,
+	entity.SubscriptionRunPartial: SubscriptionRunStatus_SUBSCRIPTION_RUN_STATUS_PARTIAL,
+	entity.SubscriptionRunSkipped: SubscriptionRunStatus_SUBSCRIPTION_RUN_STATUS_SKIPPED,
+	entity.SubscriptionRunFailed:  SubscriptionRunStatus_SUBSCRIPTION_RUN_STATUS_FAILED,
+}
+
+func convertPBSubscriptionToDomain(sub *Subscription) entity.Subscription {
+	draft := entity.Subscription{
+		UserID:   entity.UUID(sub.GetUserId()),
+		UserName: sub.GetName(),
+		Items:    make([]entity.SubscriptionItem, 0, len(sub.GetItems())),
+		Cadence: entity.Cadence{
+			Cron:     sub.GetCron(),
+			Interval: time.Duration(sub.GetIntervalSeconds()) * time.Second,
+		},
+		StockPolicy: pbStockPolicyToDomain[sub.GetStockPolicy()],
+	}
+	for _, item := range sub.GetItems() {
+		draft.Items = append(draft.Items, entity.SubscriptionItem{
+			ProductID: entity.UUID(item.GetProductId()),
+			Quantity:  int64(item.GetQuantity()),
+		})
+	}
+	if sub.GetStartAt() != nil {
+		draft.StartAt = sub.GetStartAt().AsTime()
+	}
+	if sub.GetEndAt() != nil {
+		draft.EndAt = sub.GetEndAt().AsTime()
+	}
+	return draft
+}
+
+func convertDomainSubscriptionToPB(sub *entity.Subscription) *Subscription {
+	items := make([]*SubscriptionItem, 0, len(sub.Items))
+	for _, item := range sub.Items {
+		items = append(items, &SubscriptionItem{
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+		})
+	}
+
+	pb := &Subscription{
+		Id:              sub.ID.String(),
+		UserId:          sub.UserID.String(),
+		Name:            sub.UserName,
+		Items:           items,
+		Cron:            sub.Cadence.Cron,
+		IntervalSeconds: int64(sub.Cadence.Interval / time.Second),
+		StockPolicy:     domainStockPolicyToPB[sub.StockPolicy],
+		Status:          domainSubscriptionStatusToPB[sub.Status],
+		StartAt:         timestamppb.New(sub.StartAt),
+		NextRunAt:       timestamppb.New(sub.NextRunAt),
+		CreatedAt:       timestamppb.New(sub.CreatedAt),
+		UpdatedAt:       timestamppb.New(sub.UpdatedAt),
+	}
+	if !sub.EndAt.IsZero() {
+		pb.EndAt = timestamppb.New(sub.EndAt)
+	}
+	return pb
+}
+
+func convertDomainSubscriptionRunToPB(run *entity.SubscriptionRun) *SubscriptionRun {
+	return &SubscriptionRun{
+		Id:             run.ID.String(),
+		SubscriptionId: run.SubscriptionID.String(),
+		ScheduledAt:    timestamppb.New(run.ScheduledAt),
+		OrderId:        run.OrderID.String(),
+		Status:         domainSubscriptionRunStatusToPB[run.Status],
+		Reason:         run.Reason,
+		CreatedAt:      timestamppb.New(run.CreatedAt),
+	}
+}
+
+func convertDomainInvoiceToPB(invoice *entity.Invoice) *Invoice {
+	pb := &Invoice{
+		Id:            invoice.ID.String(),
+		Number:        invoice.Number,
+		OrderId:       invoice.OrderID.String(),
+		UserId:        invoice.UserID.String(),
+		Name:          invoice.UserName,
+		Lines:         make([]*InvoiceLine, 0, len(invoice.Lines)),
+		Discounts:     make([]*InvoiceDiscount, 0, len(invoice.Discounts)),
+		Subtotal:      moneyToPB(invoice.Subtotal),
+		DiscountTotal: moneyToPB(invoice.DiscountTotal),
+		TaxTotal:      moneyToPB(invoice.TaxTotal),
+		Total:         moneyToPB(invoice.Total),
+		IssuedAt:      timestamppb.New(invoice.IssuedAt),
+	}
+	if invoice.BillingAddress != nil {
+		pb.BillingAddress = addressToPB(invoice.BillingAddress)
+	}
+	for _, line := range invoice.Lines {
+		pb.Lines = append(pb.Lines, &InvoiceLine{
+			ProductId:    line.ProductID.String(),
+			ProductName:  line.ProductName,
+			Quantity:     int32(line.Quantity),
+			UnitPrice:    moneyToPB(line.UnitPrice),
+			Amount:       moneyToPB(line.Amount),
+			TaxRate:      line.TaxRate,
+			TaxAmount:    moneyToPB(line.TaxAmount),
+			TaxInclusive: line.TaxInclusive,
+		})
+	}
+	for _, d := range invoice.Discounts {
+		pb.Discounts = append(pb.Discounts, &InvoiceDiscount{Code: d.Code, Amount: moneyToPB(d.Amount)})
+	}
+	return pb
+}
+
+func addressFromPB(a *Address) *entity.Address {
+	if a == nil {
+		return nil
+	}
+	return &entity.Address{
+		Name:       a.GetName(),
+		Line1:      a.GetLine1(),
+		Line2:      a.GetLine2(),
+		City:       a.GetCity(),
+		Region:     a.GetRegion(),
+		PostalCode: a.GetPostalCode(),
+		Country:    strings.ToUpper(a.GetCountry()),
+		Phone:      a.GetPhone(),
+	}
+}
+
+func addressToPB(a *entity.Address) *Address {
+	return &Address{
+		Name:       a.Name,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Phone:      a.Phone,
+	}
+}
+
+var domainPaymentStatusToPB = map[entity.PaymentStatus]PaymentStatus{
+	entity.PaymentStatusPending:    PaymentStatus_PAYMENT_STATUS_PENDING,
+	entity.PaymentStatusAuthorized: PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+	entity.PaymentStatusCaptured:   PaymentStatus_PAYMENT_STATUS_CAPTURED,
+	entity.PaymentStatusFailed:     PaymentStatus_PAYMENT_STATUS_FAILED,
+	entity.PaymentStatusRefunded:   PaymentStatus_PAYMENT_STATUS_REFUNDED,
+}
+
+var domainPaymentKindToPB = map[entity.PaymentKind]PaymentKind{
+	entity.PaymentKindCharge: PaymentKind_PAYMENT_KIND_CHARGE,
+	entity.PaymentKindRefund: PaymentKind_PAYMENT_KIND_REFUND,
+}
+
+func convertDomainPaymentToPB(payment *entity.Payment) *Payment {
+	lines := make([]*RefundLine, 0, len(payment.Lines))
+	for _, line := range payment.Lines {
+		lines = append(lines, &RefundLine{
+			ProductId:   line.ProductID.String(),
+			Quantity:    int32(line.Quantity),
+			Amount:      line.Amount.Float64(),
+			AmountMoney: moneyToPB(line.Amount),
+		})
+	}
+
+	return &Payment{
+		Id:            payment.ID.String(),
+		OrderId:       payment.OrderID.String(),
+		Amount:        payment.Amount.Float64(),
+		AmountMoney:   moneyToPB(payment.Amount),
+		Currency:      payment.Amount.Currency,
+		PaymentMethod: payment.Method,
+		Status:        domainPaymentStatusToPB[payment.Status],
+		FailureReason: payment.FailureReason,
+		CreatedAt:     timestamppb.New(payment.CreatedAt),
+		UpdatedAt:     timestamppb.New(payment.UpdatedAt),
+		Kind:          domainPaymentKindToPB[payment.Kind],
+		ParentId:      payment.ParentID.String(),
+		Lines:         lines,
+	}
+}
+
+func moneyToPB(m money.Money) *Money {
+	return &Money{MinorUnits: m.Amount, CurrencyCode: m.Currency}
+}
+
+// moneyFromPB reads an amount sent by a client; without a currency code it is
+// in entity.DefaultCurrency.
+func moneyFromPB(m *Money) money.Money {
+	currency := strings.ToUpper(m.GetCurrencyCode())
+	if currency == "" {
+		currency = entity.DefaultCurrency
+	}
+	return money.New(m.GetMinorUnits(), currency)
+}
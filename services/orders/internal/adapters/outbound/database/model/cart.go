@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type Cart struct {
+	UserID    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type CartItem struct {
+	UserID    string
+	ProductID string
+	Quantity  int64
+	AddedAt   time.Time
+}
+
+func CartToModel(cart *entity.Cart) (*Cart, []CartItem) {
+	items := make([]CartItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, CartItem{
+			UserID:    cart.UserID.String(),
+			ProductID: item.ProductID.String(),
+			Quantity:  item.Quantity,
+			AddedAt:   item.AddedAt,
+		})
+	}
+
+	return &Cart{
+		UserID:    cart.UserID.String(),
+		CreatedAt: cart.CreatedAt,
+		UpdatedAt: cart.UpdatedAt,
+		ExpiresAt: cart.ExpiresAt,
+	}, items
+}
+
+func ModelToCart(m *Cart, items []CartItem) *entity.Cart {
+	cart := &entity.Cart{
+		UserID:    entity.UUID(m.UserID),
+		Items:     make([]entity.CartItem, 0, len(items)),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+		ExpiresAt: m.ExpiresAt,
+	}
+	for _, item := range items {
+		cart.Items = append(cart.Items, entity.CartItem{
+			ProductID: entity.UUID(item.ProductID),
+			Quantity:  item.Quantity,
+			AddedAt:   item.AddedAt,
+		})
+	}
+	return cart
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+type Coupon struct {
+	ID                    string
+	Code                  string
+	Kind                  string
+	PercentOff            int64
+	AmountOff             string
+	MinOrderValue         string
+	Currency              string
+	ValidFrom             sql.NullTime
+	ValidUntil            sql.NullTime
+	MaxRedemptions        int64
+	MaxRedemptionsPerUser int64
+	Redemptions           int64
+	Active                bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+type OrderDiscount struct {
+	OrderID       string    `json:"order_id"`
+	CouponID      string    `json:"coupon_id"`
+	Code          string    `json:"code"`
+	Kind          string    `json:"kind"`
+	PercentOff    int64     `json:"percent_off"`
+	AmountOff     string    `json:"amount_off,omitempty"`
+	MinOrderValue string    `json:"min_order_value,omitempty"`
+	Amount        string    `json:"amount"`
+	FreeShipping  bool      `json:"free_shipping"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func CouponToModel(c *entity.Coupon) *Coupon {
+	return &Coupon{
+		ID:                    c.ID.String(),
+		Code:                  c.Code,
+		Kind:                  string(c.Kind),
+		PercentOff:            c.PercentOff,
+		AmountOff:             c.AmountOff.Decimal(),
+		MinOrderValue:         c.MinOrderValue.Decimal(),
+		Currency:              c.Currency(),
+		ValidFrom:             sql.NullTime{Time: c.ValidFrom, Valid: !c.ValidFrom.IsZero()},
+		ValidUntil:            sql.NullTime{Time: c.ValidUntil, Valid: !c.ValidUntil.IsZero()},
+		MaxRedemptions:        c.MaxRedemptions,
+		MaxRedemptionsPerUser: c.MaxRedemptionsPerUser,
+		Redemptions:           c.Redemptions,
+		Active:                c.Active,
+		CreatedAt:             c.CreatedAt,
+		UpdatedAt:             c.UpdatedAt,
+	}
+}
+
+func ModelToCoupon(m *Coupon) (*entity.Coupon, error) {
+	amountOff, err := money.Parse(m.AmountOff, m.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("coupon %s amount off: %w", m.ID, err)
+	}
+	minOrderValue, err := money.Parse(m.MinOrderValue, m.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("coupon %s minimum order value: %w", m.ID, err)
+	}
+
+	return &entity.Coupon{
+		ID:                    entity.UUID(m.ID),
+		Code:                  m.Code,
+		Kind:                  entity.CouponKind(m.Kind),
+		PercentOff:            m.PercentOff,
+		AmountOff:             amountOff,
+		MinOrderValue:         minOrderValue,
+		ValidFrom:             m.ValidFrom.Time,
+		ValidUntil:            m.ValidUntil.Time,
+		MaxRedemptions:        m.MaxRedemptions,
+		MaxRedemptionsPerUser: m.MaxRedemptionsPerUser,
+		Redemptions:           m.Redemptions,
+		Active:                m.Active,
+		CreatedAt:             m.CreatedAt,
+		UpdatedAt:             m.UpdatedAt,
+	}, nil
+}
+
+func OrderDiscountToModel(orderID entity.UUID, d entity.OrderDiscount) OrderDiscount {
+	return OrderDiscount{
+		OrderID:       orderID.String(),
+		CouponID:      d.CouponID.String(),
+		Code:          d.Code,
+		Kind:          string(d.Kind),
+		PercentOff:    d.PercentOff,
+		AmountOff:     d.AmountOff.Decimal(),
+		MinOrderValue: d.MinOrderValue.Decimal(),
+		Amount:        d.Amount.Decimal(),
+		FreeShipping:  d.FreeShipping,
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+// ModelToOrderDiscount parses a discount of an order priced in currency.
+// Events recorded before discounts kept the coupon's terms have no amount off
+// or minimum order value; those are read as zero.
+func ModelToOrderDiscount(m OrderDiscount, currency string) (entity.OrderDiscount, error) {
+	amount, err := money.Parse(m.Amount, currency)
+	if err != nil {
+		return entity.OrderDiscount{}, fmt.Errorf("order %s discount %s amount: %w", m.OrderID, m.Code, err)
+	}
+	amountOff, minOrderValue := money.New(0, currency), money.New(0, currency)
+	if m.AmountOff != "" {
+		if amountOff, err = money.Parse(m.AmountOff, currency); err != nil {
+			return entity.OrderDiscount{}, fmt.Errorf("order %s discount %s amount off: %w", m.OrderID, m.Code, err)
+		}
+	}
+	if m.MinOrderValue != "" {
+		if minOrderValue, err = money.Parse(m.MinOrderValue, currency); err != nil {
+			return entity.OrderDiscount{}, fmt.Errorf("order %s discount %s minimum order value: %w", m.OrderID, m.Code, err)
+		}
+	}
+	return entity.OrderDiscount{
+		CouponID:      entity.UUID(m.CouponID),
+		Code:          m.Code,
+		Kind:          entity.CouponKind(m.Kind),
+		PercentOff:    m.PercentOff,
+		AmountOff:     amountOff,
+		MinOrderValue: minOrderValue,
+		Amount:        amount,
+		FreeShipping:  m.FreeShipping,
+		CreatedAt:     m.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+// OrderEvent is the payload of order.created and order.updated events: the
+// order as it is stored, with its items, discounts, taxes and addresses.
+type OrderEvent struct {
+	Order
+	Items     []OrderItem     `json:"items"`
+	Discounts []OrderDiscount `json:"discounts,omitempty"`
+	TaxLines  []OrderTaxLine  `json:"tax_lines,omitempty"`
+	Addresses []OrderAddress  `json:"addresses,omitempty"`
+}
+
+// OrderStatusChangedEvent is the payload of order.status_changed events.
+type OrderStatusChangedEvent struct {
+	ID   string `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// OrderDeletedEvent is the payload of order.deleted events.
+type OrderDeletedEvent struct {
+	ID string `json:"id"`
+}
+
+// ShipmentEvent is the payload of shipment.created and shipment.updated
+// events.
+type ShipmentEvent struct {
+	Shipment
+	Items []ShipmentItem `json:"items"`
+}
+
+// ReturnEvent is the payload of return.created and return.updated events.
+type ReturnEvent struct {
+	Return
+	Items []ReturnItem `json:"items"`
+}
+
+// PaymentEvent is the payload of payment.created and payment.updated events.
+type PaymentEvent struct {
+	Payment
+	Lines []RefundLine `json:"lines,omitempty"`
+}
+
+func OrderToEvent(order *entity.Order) (OrderEvent, error) {
+	o, items, err := OrderToModel(order)
+	if err != nil {
+		return OrderEvent{}, err
+	}
+	event := OrderEvent{Order: *o, Items: items}
+	for _, d := range order.Discounts {
+		event.Discounts = append(event.Discounts, OrderDiscountToModel(order.ID, d))
+	}
+	for _, line := range order.TaxLines {
+		event.TaxLines = append(event.TaxLines, TaxLineToModel(order.ID, line))
+	}
+	event.Addresses = AddressesToModel(order)
+	return event, nil
+}
+
+func PaymentToEvent(payment *entity.Payment) PaymentEvent {
+	p, lines := PaymentToModel(payment)
+	return PaymentEvent{Payment: *p, Lines: lines}
+}
+
+func ShipmentToEvent(shipment *entity.Shipment) ShipmentEvent {
+	m, items := ShipmentToModel(shipment)
+	return ShipmentEvent{Shipment: *m, Items: items}
+}
+
+func ReturnToEvent(r *entity.Return) ReturnEvent {
+	m, items := ReturnToModel(r)
+	return ReturnEvent{Return: *m, Items: items}
+}
+
+// EventToOrderEvent decodes the payload of an outbox event recorded for an
+// order. Events of a type it does not know keep only their envelope.
+func EventToOrderEvent(seq int64, event entity.Event) (*entity.OrderEvent, error) {
+	e := &entity.OrderEvent{
+		Seq:        seq,
+		ID:         event.ID,
+		OrderID:    entity.UUID(event.AggregateID),
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+	}
+
+	switch event.Type {
+	case entity.EventOrderCreated, entity.EventOrderUpdated:
+		var payload OrderEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		order, err := ModelToOrder(&payload.Order, payload.Items, payload.Discounts, payload.TaxLines, payload.Addresses)
+		if err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		e.Order = order
+	case entity.EventOrderStatusChanged:
+		var payload OrderStatusChangedEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		e.FromStatus = entity.OrderStatus(payload.From)
+		e.ToStatus = entity.OrderStatus(payload.To)
+	case entity.EventPaymentCreated, entity.EventPaymentUpdated:
+		var payload PaymentEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		payment, err := ModelToPayment(&payload.Payment, payload.Lines)
+		if err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		e.Payment = payment
+	case entity.EventShipmentCreated, entity.EventShipmentUpdated:
+		var payload ShipmentEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		e.Shipment = ModelToShipment(&payload.Shipment, payload.Items)
+	case entity.EventReturnCreated, entity.EventReturnUpdated:
+		var payload ReturnEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("event %s payload: %w", event.ID, err)
+		}
+		e.Return = ModelToReturn(&payload.Return, payload.Items)
+	}
+	return e, nil
+}
@@ -0,0 +1,162 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+// Invoice keeps the billing address inline; an empty Billing.Line1 means the
+// order had none.
+type Invoice struct {
+	ID            string
+	Number        string
+	Year          int
+	Sequence      int64
+	OrderID       string
+	UserID        string
+	UserName      string
+	Currency      string
+	Subtotal      string
+	DiscountTotal string
+	TaxTotal      string
+	Total         string
+	Billing       OrderAddress
+	IssuedAt      time.Time
+}
+
+type InvoiceLine struct {
+	InvoiceID    string
+	Position     int
+	ProductID    string
+	ProductName  string
+	Quantity     int64
+	UnitPrice    string
+	Amount       string
+	TaxRate      int64
+	TaxAmount    string
+	TaxInclusive bool
+}
+
+type InvoiceDiscount struct {
+	InvoiceID string
+	Position  int
+	Code      string
+	Amount    string
+}
+
+func InvoiceToModel(inv *entity.Invoice) (*Invoice, []InvoiceLine, []InvoiceDiscount) {
+	m := &Invoice{
+		ID:            inv.ID.String(),
+		Number:        inv.Number,
+		Year:          inv.Year,
+		Sequence:      inv.Sequence,
+		OrderID:       inv.OrderID.String(),
+		UserID:        inv.UserID.String(),
+		UserName:      inv.UserName,
+		Currency:      inv.Total.Currency,
+		Subtotal:      inv.Subtotal.Decimal(),
+		DiscountTotal: inv.DiscountTotal.Decimal(),
+		TaxTotal:      inv.TaxTotal.Decimal(),
+		Total:         inv.Total.Decimal(),
+		IssuedAt:      inv.IssuedAt,
+	}
+	if inv.BillingAddress != nil {
+		m.Billing = addressToModel(inv.OrderID, AddressKindBilling, inv.BillingAddress)
+	}
+
+	lines := make([]InvoiceLine, 0, len(inv.Lines))
+	for i, line := range inv.Lines {
+		lines = append(lines, InvoiceLine{
+			InvoiceID:    m.ID,
+			Position:     i + 1,
+			ProductID:    line.ProductID.String(),
+			ProductName:  line.ProductName,
+			Quantity:     line.Quantity,
+			UnitPrice:    line.UnitPrice.Decimal(),
+			Amount:       line.Amount.Decimal(),
+			TaxRate:      line.TaxRate,
+			TaxAmount:    line.TaxAmount.Decimal(),
+			TaxInclusive: line.TaxInclusive,
+		})
+	}
+	discounts := make([]InvoiceDiscount, 0, len(inv.Discounts))
+	for i, d := range inv.Discounts {
+		discounts = append(discounts, InvoiceDiscount{
+			InvoiceID: m.ID,
+			Position:  i + 1,
+			Code:      d.Code,
+			Amount:    d.Amount.Decimal(),
+		})
+	}
+	return m, lines, discounts
+}
+
+func ModelToInvoice(m *Invoice, lines []InvoiceLine, discounts []InvoiceDiscount) (*entity.Invoice, error) {
+	parse := func(field, s string) (money.Money, error) {
+		amount, err := money.Parse(s, m.Currency)
+		if err != nil {
+			return money.Money{}, fmt.Errorf("invoice %s %s: %w", m.Number, field, err)
+		}
+		return amount, nil
+	}
+
+	inv := &entity.Invoice{
+		ID:       entity.UUID(m.ID),
+		Number:   m.Number,
+		Year:     m.Year,
+		Sequence: m.Sequence,
+		OrderID:  entity.UUID(m.OrderID),
+		UserID:   entity.UUID(m.UserID),
+		UserName: m.UserName,
+		IssuedAt: m.IssuedAt,
+		Lines:    make([]entity.InvoiceLine, 0, len(lines)),
+	}
+	if m.Billing.Line1 != "" {
+		inv.BillingAddress = ModelToAddress(m.Billing)
+	}
+
+	var err error
+	if inv.Subtotal, err = parse("subtotal", m.Subtotal); err != nil {
+		return nil, err
+	}
+	if inv.DiscountTotal, err = parse("discount total", m.DiscountTotal); err != nil {
+		return nil, err
+	}
+	if inv.TaxTotal, err = parse("tax total", m.TaxTotal); err != nil {
+		return nil, err
+	}
+	if inv.Total, err = parse("total", m.Total); err != nil {
+		return nil, err
+	}
+
+	for _, l := range lines {
+		line := entity.InvoiceLine{
+			ProductID:    entity.UUID(l.ProductID),
+			ProductName:  l.ProductName,
+			Quantity:     l.Quantity,
+			TaxRate:      l.TaxRate,
+			TaxInclusive: l.TaxInclusive,
+		}
+		if line.UnitPrice, err = parse("unit price", l.UnitPrice); err != nil {
+			return nil, err
+		}
+		if line.Amount, err = parse("line amount", l.Amount); err != nil {
+			return nil, err
+		}
+		if line.TaxAmount, err = parse("line tax", l.TaxAmount); err != nil {
+			return nil, err
+		}
+		inv.Lines = append(inv.Lines, line)
+	}
+	for _, d := range discounts {
+		amount, err := parse("discount", d.Amount)
+		if err != nil {
+			return nil, err
+		}
+		inv.Discounts = append(inv.Discounts, entity.InvoiceDiscount{Code: d.Code, Amount: amount})
+	}
+	return inv, nil
+}
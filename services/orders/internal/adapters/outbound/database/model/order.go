@@ -1 +1,127 @@
 package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+type Order struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"user_id"`
+	UserName    string    `json:"user_name"`
+	TotalAmount string    `json:"total_amount"`
+	Currency    string    `json:"currency"`
+	Status      string    `json:"status"`
+	TaxRegion   string    `json:"tax_region"`
+	EditVersion int       `json:"edit_version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type OrderItem struct {
+	ID           string    `json:"id"`
+	OrderID      string    `json:"order_id"`
+	ProductID    string    `json:"product_id"`
+	ProductName  string    `json:"product_name"`
+	ProductPrice string    `json:"product_price"`
+	Quantity     int64     `json:"quantity"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func OrderToModel(order *entity.Order) (*Order, []OrderItem, error) {
+	o := &Order{
+		ID:          order.ID.String(),
+		UserID:      order.UserID.String(),
+		UserName:    order.UserName,
+		TotalAmount: order.TotalAmount.Decimal(),
+		Currency:    order.TotalAmount.Currency,
+		Status:      string(order.Status),
+		TaxRegion:   order.TaxRegion,
+		EditVersion: order.EditVersion,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+
+	items := make([]OrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, OrderItem{
+			OrderID:      order.ID.String(),
+			ProductID:    item.ProductID.String(),
+			ProductName:  item.ProductName,
+			ProductPrice: item.ProductPrice.Decimal(),
+			Quantity:     item.Quantity,
+			CreatedAt:    item.CreatedAt,
+			UpdatedAt:    item.UpdatedAt,
+		})
+	}
+
+	return o, items, nil
+}
+
+func ModelToOrder(o *Order, items []OrderItem, discounts []OrderDiscount, taxLines []OrderTaxLine, addresses []OrderAddress) (*entity.Order, error) {
+	total, err := money.Parse(o.TotalAmount, o.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("order %s total: %w", o.ID, err)
+	}
+
+	order := &entity.Order{
+		ID:          entity.UUID(o.ID),
+		UserID:      entity.UUID(o.UserID),
+		UserName:    o.UserName,
+		TotalAmount: total,
+		Status:      entity.OrderStatus(o.Status),
+		TaxRegion:   o.TaxRegion,
+		EditVersion: o.EditVersion,
+		Items:       make([]entity.OrderItem, 0, len(items)),
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+
+	for _, item := range items {
+		// Items are always priced in the order's currency.
+		price, err := money.Parse(item.ProductPrice, o.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("order %s item %s price: %w", o.ID, item.ProductID, err)
+		}
+		order.Items = append(order.Items, entity.OrderItem{
+			ProductID:    entity.UUID(item.ProductID),
+			ProductName:  item.ProductName,
+			ProductPrice: price,
+			Quantity:     item.Quantity,
+			CreatedAt:    item.CreatedAt,
+			UpdatedAt:    item.UpdatedAt,
+		})
+	}
+
+	for _, d := range discounts {
+		discount, err := ModelToOrderDiscount(d, o.Currency)
+		if err != nil {
+			return nil, err
+		}
+		order.Discounts = append(order.Discounts, discount)
+		order.CouponCode = discount.Code
+	}
+
+	for _, l := range taxLines {
+		line, err := ModelToTaxLine(l, o.Currency)
+		if err != nil {
+			return nil, err
+		}
+		order.TaxLines = append(order.TaxLines, line)
+	}
+
+	for _, a := range addresses {
+		switch a.Kind {
+		case AddressKindShipping:
+			order.ShippingAddress = ModelToAddress(a)
+		case AddressKindBilling:
+			order.BillingAddress = ModelToAddress(a)
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type OrderTask struct {
+	ID        string    `json:"id"`
+	OrderID   string    `json:"order_id"`
+	Kind      string    `json:"kind"`
+	Ref       string    `json:"ref"`
+	Status    string    `json:"status"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error"`
+	RunAt     time.Time `json:"run_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type OrderTaskItem struct {
+	TaskID    string `json:"task_id"`
+	ProductID string `json:"product_id"`
+	Quantity  int64  `json:"quantity"`
+}
+
+func OrderTaskToModel(task *entity.OrderTask) (*OrderTask, []OrderTaskItem) {
+	m := &OrderTask{
+		ID:        task.ID,
+		OrderID:   task.OrderID.String(),
+		Kind:      string(task.Kind),
+		Ref:       task.Ref,
+		Status:    string(task.Status),
+		Attempts:  task.Attempts,
+		LastError: task.LastError,
+		RunAt:     task.RunAt,
+		CreatedAt: task.CreatedAt,
+		UpdatedAt: task.UpdatedAt,
+	}
+
+	items := make([]OrderTaskItem, 0, len(task.Items))
+	for _, item := range task.Items {
+		items = append(items, OrderTaskItem{
+			TaskID:    task.ID,
+			ProductID: item.ProductID.String(),
+			Quantity:  item.Quantity,
+		})
+	}
+	return m, items
+}
+
+func ModelToOrderTask(m *OrderTask, items []OrderTaskItem) *entity.OrderTask {
+	task := &entity.OrderTask{
+		ID:        m.ID,
+		OrderID:   entity.UUID(m.OrderID),
+		Kind:      entity.OrderTaskKind(m.Kind),
+		Ref:       m.Ref,
+		Status:    entity.OrderTaskStatus(m.Status),
+		Attempts:  m.Attempts,
+		LastError: m.LastError,
+		RunAt:     m.RunAt,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	for _, item := range items {
+		task.Items = append(task.Items, entity.OrderItem{
+			ProductID: entity.UUID(item.ProductID),
+			Quantity:  item.Quantity,
+		})
+	}
+	return task
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+type Payment struct {
+	ID                string    `json:"id"`
+	OrderID           string    `json:"order_id"`
+	Kind              string    `json:"kind"`
+	ParentPaymentID   string    `json:"parent_payment_id"`
+	Amount            string    `json:"amount"`
+	Currency          string    `json:"currency"`
+	PaymentMethod     string    `json:"payment_method"`
+	Status            string    `json:"status"`
+	ProviderReference string    `json:"provider_reference"`
+	FailureReason     string    `json:"failure_reason"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type RefundLine struct {
+	PaymentID string `json:"payment_id"`
+	ProductID string `json:"product_id"`
+	Quantity  int64  `json:"quantity"`
+	Amount    string `json:"amount"`
+}
+
+func PaymentToModel(payment *entity.Payment) (*Payment, []RefundLine) {
+	lines := make([]RefundLine, 0, len(payment.Lines))
+	for _, line := range payment.Lines {
+		lines = append(lines, RefundLine{
+			PaymentID: payment.ID.String(),
+			ProductID: line.ProductID.String(),
+			Quantity:  line.Quantity,
+			Amount:    line.Amount.Decimal(),
+		})
+	}
+
+	return &Payment{
+		ID:                payment.ID.String(),
+		OrderID:           payment.OrderID.String(),
+		Kind:              string(payment.Kind),
+		ParentPaymentID:   payment.ParentID.String(),
+		Amount:            payment.Amount.Decimal(),
+		Currency:          payment.Amount.Currency,
+		PaymentMethod:     payment.Method,
+		Status:            string(payment.Status),
+		ProviderReference: payment.ProviderReference,
+		FailureReason:     payment.FailureReason,
+		CreatedAt:         payment.CreatedAt,
+		UpdatedAt:         payment.UpdatedAt,
+	}, lines
+}
+
+func ModelToPayment(m *Payment, lines []RefundLine) (*entity.Payment, error) {
+	amount, err := money.Parse(m.Amount, m.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("payment %s amount: %w", m.ID, err)
+	}
+
+	payment := &entity.Payment{
+		ID:                entity.UUID(m.ID),
+		OrderID:           entity.UUID(m.OrderID),
+		Kind:              entity.PaymentKind(m.Kind),
+		ParentID:          entity.UUID(m.ParentPaymentID),
+		Amount:            amount,
+		Method:            m.PaymentMethod,
+		Status:            entity.PaymentStatus(m.Status),
+		ProviderReference: m.ProviderReference,
+		FailureReason:     m.FailureReason,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+
+	for _, line := range lines {
+		lineAmount, err := money.Parse(line.Amount, m.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("payment %s line %s amount: %w", m.ID, line.ProductID, err)
+		}
+		payment.Lines = append(payment.Lines, entity.RefundLine{
+			ProductID: entity.UUID(line.ProductID),
+			Quantity:  line.Quantity,
+			Amount:    lineAmount,
+		})
+	}
+	return payment, nil
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type Return struct {
+	ID        string         `json:"id"`
+	OrderID   string         `json:"order_id"`
+	Status    string         `json:"status"`
+	RefundID  sql.NullString `json:"refund_id"`
+	Reason    string         `json:"reason"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type ReturnItem struct {
+	ReturnID  string         `json:"return_id"`
+	ProductID string         `json:"product_id"`
+	Quantity  int64          `json:"quantity"`
+	Reason    string         `json:"reason"`
+	Condition sql.NullString `json:"condition"`
+}
+
+func ReturnToModel(r *entity.Return) (*Return, []ReturnItem) {
+	items := make([]ReturnItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, ReturnItem{
+			ReturnID:  r.ID.String(),
+			ProductID: item.ProductID.String(),
+			Quantity:  item.Quantity,
+			Reason:    item.Reason,
+			Condition: sql.NullString{String: string(item.Condition), Valid: item.Condition != ""},
+		})
+	}
+
+	return &Return{
+		ID:        r.ID.String(),
+		OrderID:   r.OrderID.String(),
+		Status:    string(r.Status),
+		RefundID:  sql.NullString{String: r.RefundID.String(), Valid: r.RefundID != ""},
+		Reason:    r.Reason,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}, items
+}
+
+func ModelToReturn(m *Return, items []ReturnItem) *entity.Return {
+	r := &entity.Return{
+		ID:        entity.UUID(m.ID),
+		OrderID:   entity.UUID(m.OrderID),
+		Status:    entity.ReturnStatus(m.Status),
+		Items:     make([]entity.ReturnItem, 0, len(items)),
+		RefundID:  entity.UUID(m.RefundID.String),
+		Reason:    m.Reason,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	for _, item := range items {
+		r.Items = append(r.Items, entity.ReturnItem{
+			ProductID: entity.UUID(item.ProductID),
+			Quantity:  item.Quantity,
+			Reason:    item.Reason,
+			Condition: entity.ItemCondition(item.Condition.String),
+		})
+	}
+	return r
+}
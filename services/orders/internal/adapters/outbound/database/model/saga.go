@@ -0,0 +1,72 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type OrderSaga struct {
+	OrderID   string    `json:"order_id"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type OrderSagaStep struct {
+	OrderID   string    `json:"order_id"`
+	ProductID string    `json:"product_id"`
+	Quantity  int64     `json:"quantity"`
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func SagaToModel(saga *entity.OrderSaga) (*OrderSaga, []OrderSagaStep) {
+	s := &OrderSaga{
+		OrderID:   saga.OrderID.String(),
+		Status:    string(saga.Status),
+		Error:     saga.Error,
+		CreatedAt: saga.CreatedAt,
+		UpdatedAt: saga.UpdatedAt,
+	}
+
+	steps := make([]OrderSagaStep, 0, len(saga.Steps))
+	for _, step := range saga.Steps {
+		steps = append(steps, SagaStepToModel(saga.OrderID, step))
+	}
+
+	return s, steps
+}
+
+func SagaStepToModel(orderID entity.UUID, step entity.SagaStep) OrderSagaStep {
+	return OrderSagaStep{
+		OrderID:   orderID.String(),
+		ProductID: step.ProductID.String(),
+		Quantity:  step.Quantity,
+		Status:    string(step.Status),
+		UpdatedAt: step.UpdatedAt,
+	}
+}
+
+func ModelToSaga(s *OrderSaga, steps []OrderSagaStep) *entity.OrderSaga {
+	saga := &entity.OrderSaga{
+		OrderID:   entity.UUID(s.OrderID),
+		Status:    entity.SagaStatus(s.Status),
+		Error:     s.Error,
+		Steps:     make([]entity.SagaStep, 0, len(steps)),
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+
+	for _, step := range steps {
+		saga.Steps = append(saga.Steps, entity.SagaStep{
+			ProductID: entity.UUID(step.ProductID),
+			Quantity:  step.Quantity,
+			Status:    entity.SagaStepStatus(step.Status),
+			UpdatedAt: step.UpdatedAt,
+		})
+	}
+
+	return saga
+}
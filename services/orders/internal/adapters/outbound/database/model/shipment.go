@@ -0,0 +1,128 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+const (
+	AddressKindShipping = "shipping"
+	AddressKindBilling  = "billing"
+)
+
+type OrderAddress struct {
+	OrderID    string `json:"order_id"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
+}
+
+type Shipment struct {
+	ID             string       `json:"id"`
+	OrderID        string       `json:"order_id"`
+	Carrier        string       `json:"carrier"`
+	TrackingNumber string       `json:"tracking_number"`
+	Status         string       `json:"status"`
+	ShippedAt      time.Time    `json:"shipped_at"`
+	DeliveredAt    sql.NullTime `json:"delivered_at"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+type ShipmentItem struct {
+	ShipmentID string `json:"shipment_id"`
+	ProductID  string `json:"product_id"`
+	Quantity   int64  `json:"quantity"`
+}
+
+func AddressesToModel(order *entity.Order) []OrderAddress {
+	var addresses []OrderAddress
+	if order.ShippingAddress != nil {
+		addresses = append(addresses, addressToModel(order.ID, AddressKindShipping, order.ShippingAddress))
+	}
+	if order.BillingAddress != nil {
+		addresses = append(addresses, addressToModel(order.ID, AddressKindBilling, order.BillingAddress))
+	}
+	return addresses
+}
+
+func addressToModel(orderID entity.UUID, kind string, a *entity.Address) OrderAddress {
+	return OrderAddress{
+		OrderID:    orderID.String(),
+		Kind:       kind,
+		Name:       a.Name,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Phone:      a.Phone,
+	}
+}
+
+func ModelToAddress(m OrderAddress) *entity.Address {
+	return &entity.Address{
+		Name:       m.Name,
+		Line1:      m.Line1,
+		Line2:      m.Line2,
+		City:       m.City,
+		Region:     m.Region,
+		PostalCode: m.PostalCode,
+		Country:    m.Country,
+		Phone:      m.Phone,
+	}
+}
+
+func ShipmentToModel(shipment *entity.Shipment) (*Shipment, []ShipmentItem) {
+	items := make([]ShipmentItem, 0, len(shipment.Items))
+	for _, item := range shipment.Items {
+		items = append(items, ShipmentItem{
+			ShipmentID: shipment.ID.String(),
+			ProductID:  item.ProductID.String(),
+			Quantity:   item.Quantity,
+		})
+	}
+
+	return &Shipment{
+		ID:             shipment.ID.String(),
+		OrderID:        shipment.OrderID.String(),
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         string(shipment.Status),
+		ShippedAt:      shipment.ShippedAt,
+		DeliveredAt:    sql.NullTime{Time: shipment.DeliveredAt, Valid: !shipment.DeliveredAt.IsZero()},
+		CreatedAt:      shipment.CreatedAt,
+		UpdatedAt:      shipment.UpdatedAt,
+	}, items
+}
+
+func ModelToShipment(m *Shipment, items []ShipmentItem) *entity.Shipment {
+	shipment := &entity.Shipment{
+		ID:             entity.UUID(m.ID),
+		OrderID:        entity.UUID(m.OrderID),
+		Carrier:        m.Carrier,
+		TrackingNumber: m.TrackingNumber,
+		Status:         entity.ShipmentStatus(m.Status),
+		Items:          make([]entity.ShipmentItem, 0, len(items)),
+		ShippedAt:      m.ShippedAt,
+		DeliveredAt:    m.DeliveredAt.Time,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+	for _, item := range items {
+		shipment.Items = append(shipment.Items, entity.ShipmentItem{
+			ProductID: entity.UUID(item.ProductID),
+			Quantity:  item.Quantity,
+		})
+	}
+	return shipment
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type StatusHistoryEntry struct {
+	ID           int64
+	OrderID      string
+	FromStatus   sql.NullString
+	ToStatus     string
+	Actor        string
+	Reason       string
+	ChangedAt    time.Time
+	ReturnID     sql.NullString
+	ReturnStatus sql.NullString
+}
+
+func StatusTransitionToModel(t entity.StatusTransition) StatusHistoryEntry {
+	return StatusHistoryEntry{
+		OrderID:      t.OrderID.String(),
+		FromStatus:   sql.NullString{String: string(t.From), Valid: t.From != ""},
+		ToStatus:     string(t.To),
+		Actor:        t.Actor,
+		Reason:       t.Reason,
+		ChangedAt:    t.At,
+		ReturnID:     sql.NullString{String: t.ReturnID.String(), Valid: t.ReturnID != ""},
+		ReturnStatus: sql.NullString{String: string(t.ReturnStatus), Valid: t.ReturnStatus != ""},
+	}
+}
+
+func ModelToStatusTransition(m StatusHistoryEntry) entity.StatusTransition {
+	return entity.StatusTransition{
+		OrderID:      entity.UUID(m.OrderID),
+		From:         entity.OrderStatus(m.FromStatus.String),
+		To:           entity.OrderStatus(m.ToStatus),
+		At:           m.ChangedAt,
+		Actor:        m.Actor,
+		Reason:       m.Reason,
+		ReturnID:     entity.UUID(m.ReturnID.String),
+		ReturnStatus: entity.ReturnStatus(m.ReturnStatus.String),
+	}
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type Subscription struct {
+	ID              string
+	UserID          string
+	UserName        string
+	Cron            string
+	IntervalSeconds int64
+	StockPolicy     string
+	Status          string
+	StartAt         time.Time
+	EndAt           sql.NullTime
+	NextRunAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type SubscriptionItem struct {
+	SubscriptionID string
+	ProductID      string
+	Quantity       int64
+}
+
+type SubscriptionRun struct {
+	ID             string
+	SubscriptionID string
+	ScheduledAt    time.Time
+	OrderID        sql.NullString
+	Status         string
+	Reason         string
+	CreatedAt      time.Time
+}
+
+func SubscriptionToModel(s *entity.Subscription) (*Subscription, []SubscriptionItem) {
+	items := make([]SubscriptionItem, 0, len(s.Items))
+	for _, item := range s.Items {
+		items = append(items, SubscriptionItem{
+			SubscriptionID: s.ID.String(),
+			ProductID:      item.ProductID.String(),
+			Quantity:       item.Quantity,
+		})
+	}
+
+	return &Subscription{
+		ID:              s.ID.String(),
+		UserID:          s.UserID.String(),
+		UserName:        s.UserName,
+		Cron:            s.Cadence.Cron,
+		IntervalSeconds: int64(s.Cadence.Interval / time.Second),
+		StockPolicy:     string(s.StockPolicy),
+		Status:          string(s.Status),
+		StartAt:         s.StartAt,
+		EndAt:           sql.NullTime{Time: s.EndAt, Valid: !s.EndAt.IsZero()},
+		NextRunAt:       s.NextRunAt,
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
+	}, items
+}
+
+func ModelToSubscription(m *Subscription, items []SubscriptionItem) *entity.Subscription {
+	s := &entity.Subscription{
+		ID:       entity.UUID(m.ID),
+		UserID:   entity.UUID(m.UserID),
+		UserName: m.UserName,
+		Items:    make([]entity.SubscriptionItem, 0, len(items)),
+		Cadence: entity.Cadence{
+			Cron:     m.Cron,
+			Interval: time.Duration(m.IntervalSeconds) * time.Second,
+		},
+		StockPolicy: entity.StockPolicy(m.StockPolicy),
+		Status:      entity.SubscriptionStatus(m.Status),
+		StartAt:     m.StartAt.UTC(),
+		NextRunAt:   m.NextRunAt.UTC(),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.EndAt.Valid {
+		s.EndAt = m.EndAt.Time.UTC()
+	}
+	for _, item := range items {
+		s.Items = append(s.Items, entity.SubscriptionItem{
+			ProductID: entity.UUID(item.ProductID),
+			Quantity:  item.Quantity,
+		})
+	}
+	return s
+}
+
+func SubscriptionRunToModel(run *entity.SubscriptionRun) *SubscriptionRun {
+	return &SubscriptionRun{
+		ID:             run.ID.String(),
+		SubscriptionID: run.SubscriptionID.String(),
+		ScheduledAt:    run.ScheduledAt,
+		OrderID:        sql.NullString{String: run.OrderID.String(), Valid: run.OrderID != ""},
+		Status:         string(run.Status),
+		Reason:         run.Reason,
+		CreatedAt:      run.CreatedAt,
+	}
+}
+
+func ModelToSubscriptionRun(m *SubscriptionRun) *entity.SubscriptionRun {
+	return &entity.SubscriptionRun{
+		ID:             entity.UUID(m.ID),
+		SubscriptionID: entity.UUID(m.SubscriptionID),
+		ScheduledAt:    m.ScheduledAt.UTC(),
+		OrderID:        entity.UUID(m.OrderID.String),
+		Status:         entity.SubscriptionRunStatus(m.Status),
+		Reason:         m.Reason,
+		CreatedAt:      m.CreatedAt,
+	}
+}
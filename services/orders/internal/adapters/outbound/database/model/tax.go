@@ -0,0 +1,53 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+type OrderTaxLine struct {
+	OrderID   string `json:"order_id"`
+	ProductID string `json:"product_id"`
+	TaxClass  string `json:"tax_class"`
+	Region    string `json:"region"`
+	Rate      int64  `json:"rate"`
+	Taxable   string `json:"taxable"`
+	Amount    string `json:"amount"`
+	Inclusive bool   `json:"inclusive"`
+}
+
+func TaxLineToModel(orderID entity.UUID, line entity.TaxLine) OrderTaxLine {
+	return OrderTaxLine{
+		OrderID:   orderID.String(),
+		ProductID: line.ProductID.String(),
+		TaxClass:  line.TaxClass,
+		Region:    line.Region,
+		Rate:      line.Rate,
+		Taxable:   line.Taxable.Decimal(),
+		Amount:    line.Amount.Decimal(),
+		Inclusive: line.Inclusive,
+	}
+}
+
+// ModelToTaxLine parses a tax line of an order priced in currency.
+func ModelToTaxLine(m OrderTaxLine, currency string) (entity.TaxLine, error) {
+	taxable, err := money.Parse(m.Taxable, currency)
+	if err != nil {
+		return entity.TaxLine{}, fmt.Errorf("order %s tax line %s taxable: %w", m.OrderID, m.ProductID, err)
+	}
+	amount, err := money.Parse(m.Amount, currency)
+	if err != nil {
+		return entity.TaxLine{}, fmt.Errorf("order %s tax line %s amount: %w", m.OrderID, m.ProductID, err)
+	}
+	return entity.TaxLine{
+		ProductID: entity.UUID(m.ProductID),
+		TaxClass:  m.TaxClass,
+		Region:    m.Region,
+		Rate:      m.Rate,
+		Taxable:   taxable,
+		Amount:    amount,
+		Inclusive: m.Inclusive,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+type postgresCartRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresCartRepository(db *sql.DB) ports.CartRepository {
+	return &postgresCartRepository{db: db}
+}
+
+func (r *postgresCartRepository) GetCart(ctx context.Context, userID entity.UUID) (*entity.Cart, error) {
+	const op = "postgresCartRepository.GetCart"
+
+	cart, err := fetchCart(ctx, r.db, userID, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrCartNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cart, nil
+}
+
+func (r *postgresCartRepository) UpdateCart(ctx context.Context, userID entity.UUID, updateFn func(*entity.Cart) (bool, error)) error {
+	const op = "postgresCartRepository.UpdateCart"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		cart, err := fetchCart(ctx, tx, userID, true)
+		if errors.Is(err, entity.ErrCartNotFound) {
+			cart = &entity.Cart{UserID: userID}
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		updated, err := updateFn(cart)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		m, items := model.CartToModel(cart)
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO carts (user_id, created_at, updated_at, expires_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_id) DO UPDATE SET
+				created_at = EXCLUDED.created_at,
+				updated_at = EXCLUDED.updated_at,
+				expires_at = EXCLUDED.expires_at`,
+			m.UserID, m.CreatedAt, m.UpdatedAt, m.ExpiresAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Items are owned by the cart, so they are rewritten as a whole.
+		_, err = tx.ExecContext(ctx, `DELETE FROM cart_items WHERE user_id = $1`, userID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO cart_items (user_id, product_id, quantity, added_at) VALUES ($1, $2, $3, $4)`,
+				item.UserID, item.ProductID, item.Quantity, item.AddedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *postgresCartRepository) DeleteExpiredCarts(ctx context.Context, now time.Time) (int64, error) {
+	const op = "postgresCartRepository.DeleteExpiredCarts"
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM carts WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return deleted, nil
+}
+
+func fetchCart(ctx context.Context, q queryer, userID entity.UUID, forUpdate bool) (*entity.Cart, error) {
+	query := `SELECT user_id, created_at, updated_at, expires_at FROM carts WHERE user_id = $1`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	var m model.Cart
+	err := q.QueryRowContext(ctx, query, userID).Scan(&m.UserID, &m.CreatedAt, &m.UpdatedAt, &m.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrCartNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT user_id, product_id, quantity, added_at
+		FROM cart_items
+		WHERE user_id = $1
+		ORDER BY added_at, product_id`, userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []model.CartItem
+	for rows.Next() {
+		var item model.CartItem
+		if err := rows.Scan(&item.UserID, &item.ProductID, &item.Quantity, &item.AddedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return model.ModelToCart(&m, items), nil
+}
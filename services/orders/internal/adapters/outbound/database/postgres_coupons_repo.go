@@ -0,0 +1,314 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresCouponRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresCouponRepository(db *sql.DB) ports.CouponRepository {
+	return &postgresCouponRepository{db: db}
+}
+
+const couponColumns = `id, code, kind, percent_off, amount_off, min_order_value, currency, valid_from, valid_until,
+	max_redemptions, max_redemptions_per_user, redemptions, active, created_at, updated_at`
+
+func (r *postgresCouponRepository) SaveCoupon(ctx context.Context, coupon entity.Coupon) error {
+	const op = "postgresCouponRepository.SaveCoupon"
+
+	m := model.CouponToModel(&coupon)
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO coupons (`+couponColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (code) DO NOTHING`,
+		m.ID, m.Code, m.Kind, m.PercentOff, m.AmountOff, m.MinOrderValue, m.Currency, m.ValidFrom, m.ValidUntil,
+		m.MaxRedemptions, m.MaxRedemptionsPerUser, m.Redemptions, m.Active, m.CreatedAt, m.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if inserted == 0 {
+		return fmt.Errorf("%w: %s", entity.ErrCouponAlreadyExists, coupon.Code)
+	}
+	return nil
+}
+
+func (r *postgresCouponRepository) GetCouponByID(ctx context.Context, id entity.UUID) (*entity.Coupon, error) {
+	const op = "postgresCouponRepository.GetCouponByID"
+
+	coupon, err := fetchCoupon(ctx, r.db, `id = $1`, id, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrCouponNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return coupon, nil
+}
+
+func (r *postgresCouponRepository) GetCouponByCode(ctx context.Context, code string) (*entity.Coupon, error) {
+	const op = "postgresCouponRepository.GetCouponByCode"
+
+	coupon, err := fetchCoupon(ctx, r.db, `code = $1`, code, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrCouponNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return coupon, nil
+}
+
+func (r *postgresCouponRepository) UpdateCouponByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Coupon) (bool, error)) error {
+	const op = "postgresCouponRepository.UpdateCouponByID"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		coupon, err := fetchCoupon(ctx, tx, `id = $1`, id, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrCouponNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		updated, err := updateFn(coupon)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		// redemptions is only ever bumped by SaveOrder, so it is left alone.
+		m := model.CouponToModel(coupon)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE coupons SET percent_off = $1, amount_off = $2, min_order_value = $3, currency = $4,
+				valid_from = $5, valid_until = $6, max_redemptions = $7, max_redemptions_per_user = $8,
+				active = $9, updated_at = $10
+			WHERE id = $11`,
+			m.PercentOff, m.AmountOff, m.MinOrderValue, m.Currency,
+			m.ValidFrom, m.ValidUntil, m.MaxRedemptions, m.MaxRedemptionsPerUser,
+			m.Active, m.UpdatedAt, id,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresCouponRepository) GetTotalCouponsCount(ctx context.Context) (int64, error) {
+	const op = "postgresCouponRepository.GetTotalCouponsCount"
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM coupons`).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return total, nil
+}
+
+func (r *postgresCouponRepository) GetAllCoupons(ctx context.Context, pagination *entity.Pagination) ([]*entity.Coupon, error) {
+	const op = "postgresCouponRepository.GetAllCoupons"
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+couponColumns+` FROM coupons
+		ORDER BY created_at DESC, id
+		LIMIT `+strconv.FormatInt(pagination.PageSize, 10)+` OFFSET `+strconv.FormatInt(offset, 10),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var coupons []*entity.Coupon
+	for rows.Next() {
+		m, err := scanCoupon(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		coupon, err := model.ModelToCoupon(m)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		coupons = append(coupons, coupon)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return coupons, nil
+}
+
+func (r *postgresCouponRepository) CountUserRedemptions(ctx context.Context, couponID, userID entity.UUID) (int64, error) {
+	const op = "postgresCouponRepository.CountUserRedemptions"
+
+	count, err := countUserRedemptions(ctx, r.db, couponID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return count, nil
+}
+
+func countUserRedemptions(ctx context.Context, q queryer, couponID, userID entity.UUID) (int64, error) {
+	var count int64
+	err := q.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM order_discounts d
+		JOIN orders o ON o.id = d.order_id
+		WHERE d.coupon_id = $1 AND o.user_id = $2`,
+		couponID, userID,
+	).Scan(&count)
+	return count, err
+}
+
+func scanCoupon(row rowScanner) (*model.Coupon, error) {
+	var m model.Coupon
+	err := row.Scan(
+		&m.ID,
+		&m.Code,
+		&m.Kind,
+		&m.PercentOff,
+		&m.AmountOff,
+		&m.MinOrderValue,
+		&m.Currency,
+		&m.ValidFrom,
+		&m.ValidUntil,
+		&m.MaxRedemptions,
+		&m.MaxRedemptionsPerUser,
+		&m.Redemptions,
+		&m.Active,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func fetchCoupon(ctx context.Context, q queryer, where string, arg any, forUpdate bool) (*entity.Coupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupons WHERE ` + where
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	m, err := scanCoupon(q.QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrCouponNotFound
+		}
+		return nil, err
+	}
+	return model.ModelToCoupon(m)
+}
+
+// redeemDiscounts records the discounts of a new order. Each coupon's counter
+// is bumped under its row lock before the limits are checked, so concurrent
+// orders cannot both take the last redemption.
+func redeemDiscounts(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	for _, discount := range order.Discounts {
+		var maxPerUser int64
+		err := tx.QueryRowContext(ctx,
+			`UPDATE coupons SET redemptions = redemptions + 1
+			WHERE id = $1 AND (max_redemptions = 0 OR redemptions < max_redemptions)
+			RETURNING max_redemptions_per_user`,
+			discount.CouponID,
+		).Scan(&maxPerUser)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", entity.ErrCouponExhausted, discount.Code)
+		}
+		if err != nil {
+			return err
+		}
+
+		if maxPerUser > 0 {
+			used, err := countUserRedemptions(ctx, tx, discount.CouponID, order.UserID)
+			if err != nil {
+				return err
+			}
+			if used >= maxPerUser {
+				return fmt.Errorf("%w: %s was already used %d times by this user", entity.ErrCouponExhausted, discount.Code, used)
+			}
+		}
+
+		m := model.OrderDiscountToModel(order.ID, discount)
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO order_discounts (order_id, coupon_id, code, kind, percent_off, amount_off, min_order_value, amount, free_shipping, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			m.OrderID, m.CouponID, m.Code, m.Kind, m.PercentOff, m.AmountOff, m.MinOrderValue, m.Amount, m.FreeShipping, m.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateDiscountAmounts stores what the order's discounts take off now. It
+// does not redeem their coupons again.
+func updateDiscountAmounts(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	for _, discount := range order.Discounts {
+		m := model.OrderDiscountToModel(order.ID, discount)
+		_, err := tx.ExecContext(ctx,
+			`UPDATE order_discounts SET amount = $1 WHERE order_id = $2 AND coupon_id = $3`,
+			m.Amount, m.OrderID, m.CouponID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fetchDiscountsForOrders(ctx context.Context, q queryer, orderIDs []string) (map[string][]model.OrderDiscount, error) {
+	discountsByOrder := make(map[string][]model.OrderDiscount, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return discountsByOrder, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT order_id, coupon_id, code, kind, percent_off, amount_off, min_order_value, amount, free_shipping, created_at
+		FROM order_discounts
+		WHERE order_id = ANY($1)
+		ORDER BY created_at, code`, pq.Array(orderIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d model.OrderDiscount
+		err := rows.Scan(
+			&d.OrderID,
+			&d.CouponID,
+			&d.Code,
+			&d.Kind,
+			&d.PercentOff,
+			&d.AmountOff,
+			&d.MinOrderValue,
+			&d.Amount,
+			&d.FreeShipping,
+			&d.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		discountsByOrder[d.OrderID] = append(discountsByOrder[d.OrderID], d)
+	}
+	return discountsByOrder, rows.Err()
+}
@@ -0,0 +1,229 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+type postgresInvoiceRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresInvoiceRepository(db *sql.DB) ports.InvoiceRepository {
+	return &postgresInvoiceRepository{db: db}
+}
+
+const invoiceColumns = `id, number, year, sequence, order_id, user_id, user_name, currency,
+	subtotal, discount_total, tax_total, total,
+	billing_name, billing_line1, billing_line2, billing_city, billing_region, billing_postal_code, billing_country, billing_phone,
+	issued_at`
+
+// IssueInvoice runs at read committed rather than repeatable read: invoices
+// issued at once queue on their year's sequence row, and each must see the
+// number the one before it committed instead of failing to serialize.
+func (r *postgresInvoiceRepository) IssueInvoice(ctx context.Context, orderID entity.UUID, issueFn func(*entity.Order) (*entity.Invoice, error)) (*entity.Invoice, error) {
+	const op = "postgresInvoiceRepository.IssueInvoice"
+
+	var invoice *entity.Invoice
+	err := runInTxWithIsolation(ctx, r.db, sql.LevelReadCommitted, func(tx *sql.Tx) error {
+		order, err := loadOrder(ctx, tx, orderID, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrOrderNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		invoice, err = fetchInvoice(ctx, tx, `order_id = $1`, orderID)
+		if err == nil {
+			// Already issued.
+			return nil
+		}
+		if !errors.Is(err, entity.ErrInvoiceNotFound) {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if invoice, err = issueFn(order); err != nil {
+			return err
+		}
+
+		var sequence int64
+		err = tx.QueryRowContext(ctx,
+			`INSERT INTO invoice_sequences (year, last_number) VALUES ($1, 1)
+			ON CONFLICT (year) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+			RETURNING last_number`, invoice.Year,
+		).Scan(&sequence)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		invoice.AssignNumber(sequence)
+
+		if err := insertInvoice(ctx, tx, invoice); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (r *postgresInvoiceRepository) GetInvoiceByID(ctx context.Context, id entity.UUID) (*entity.Invoice, error) {
+	const op = "postgresInvoiceRepository.GetInvoiceByID"
+
+	invoice, err := fetchInvoice(ctx, r.db, `id = $1`, id)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvoiceNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return invoice, nil
+}
+
+func (r *postgresInvoiceRepository) GetInvoiceByOrderID(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error) {
+	const op = "postgresInvoiceRepository.GetInvoiceByOrderID"
+
+	invoice, err := fetchInvoice(ctx, r.db, `order_id = $1`, orderID)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvoiceNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return invoice, nil
+}
+
+func insertInvoice(ctx context.Context, tx *sql.Tx, invoice *entity.Invoice) error {
+	m, lines, discounts := model.InvoiceToModel(invoice)
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO invoices (`+invoiceColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)`,
+		m.ID, m.Number, m.Year, m.Sequence, m.OrderID, m.UserID, m.UserName, m.Currency,
+		m.Subtotal, m.DiscountTotal, m.TaxTotal, m.Total,
+		m.Billing.Name, m.Billing.Line1, m.Billing.Line2, m.Billing.City, m.Billing.Region, m.Billing.PostalCode, m.Billing.Country, m.Billing.Phone,
+		m.IssuedAt,
+	)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO invoice_lines (invoice_id, position, product_id, product_name, quantity, unit_price, amount, tax_rate, tax_amount, tax_inclusive)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			line.InvoiceID, line.Position, line.ProductID, line.ProductName, line.Quantity,
+			line.UnitPrice, line.Amount, line.TaxRate, line.TaxAmount, line.TaxInclusive,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	for _, d := range discounts {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO invoice_discounts (invoice_id, position, code, amount) VALUES ($1, $2, $3, $4)`,
+			d.InvoiceID, d.Position, d.Code, d.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fetchInvoice(ctx context.Context, q queryer, where string, args ...any) (*entity.Invoice, error) {
+	var m model.Invoice
+	err := q.QueryRowContext(ctx, `SELECT `+invoiceColumns+` FROM invoices WHERE `+where, args...).Scan(
+		&m.ID,
+		&m.Number,
+		&m.Year,
+		&m.Sequence,
+		&m.OrderID,
+		&m.UserID,
+		&m.UserName,
+		&m.Currency,
+		&m.Subtotal,
+		&m.DiscountTotal,
+		&m.TaxTotal,
+		&m.Total,
+		&m.Billing.Name,
+		&m.Billing.Line1,
+		&m.Billing.Line2,
+		&m.Billing.City,
+		&m.Billing.Region,
+		&m.Billing.PostalCode,
+		&m.Billing.Country,
+		&m.Billing.Phone,
+		&m.IssuedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	lines, err := fetchInvoiceLines(ctx, q, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	discounts, err := fetchInvoiceDiscounts(ctx, q, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	return model.ModelToInvoice(&m, lines, discounts)
+}
+
+func fetchInvoiceLines(ctx context.Context, q queryer, invoiceID string) ([]model.InvoiceLine, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT invoice_id, position, product_id, product_name, quantity, unit_price, amount, tax_rate, tax_amount, tax_inclusive
+		FROM invoice_lines
+		WHERE invoice_id = $1
+		ORDER BY position`, invoiceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []model.InvoiceLine
+	for rows.Next() {
+		var l model.InvoiceLine
+		err := rows.Scan(&l.InvoiceID, &l.Position, &l.ProductID, &l.ProductName, &l.Quantity,
+			&l.UnitPrice, &l.Amount, &l.TaxRate, &l.TaxAmount, &l.TaxInclusive)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, l)
+	}
+	return lines, rows.Err()
+}
+
+func fetchInvoiceDiscounts(ctx context.Context, q queryer, invoiceID string) ([]model.InvoiceDiscount, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT invoice_id, position, code, amount
+		FROM invoice_discounts
+		WHERE invoice_id = $1
+		ORDER BY position`, invoiceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var discounts []model.InvoiceDiscount
+	for rows.Next() {
+		var d model.InvoiceDiscount
+		if err := rows.Scan(&d.InvoiceID, &d.Position, &d.Code, &d.Amount); err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, d)
+	}
+	return discounts, rows.Err()
+}
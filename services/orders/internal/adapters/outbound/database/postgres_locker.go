@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// postgresLocker takes session-level advisory locks. A session lock belongs
+// to the connection that took it, so each lock keeps a connection out of the
+// pool until it is released, and dies with the connection if the replica
+// holding it goes away.
+type postgresLocker struct {
+	db *sql.DB
+}
+
+func NewPostgresLocker(db *sql.DB) ports.Locker {
+	return &postgresLocker{db: db}
+}
+
+func (l *postgresLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	const op = "postgresLocker.TryLock"
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, key).Scan(&locked)
+	if err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+	if !locked {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	release := func() {
+		_, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, key)
+		if err != nil {
+			// The lock must not outlive us on a pooled connection, so the
+			// connection is discarded, which ends the session and its lock.
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}
+	return release, true, nil
+}
@@ -0,0 +1,199 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/lib/pq"
+)
+
+const orderTaskColumns = `id, order_id, kind, ref, status, attempts, last_error, run_at, created_at, updated_at`
+
+// GetOrderTasks lists the pending tasks matching filter, those due first.
+func (r *postgresOrdersRepository) GetOrderTasks(ctx context.Context, filter entity.OrderTaskFilter) ([]*entity.OrderTask, error) {
+	const op = "postgresOrdersRepository.GetOrderTasks"
+
+	conditions := []string{"status = $1"}
+	args := []any{entity.OrderTaskPending}
+	if filter.OrderID != "" {
+		args = append(args, filter.OrderID)
+		conditions = append(conditions, "order_id = $"+strconv.Itoa(len(args)))
+	}
+	if !filter.DueBy.IsZero() {
+		args = append(args, filter.DueBy)
+		conditions = append(conditions, "run_at <= $"+strconv.Itoa(len(args)))
+	}
+	query := `SELECT ` + orderTaskColumns + ` FROM order_tasks
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY run_at, id`
+	if filter.Limit > 0 {
+		query += " LIMIT " + strconv.Itoa(filter.Limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var tasks []model.OrderTask
+	for rows.Next() {
+		m, err := scanOrderTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, *m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(tasks))
+	for _, m := range tasks {
+		ids = append(ids, m.ID)
+	}
+	items, err := fetchOrderTaskItems(ctx, r.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	entities := make([]*entity.OrderTask, 0, len(tasks))
+	for _, m := range tasks {
+		entities = append(entities, model.ModelToOrderTask(&m, items[m.ID]))
+	}
+	return entities, nil
+}
+
+// RunOrderTask holds a row lock on the task while runFn runs, and SKIP
+// LOCKED lets the task runners of several replicas share the tasks without
+// running one twice. The task is stored as runFn left it even when its work
+// failed, so the failure is recorded.
+func (r *postgresOrdersRepository) RunOrderTask(ctx context.Context, id string, runFn func(*entity.Order, *entity.OrderTask)) error {
+	const op = "postgresOrdersRepository.RunOrderTask"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		m, err := scanOrderTask(tx.QueryRowContext(ctx,
+			`SELECT `+orderTaskColumns+` FROM order_tasks
+			WHERE id = $1 AND status = $2
+			FOR UPDATE SKIP LOCKED`,
+			id, entity.OrderTaskPending,
+		))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrNotUpdated
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		items, err := fetchOrderTaskItems(ctx, tx, []string{id})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		task := model.ModelToOrderTask(m, items[id])
+
+		order, err := loadOrder(ctx, tx, task.OrderID, false)
+		if err != nil {
+			if errors.Is(err, entity.ErrOrderNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		runFn(order, task)
+
+		m, _ = model.OrderTaskToModel(task)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE order_tasks SET status = $1, attempts = $2, last_error = $3, run_at = $4, updated_at = $5 WHERE id = $6`,
+			m.Status, m.Attempts, m.LastError, m.RunAt, m.UpdatedAt, m.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+// insertOrderTasks stores the tasks an order change left. A task that is
+// already stored is kept as it is.
+func insertOrderTasks(ctx context.Context, tx *sql.Tx, tasks []entity.OrderTask) error {
+	for _, task := range tasks {
+		m, items := model.OrderTaskToModel(&task)
+		res, err := tx.ExecContext(ctx,
+			`INSERT INTO order_tasks (`+orderTaskColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (id) DO NOTHING`,
+			m.ID, m.OrderID, m.Kind, m.Ref, m.Status,
+			m.Attempts, m.LastError, m.RunAt, m.CreatedAt, m.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if inserted == 0 {
+			continue
+		}
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO order_task_items (task_id, product_id, quantity) VALUES ($1, $2, $3)`,
+				item.TaskID, item.ProductID, item.Quantity,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func scanOrderTask(row rowScanner) (*model.OrderTask, error) {
+	var m model.OrderTask
+	err := row.Scan(
+		&m.ID,
+		&m.OrderID,
+		&m.Kind,
+		&m.Ref,
+		&m.Status,
+		&m.Attempts,
+		&m.LastError,
+		&m.RunAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func fetchOrderTaskItems(ctx context.Context, q queryer, taskIDs []string) (map[string][]model.OrderTaskItem, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT task_id, product_id, quantity FROM order_task_items
+		WHERE task_id = ANY($1)
+		ORDER BY task_id, product_id`, pq.Array(taskIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make(map[string][]model.OrderTaskItem, len(taskIDs))
+	for rows.Next() {
+		var item model.OrderTaskItem
+		if err := rows.Scan(&item.TaskID, &item.ProductID, &item.Quantity); err != nil {
+			return nil, err
+		}
+		items[item.TaskID] = append(items[item.TaskID], item)
+	}
+	return items, rows.Err()
+}
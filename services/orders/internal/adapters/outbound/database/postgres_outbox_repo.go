@@ -0,0 +1,188 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) ports.OutboxRepository {
+	return &postgresOutboxRepository{db: db}
+}
+
+func NewPostgresOrderEventsRepository(db *sql.DB) ports.OrderEventsRepository {
+	return &postgresOutboxRepository{db: db}
+}
+
+// PublishOutboxEvents holds row locks on the batch while publishFn runs, and
+// SKIP LOCKED lets several relays share the outbox without taking the same
+// events.
+func (r *postgresOutboxRepository) PublishOutboxEvents(ctx context.Context, limit int, publishFn func([]entity.Event) (int, error)) (int, error) {
+	const op = "postgresOutboxRepository.PublishOutboxEvents"
+
+	var published int
+	var publishErr error
+	err := runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT seq, id, aggregate_type, aggregate_id, event_type, payload, occurred_at
+			FROM outbox_events
+			WHERE published_at IS NULL
+			ORDER BY seq
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED`,
+			limit,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer rows.Close()
+
+		var seqs []int64
+		var events []entity.Event
+		for rows.Next() {
+			var seq int64
+			var event entity.Event
+			err := rows.Scan(&seq, &event.ID, &event.AggregateType, &event.AggregateID, &event.Type, &event.Payload, &event.OccurredAt)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			seqs = append(seqs, seq)
+			events = append(events, event)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		published, publishErr = publishFn(events)
+		published = min(max(published, 0), len(events))
+
+		if published > 0 {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE outbox_events SET published_at = NOW(), attempts = attempts + 1, last_error = ''
+				WHERE seq = ANY($1)`,
+				pq.Array(seqs[:published]),
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		if publishErr != nil && published < len(events) {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE outbox_events SET attempts = attempts + 1, last_error = $1 WHERE seq = $2`,
+				publishErr.Error(), seqs[published],
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, publishErr
+}
+
+func (r *postgresOutboxRepository) ListOrderEvents(ctx context.Context, orderID entity.UUID, afterSeq int64, limit int) ([]*entity.OrderEvent, error) {
+	const op = "postgresOutboxRepository.ListOrderEvents"
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT seq, id, aggregate_type, aggregate_id, event_type, payload, occurred_at
+		FROM outbox_events
+		WHERE aggregate_type = $1 AND aggregate_id = $2 AND seq > $3
+		ORDER BY seq
+		LIMIT $4`,
+		entity.AggregateOrder, orderID, afterSeq, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var events []*entity.OrderEvent
+	for rows.Next() {
+		var seq int64
+		var event entity.Event
+		err := rows.Scan(&seq, &event.ID, &event.AggregateType, &event.AggregateID, &event.Type, &event.Payload, &event.OccurredAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		e, err := model.EventToOrderEvent(seq, event)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return events, nil
+}
+
+// GetOrderSnapshot reads the order and its newest event in one repeatable
+// read transaction. Events of an order are inserted under its advisory lock,
+// so every event of the order that commits later has a greater seq.
+func (r *postgresOutboxRepository) GetOrderSnapshot(ctx context.Context, orderID entity.UUID) (*entity.Order, int64, error) {
+	const op = "postgresOutboxRepository.GetOrderSnapshot"
+
+	var order *entity.Order
+	var seq int64
+	err := runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		if order, err = loadOrder(ctx, tx, orderID, false); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx,
+			`SELECT COALESCE(MAX(seq), 0) FROM outbox_events
+			WHERE aggregate_type = $1 AND aggregate_id = $2`,
+			entity.AggregateOrder, orderID,
+		).Scan(&seq)
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, 0, err
+		}
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return order, seq, nil
+}
+
+// insertOutboxEvents records events in the caller's transaction. Sequence
+// numbers are handed out at insert time, so each aggregate is locked until the
+// transaction ends: that way the events of one aggregate become visible in
+// seq order and a reader that has seen seq n never misses a later commit
+// with a smaller one.
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, events ...entity.Event) error {
+	locked := make(map[string]bool, 1)
+	for _, event := range events {
+		aggregate := event.AggregateType + ":" + event.AggregateID
+		if !locked[aggregate] {
+			if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, aggregate); err != nil {
+				return err
+			}
+			locked[aggregate] = true
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO outbox_events (id, aggregate_type, aggregate_id, event_type, payload, occurred_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			event.ID, event.AggregateType, event.AggregateID, event.Type, []byte(event.Payload), event.OccurredAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresPaymentsRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresPaymentsRepository(db *sql.DB) ports.PaymentsRepository {
+	return &postgresPaymentsRepository{db: db}
+}
+
+const paymentColumns = `id, order_id, kind, COALESCE(parent_payment_id::text, ''), amount, currency,
+	payment_method, status, provider_reference, failure_reason, created_at, updated_at`
+
+// SavePayment refuses a charge whose amount is no longer the order's total.
+// The check writes the order row, so an edit of the order's items running at
+// the same time either sees the charge or fails to serialize with it.
+func (r *postgresPaymentsRepository) SavePayment(ctx context.Context, payment entity.Payment) error {
+	const op = "postgresPaymentsRepository.SavePayment"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		if payment.Kind == entity.PaymentKindCharge {
+			m, _ := model.PaymentToModel(&payment)
+			res, err := tx.ExecContext(ctx,
+				`UPDATE orders SET total_amount = total_amount
+				WHERE id = $1 AND total_amount = $2 AND currency = $3`,
+				m.OrderID, m.Amount, m.Currency,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			matched, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			if matched == 0 {
+				return fmt.Errorf("%w: order total is no longer %s", entity.ErrOrderNotPayable, payment.Amount)
+			}
+		}
+		if err := insertPayment(ctx, tx, &payment); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertPaymentEvent(ctx, tx, entity.EventPaymentCreated, &payment); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresPaymentsRepository) GetPaymentByID(ctx context.Context, id entity.UUID) (*entity.Payment, error) {
+	const op = "postgresPaymentsRepository.GetPaymentByID"
+
+	payment, err := fetchPayment(ctx, r.db, id, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrPaymentNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return payment, nil
+}
+
+func (r *postgresPaymentsRepository) GetPaymentsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Payment, error) {
+	const op = "postgresPaymentsRepository.GetPaymentsByOrderID"
+
+	payments, err := fetchPayments(ctx, r.db, `order_id = $1`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return payments, nil
+}
+
+func (r *postgresPaymentsRepository) UpdatePaymentByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Payment) (bool, error)) error {
+	const op = "postgresPaymentsRepository.UpdatePaymentByID"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		payment, err := fetchPayment(ctx, tx, id, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrPaymentNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		updated, err := updateFn(payment)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		m, _ := model.PaymentToModel(payment)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE payments SET status = $1, provider_reference = $2, failure_reason = $3, updated_at = $4
+			WHERE id = $5`,
+			m.Status, m.ProviderReference, m.FailureReason, m.UpdatedAt, id,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertPaymentEvent(ctx, tx, entity.EventPaymentUpdated, payment); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresPaymentsRepository) CreateRefund(ctx context.Context, chargeID entity.UUID, createFn func(*entity.Payment, []*entity.Payment) (*entity.Payment, error)) error {
+	const op = "postgresPaymentsRepository.CreateRefund"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		charge, err := fetchPayment(ctx, tx, chargeID, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrPaymentNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		refunds, err := fetchPayments(ctx, tx, `parent_payment_id = $1`, chargeID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		refund, err := createFn(charge, refunds)
+		if err != nil {
+			return err
+		}
+
+		if err := insertPayment(ctx, tx, refund); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertPaymentEvent(ctx, tx, entity.EventPaymentCreated, refund); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func insertPayment(ctx context.Context, tx *sql.Tx, payment *entity.Payment) error {
+	m, lines := model.PaymentToModel(payment)
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO payments (id, order_id, kind, parent_payment_id, amount, currency, payment_method,
+			status, provider_reference, failure_reason, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		m.ID, m.OrderID, m.Kind, m.ParentPaymentID, m.Amount, m.Currency, m.PaymentMethod,
+		m.Status, m.ProviderReference, m.FailureReason, m.CreatedAt, m.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO payment_refund_lines (payment_id, product_id, quantity, amount)
+			VALUES ($1, $2, $3, $4)`,
+			line.PaymentID, line.ProductID, line.Quantity, line.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPaymentEvent(ctx context.Context, tx *sql.Tx, eventType string, payment *entity.Payment) error {
+	event, err := entity.NewEvent(entity.AggregateOrder, payment.OrderID.String(), eventType, model.PaymentToEvent(payment), payment.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, event)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPayment(row rowScanner) (*model.Payment, error) {
+	var m model.Payment
+	err := row.Scan(
+		&m.ID,
+		&m.OrderID,
+		&m.Kind,
+		&m.ParentPaymentID,
+		&m.Amount,
+		&m.Currency,
+		&m.PaymentMethod,
+		&m.Status,
+		&m.ProviderReference,
+		&m.FailureReason,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func fetchPayment(ctx context.Context, q queryer, id entity.UUID, forUpdate bool) (*entity.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE id = $1`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	m, err := scanPayment(q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	lines, err := fetchRefundLines(ctx, q, []string{m.ID})
+	if err != nil {
+		return nil, err
+	}
+	return model.ModelToPayment(m, lines[m.ID])
+}
+
+func fetchPayments(ctx context.Context, q queryer, where string, args ...any) ([]*entity.Payment, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE `+where+` ORDER BY created_at, id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*model.Payment
+	for rows.Next() {
+		m, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	lines, err := fetchRefundLines(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*entity.Payment, 0, len(models))
+	for _, m := range models {
+		payment, err := model.ModelToPayment(m, lines[m.ID])
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, nil
+}
+
+func fetchRefundLines(ctx context.Context, q queryer, paymentIDs []string) (map[string][]model.RefundLine, error) {
+	linesByPayment := make(map[string][]model.RefundLine, len(paymentIDs))
+	if len(paymentIDs) == 0 {
+		return linesByPayment, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT payment_id, product_id, quantity, amount
+		FROM payment_refund_lines
+		WHERE payment_id = ANY($1)
+		ORDER BY product_id`, pq.Array(paymentIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line model.RefundLine
+		if err := rows.Scan(&line.PaymentID, &line.ProductID, &line.Quantity, &line.Amount); err != nil {
+			return nil, err
+		}
+		linesByPayment[line.PaymentID] = append(linesByPayment[line.PaymentID], line)
+	}
+	return linesByPayment, rows.Err()
+}
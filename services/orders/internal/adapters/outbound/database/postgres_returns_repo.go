@@ -0,0 +1,273 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresReturnRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresReturnRepository(db *sql.DB) ports.ReturnRepository {
+	return &postgresReturnRepository{db: db}
+}
+
+const returnColumns = `id, order_id, status, refund_id, reason, created_at, updated_at`
+
+func (r *postgresReturnRepository) CreateReturn(ctx context.Context, orderID entity.UUID, createFn func(*entity.Order, []*entity.Return) (*entity.Return, error)) error {
+	const op = "postgresReturnRepository.CreateReturn"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		order, err := loadOrder(ctx, tx, orderID, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrOrderNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		returns, err := fetchReturns(ctx, tx, `order_id = $1`, orderID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		storedHistory := len(order.StatusHistory)
+		ret, err := createFn(order, returns)
+		if err != nil {
+			return err
+		}
+
+		m, items := model.ReturnToModel(ret)
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO returns (`+returnColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			m.ID, m.OrderID, m.Status, m.RefundID, m.Reason, m.CreatedAt, m.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO return_items (return_id, product_id, quantity, reason, condition) VALUES ($1, $2, $3, $4, $5)`,
+				item.ReturnID, item.ProductID, item.Quantity, item.Reason, item.Condition,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if err := insertStatusHistory(ctx, tx, order.StatusHistory[storedHistory:]); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertReturnEvent(ctx, tx, entity.EventReturnCreated, ret); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresReturnRepository) GetReturnByID(ctx context.Context, id entity.UUID) (*entity.Return, error) {
+	const op = "postgresReturnRepository.GetReturnByID"
+
+	ret, err := fetchReturn(ctx, r.db, id, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrReturnNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ret, nil
+}
+
+func (r *postgresReturnRepository) GetReturnsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Return, error) {
+	const op = "postgresReturnRepository.GetReturnsByOrderID"
+
+	returns, err := fetchReturns(ctx, r.db, `order_id = $1`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return returns, nil
+}
+
+func (r *postgresReturnRepository) UpdateReturnByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Order, *entity.Return) (bool, error)) error {
+	const op = "postgresReturnRepository.UpdateReturnByID"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		// The order is locked before the return, in the same order as
+		// CreateReturn takes them.
+		var orderID entity.UUID
+		err := tx.QueryRowContext(ctx, `SELECT order_id FROM returns WHERE id = $1`, id).Scan(&orderID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrReturnNotFound
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		order, err := loadOrder(ctx, tx, orderID, true)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		ret, err := fetchReturn(ctx, tx, id, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrReturnNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		storedHistory := len(order.StatusHistory)
+		updated, err := updateFn(order, ret)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		m, items := model.ReturnToModel(ret)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE returns SET status = $1, refund_id = $2, reason = $3, updated_at = $4 WHERE id = $5`,
+			m.Status, m.RefundID, m.Reason, m.UpdatedAt, id,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		// Only the condition of an item changes after the return is created.
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE return_items SET condition = $1 WHERE return_id = $2 AND product_id = $3`,
+				item.Condition, item.ReturnID, item.ProductID,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if err := insertStatusHistory(ctx, tx, order.StatusHistory[storedHistory:]); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertReturnEvent(ctx, tx, entity.EventReturnUpdated, ret); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func insertReturnEvent(ctx context.Context, tx *sql.Tx, eventType string, ret *entity.Return) error {
+	event, err := entity.NewEvent(entity.AggregateOrder, ret.OrderID.String(), eventType, model.ReturnToEvent(ret), ret.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, event)
+}
+
+func scanReturn(row rowScanner) (*model.Return, error) {
+	var m model.Return
+	err := row.Scan(
+		&m.ID,
+		&m.OrderID,
+		&m.Status,
+		&m.RefundID,
+		&m.Reason,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func fetchReturn(ctx context.Context, q queryer, id entity.UUID, forUpdate bool) (*entity.Return, error) {
+	query := `SELECT ` + returnColumns + ` FROM returns WHERE id = $1`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	m, err := scanReturn(q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrReturnNotFound
+		}
+		return nil, err
+	}
+
+	items, err := fetchReturnItems(ctx, q, []string{m.ID})
+	if err != nil {
+		return nil, err
+	}
+	return model.ModelToReturn(m, items[m.ID]), nil
+}
+
+func fetchReturns(ctx context.Context, q queryer, where string, args ...any) ([]*entity.Return, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT `+returnColumns+` FROM returns WHERE `+where+` ORDER BY created_at, id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*model.Return
+	for rows.Next() {
+		m, err := scanReturn(rows)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	items, err := fetchReturnItems(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	returns := make([]*entity.Return, 0, len(models))
+	for _, m := range models {
+		returns = append(returns, model.ModelToReturn(m, items[m.ID]))
+	}
+	return returns, nil
+}
+
+func fetchReturnItems(ctx context.Context, q queryer, returnIDs []string) (map[string][]model.ReturnItem, error) {
+	itemsByReturn := make(map[string][]model.ReturnItem, len(returnIDs))
+	if len(returnIDs) == 0 {
+		return itemsByReturn, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT return_id, product_id, quantity, reason, condition
+		FROM return_items
+		WHERE return_id = ANY($1)
+		ORDER BY product_id`, pq.Array(returnIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.ReturnItem
+		if err := rows.Scan(&item.ReturnID, &item.ProductID, &item.Quantity, &item.Reason, &item.Condition); err != nil {
+			return nil, err
+		}
+		itemsByReturn[item.ReturnID] = append(itemsByReturn[item.ReturnID], item)
+	}
+	return itemsByReturn, rows.Err()
+}
@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresSagaRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSagaRepository(db *sql.DB) ports.OrderSagaRepository {
+	return &postgresSagaRepository{db: db}
+}
+
+func (r *postgresSagaRepository) CreateSaga(ctx context.Context, saga entity.OrderSaga) error {
+	const op = "postgresSagaRepository.CreateSaga"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		s, steps := model.SagaToModel(&saga)
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO order_sagas (order_id, status, error, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			s.OrderID, s.Status, s.Error, s.CreatedAt, s.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, step := range steps {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO order_saga_steps (order_id, product_id, quantity, status, updated_at)
+				VALUES ($1, $2, $3, $4, $5)`,
+				step.OrderID, step.ProductID, step.Quantity, step.Status, step.UpdatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *postgresSagaRepository) UpdateSagaStatus(ctx context.Context, orderID entity.UUID, status entity.SagaStatus, reason string) error {
+	const op = "postgresSagaRepository.UpdateSagaStatus"
+
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE order_sagas SET status = $1, error = $2, updated_at = CURRENT_TIMESTAMP WHERE order_id = $3`,
+		status, reason, orderID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return entity.ErrSagaNotFound
+	}
+	return nil
+}
+
+func (r *postgresSagaRepository) UpdateSagaStep(ctx context.Context, orderID entity.UUID, step entity.SagaStep) error {
+	const op = "postgresSagaRepository.UpdateSagaStep"
+
+	m := model.SagaStepToModel(orderID, step)
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE order_saga_steps SET status = $1, updated_at = $2 WHERE order_id = $3 AND product_id = $4`,
+		m.Status, m.UpdatedAt, m.OrderID, m.ProductID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return entity.ErrSagaNotFound
+	}
+	return nil
+}
+
+func (r *postgresSagaRepository) GetUnfinishedSagas(ctx context.Context, updatedBefore time.Time) ([]*entity.OrderSaga, error) {
+	const op = "postgresSagaRepository.GetUnfinishedSagas"
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT order_id, status, error, created_at, updated_at
+		FROM order_sagas
+		WHERE status IN ($1, $2) AND updated_at < $3
+		ORDER BY created_at`,
+		entity.SagaStatusStarted, entity.SagaStatusCompensating, updatedBefore,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var sagas []model.OrderSaga
+	for rows.Next() {
+		var s model.OrderSaga
+		if err := rows.Scan(&s.OrderID, &s.Status, &s.Error, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		sagas = append(sagas, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(sagas) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(sagas))
+	for _, s := range sagas {
+		ids = append(ids, s.OrderID)
+	}
+
+	stepRows, err := r.db.QueryContext(ctx,
+		`SELECT order_id, product_id, quantity, status, updated_at
+		FROM order_saga_steps
+		WHERE order_id = ANY($1)
+		ORDER BY product_id`, pq.Array(ids),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer stepRows.Close()
+
+	stepsBySaga := make(map[string][]model.OrderSagaStep, len(sagas))
+	for stepRows.Next() {
+		var step model.OrderSagaStep
+		if err := stepRows.Scan(&step.OrderID, &step.ProductID, &step.Quantity, &step.Status, &step.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		stepsBySaga[step.OrderID] = append(stepsBySaga[step.OrderID], step)
+	}
+	if err := stepRows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	entities := make([]*entity.OrderSaga, 0, len(sagas))
+	for _, s := range sagas {
+		entities = append(entities, model.ModelToSaga(&s, stepsBySaga[s.OrderID]))
+	}
+	return entities, nil
+}
@@ -0,0 +1,244 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresShipmentRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresShipmentRepository(db *sql.DB) ports.ShipmentRepository {
+	return &postgresShipmentRepository{db: db}
+}
+
+const shipmentColumns = `id, order_id, carrier, tracking_number, status, shipped_at, delivered_at, created_at, updated_at`
+
+func (r *postgresShipmentRepository) CreateShipment(ctx context.Context, orderID entity.UUID, createFn func(*entity.Order, []*entity.Shipment) (*entity.Shipment, error)) error {
+	const op = "postgresShipmentRepository.CreateShipment"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		order, err := loadOrder(ctx, tx, orderID, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrOrderNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		shipments, err := fetchShipments(ctx, tx, `order_id = $1`, orderID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		shipment, err := createFn(order, shipments)
+		if err != nil {
+			return err
+		}
+
+		m, items := model.ShipmentToModel(shipment)
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO shipments (`+shipmentColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			m.ID, m.OrderID, m.Carrier, m.TrackingNumber, m.Status, m.ShippedAt, m.DeliveredAt, m.CreatedAt, m.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO shipment_items (shipment_id, product_id, quantity) VALUES ($1, $2, $3)`,
+				item.ShipmentID, item.ProductID, item.Quantity,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if err := insertShipmentEvent(ctx, tx, entity.EventShipmentCreated, shipment); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresShipmentRepository) GetShipmentByID(ctx context.Context, id entity.UUID) (*entity.Shipment, error) {
+	const op = "postgresShipmentRepository.GetShipmentByID"
+
+	shipment, err := fetchShipment(ctx, r.db, id, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrShipmentNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return shipment, nil
+}
+
+func (r *postgresShipmentRepository) GetShipmentsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Shipment, error) {
+	const op = "postgresShipmentRepository.GetShipmentsByOrderID"
+
+	shipments, err := fetchShipments(ctx, r.db, `order_id = $1`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return shipments, nil
+}
+
+func (r *postgresShipmentRepository) UpdateShipmentByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Shipment) (bool, error)) error {
+	const op = "postgresShipmentRepository.UpdateShipmentByID"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		shipment, err := fetchShipment(ctx, tx, id, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrShipmentNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		updated, err := updateFn(shipment)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		// Items are fixed when the shipment is created.
+		m, _ := model.ShipmentToModel(shipment)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE shipments SET carrier = $1, tracking_number = $2, status = $3, delivered_at = $4, updated_at = $5
+			WHERE id = $6`,
+			m.Carrier, m.TrackingNumber, m.Status, m.DeliveredAt, m.UpdatedAt, id,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertShipmentEvent(ctx, tx, entity.EventShipmentUpdated, shipment); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func insertShipmentEvent(ctx context.Context, tx *sql.Tx, eventType string, shipment *entity.Shipment) error {
+	event, err := entity.NewEvent(entity.AggregateOrder, shipment.OrderID.String(), eventType, model.ShipmentToEvent(shipment), shipment.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(ctx, tx, event)
+}
+
+func scanShipment(row rowScanner) (*model.Shipment, error) {
+	var m model.Shipment
+	err := row.Scan(
+		&m.ID,
+		&m.OrderID,
+		&m.Carrier,
+		&m.TrackingNumber,
+		&m.Status,
+		&m.ShippedAt,
+		&m.DeliveredAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func fetchShipment(ctx context.Context, q queryer, id entity.UUID, forUpdate bool) (*entity.Shipment, error) {
+	query := `SELECT ` + shipmentColumns + ` FROM shipments WHERE id = $1`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	m, err := scanShipment(q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrShipmentNotFound
+		}
+		return nil, err
+	}
+
+	items, err := fetchShipmentItems(ctx, q, []string{m.ID})
+	if err != nil {
+		return nil, err
+	}
+	return model.ModelToShipment(m, items[m.ID]), nil
+}
+
+func fetchShipments(ctx context.Context, q queryer, where string, args ...any) ([]*entity.Shipment, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT `+shipmentColumns+` FROM shipments WHERE `+where+` ORDER BY created_at, id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*model.Shipment
+	for rows.Next() {
+		m, err := scanShipment(rows)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	items, err := fetchShipmentItems(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	shipments := make([]*entity.Shipment, 0, len(models))
+	for _, m := range models {
+		shipments = append(shipments, model.ModelToShipment(m, items[m.ID]))
+	}
+	return shipments, nil
+}
+
+func fetchShipmentItems(ctx context.Context, q queryer, shipmentIDs []string) (map[string][]model.ShipmentItem, error) {
+	itemsByShipment := make(map[string][]model.ShipmentItem, len(shipmentIDs))
+	if len(shipmentIDs) == 0 {
+		return itemsByShipment, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT shipment_id, product_id, quantity
+		FROM shipment_items
+		WHERE shipment_id = ANY($1)
+		ORDER BY product_id`, pq.Array(shipmentIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.ShipmentItem
+		if err := rows.Scan(&item.ShipmentID, &item.ProductID, &item.Quantity); err != nil {
+			return nil, err
+		}
+		itemsByShipment[item.ShipmentID] = append(itemsByShipment[item.ShipmentID], item)
+	}
+	return itemsByShipment, rows.Err()
+}
@@ -0,0 +1,272 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
+)
+
+type postgresSubscriptionRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSubscriptionRepository(db *sql.DB) ports.SubscriptionRepository {
+	return &postgresSubscriptionRepository{db: db}
+}
+
+const subscriptionColumns = `id, user_id, user_name, cron, interval_seconds, stock_policy, status, start_at, end_at, next_run_at, created_at, updated_at`
+
+const subscriptionRunColumns = `id, subscription_id, scheduled_at, order_id, status, reason, created_at`
+
+func (r *postgresSubscriptionRepository) CreateSubscription(ctx context.Context, subscription *entity.Subscription) error {
+	const op = "postgresSubscriptionRepository.CreateSubscription"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		m, items := model.SubscriptionToModel(subscription)
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO subscriptions (`+subscriptionColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+			m.ID, m.UserID, m.UserName, m.Cron, m.IntervalSeconds, m.StockPolicy, m.Status,
+			m.StartAt, m.EndAt, m.NextRunAt, m.CreatedAt, m.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		for _, item := range items {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO subscription_items (subscription_id, product_id, quantity) VALUES ($1, $2, $3)`,
+				item.SubscriptionID, item.ProductID, item.Quantity,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *postgresSubscriptionRepository) GetSubscriptionByID(ctx context.Context, id entity.UUID) (*entity.Subscription, error) {
+	const op = "postgresSubscriptionRepository.GetSubscriptionByID"
+
+	subscription, err := fetchSubscription(ctx, r.db, id, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrSubscriptionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return subscription, nil
+}
+
+func (r *postgresSubscriptionRepository) GetSubscriptionsByUserID(ctx context.Context, userID entity.UUID) ([]*entity.Subscription, error) {
+	const op = "postgresSubscriptionRepository.GetSubscriptionsByUserID"
+
+	subscriptions, err := fetchSubscriptions(ctx, r.db, `user_id = $1 ORDER BY created_at, id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return subscriptions, nil
+}
+
+func (r *postgresSubscriptionRepository) GetDueSubscriptions(ctx context.Context, now time.Time, limit int) ([]*entity.Subscription, error) {
+	const op = "postgresSubscriptionRepository.GetDueSubscriptions"
+
+	subscriptions, err := fetchSubscriptions(ctx, r.db,
+		`status = 'active' AND next_run_at <= $1 ORDER BY next_run_at, id LIMIT $2`, now, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return subscriptions, nil
+}
+
+func (r *postgresSubscriptionRepository) UpdateSubscriptionByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Subscription) (bool, error)) error {
+	const op = "postgresSubscriptionRepository.UpdateSubscriptionByID"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		subscription, err := fetchSubscription(ctx, tx, id, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrSubscriptionNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		updated, err := updateFn(subscription)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		// Only the schedule and status change after a subscription is
+		// created.
+		m, _ := model.SubscriptionToModel(subscription)
+		_, err = tx.ExecContext(ctx,
+			`UPDATE subscriptions SET status = $1, end_at = $2, next_run_at = $3, updated_at = $4 WHERE id = $5`,
+			m.Status, m.EndAt, m.NextRunAt, m.UpdatedAt, id,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+func (r *postgresSubscriptionRepository) SaveSubscriptionRun(ctx context.Context, run *entity.SubscriptionRun) error {
+	const op = "postgresSubscriptionRepository.SaveSubscriptionRun"
+
+	m := model.SubscriptionRunToModel(run)
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO subscription_runs (`+subscriptionRunColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		m.ID, m.SubscriptionID, m.ScheduledAt, m.OrderID, m.Status, m.Reason, m.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *postgresSubscriptionRepository) GetSubscriptionRuns(ctx context.Context, subscriptionID entity.UUID) ([]*entity.SubscriptionRun, error) {
+	const op = "postgresSubscriptionRepository.GetSubscriptionRuns"
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+subscriptionRunColumns+` FROM subscription_runs
+		WHERE subscription_id = $1
+		ORDER BY scheduled_at DESC`, subscriptionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var runs []*entity.SubscriptionRun
+	for rows.Next() {
+		var m model.SubscriptionRun
+		err := rows.Scan(&m.ID, &m.SubscriptionID, &m.ScheduledAt, &m.OrderID, &m.Status, &m.Reason, &m.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		runs = append(runs, model.ModelToSubscriptionRun(&m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return runs, nil
+}
+
+func scanSubscription(row rowScanner) (*model.Subscription, error) {
+	var m model.Subscription
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.UserName,
+		&m.Cron,
+		&m.IntervalSeconds,
+		&m.StockPolicy,
+		&m.Status,
+		&m.StartAt,
+		&m.EndAt,
+		&m.NextRunAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func fetchSubscription(ctx context.Context, q queryer, id entity.UUID, forUpdate bool) (*entity.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE id = $1`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	m, err := scanSubscription(q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	items, err := fetchSubscriptionItems(ctx, q, []string{m.ID})
+	if err != nil {
+		return nil, err
+	}
+	return model.ModelToSubscription(m, items[m.ID]), nil
+}
+
+// fetchSubscriptions lists the subscriptions matching where, which may end
+// with ORDER BY and LIMIT clauses.
+func fetchSubscriptions(ctx context.Context, q queryer, where string, args ...any) ([]*entity.Subscription, error) {
+	rows, err := q.QueryContext(ctx, `SELECT `+subscriptionColumns+` FROM subscriptions WHERE `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*model.Subscription
+	for rows.Next() {
+		m, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	items, err := fetchSubscriptionItems(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*entity.Subscription, 0, len(models))
+	for _, m := range models {
+		subscriptions = append(subscriptions, model.ModelToSubscription(m, items[m.ID]))
+	}
+	return subscriptions, nil
+}
+
+func fetchSubscriptionItems(ctx context.Context, q queryer, subscriptionIDs []string) (map[string][]model.SubscriptionItem, error) {
+	itemsBySubscription := make(map[string][]model.SubscriptionItem, len(subscriptionIDs))
+	if len(subscriptionIDs) == 0 {
+		return itemsBySubscription, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT subscription_id, product_id, quantity
+		FROM subscription_items
+		WHERE subscription_id = ANY($1)
+		ORDER BY product_id`, pq.Array(subscriptionIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.SubscriptionItem
+		if err := rows.Scan(&item.SubscriptionID, &item.ProductID, &item.Quantity); err != nil {
+			return nil, err
+		}
+		itemsBySubscription[item.SubscriptionID] = append(itemsBySubscription[item.SubscriptionID], item)
+	}
+	return itemsBySubscription, rows.Err()
+}
@@ -3,9 +3,16 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database/model"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/lib/pq"
 )
 
 type postgresOrdersRepository struct {
@@ -16,21 +23,681 @@ func NewPostgresOrdersRepository(db *sql.DB) ports.OrdersRepository {
 	return &postgresOrdersRepository{db: db}
 }
 
+// queryer is satisfied by both *sql.DB and *sql.Tx, so the row helpers below
+// can be shared between plain reads and transactional read-modify-write.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 func (r *postgresOrdersRepository) GetOrderByID(ctx context.Context, id entity.UUID) (*entity.Order, error) {
-	return nil, entity.ErrNotImplemented
+	const op = "postgresOrdersRepository.GetOrderByID"
+
+	order, err := loadOrder(ctx, r.db, id, false)
+	if err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return order, nil
 }
-func (r *postgresOrdersRepository) SaveOrder(ctx context.Context, item entity.Order) error {
-	return entity.ErrNotImplemented
+
+func (r *postgresOrdersRepository) SaveOrder(ctx context.Context, order entity.Order) error {
+	const op = "postgresOrdersRepository.SaveOrder"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		m, items, err := model.OrderToModel(&order)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO orders (id, user_id, user_name, total_amount, currency, status, tax_region, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			m.ID, m.UserID, m.UserName,
+			m.TotalAmount, m.Currency, m.Status,
+			m.TaxRegion, m.CreatedAt, m.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := insertOrderItems(ctx, tx, items); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := redeemDiscounts(ctx, tx, &order); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertTaxLines(ctx, tx, &order); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertAddresses(ctx, tx, &order); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertStatusHistory(ctx, tx, order.StatusHistory); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOrderTasks(ctx, tx, order.Tasks); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		payload, err := model.OrderToEvent(&order)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		event, err := entity.NewEvent(entity.AggregateOrder, order.ID.String(), entity.EventOrderCreated, payload, order.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOutboxEvents(ctx, tx, event); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
 }
+
 func (r *postgresOrdersRepository) UpdateOrderByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Order) (bool, error)) error {
-	return entity.ErrNotImplemented
+	const op = "postgresOrdersRepository.UpdateOrderByID"
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Coupons and addresses are fixed when the order is placed. Discount
+		// amounts and tax lines follow the items when they are edited.
+		e, err := loadOrder(ctx, tx, id, true)
+		if err != nil {
+			if errors.Is(err, entity.ErrOrderNotFound) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		previousStatus := e.Status
+		previousTotal := e.TotalAmount
+		storedHistory := len(e.StatusHistory)
+		updated, err := updateFn(e)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
+			return entity.ErrNotUpdated
+		}
+
+		// A charge is for the total it was created with, so the total stays
+		// put once the order has one. SavePayment writes the order row when
+		// it adds a charge, so the two cannot race.
+		if e.TotalAmount != previousTotal {
+			var charged bool
+			err := tx.QueryRowContext(ctx,
+				`SELECT EXISTS (SELECT 1 FROM payments WHERE order_id = $1 AND kind = $2 AND status <> $3)`,
+				id, entity.PaymentKindCharge, entity.PaymentStatusFailed,
+			).Scan(&charged)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			if charged {
+				return fmt.Errorf("%w: order has a payment for its current total", entity.ErrOrderNotEditable)
+			}
+		}
+
+		o, newItems, err := model.OrderToModel(e)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE orders SET user_name = $1, total_amount = $2, currency = $3, status = $4, edit_version = $5, updated_at = $6 WHERE id = $7",
+			o.UserName, o.TotalAmount, o.Currency, o.Status, o.EditVersion, o.UpdatedAt, id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Items are owned by the order, so they are rewritten as a whole.
+		_, err = tx.ExecContext(ctx, `DELETE FROM order_items WHERE order_id = $1`, id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOrderItems(ctx, tx, newItems); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := updateDiscountAmounts(ctx, tx, e); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		_, err = tx.ExecContext(ctx, `DELETE FROM order_tax_lines WHERE order_id = $1`, id)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertTaxLines(ctx, tx, e); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertStatusHistory(ctx, tx, e.StatusHistory[storedHistory:]); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOrderTasks(ctx, tx, e.Tasks); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		events, err := orderUpdatedEvents(e, previousStatus)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOutboxEvents(ctx, tx, events...); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
 }
+
 func (r *postgresOrdersRepository) DeleteOrderByID(ctx context.Context, id entity.UUID) error {
-	return entity.ErrNotImplemented
+	const op = "postgresOrdersRepository.DeleteOrderByID"
+
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		var status string
+		err := tx.QueryRowContext(ctx,
+			`SELECT status FROM orders WHERE id = $1 FOR UPDATE`, id,
+		).Scan(&status)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrOrderNotFound
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if entity.OrderStatus(status) != entity.OrderStatusCancelled {
+			return fmt.Errorf("%w: order is %s", entity.ErrOrderNotDeletable, status)
+		}
+
+		// Deleting cascades to payments, which must outlive the order.
+		var paid bool
+		err = tx.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM payments WHERE order_id = $1 AND status <> 'failed')`, id,
+		).Scan(&paid)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if paid {
+			return fmt.Errorf("%w: order has payments", entity.ErrOrderNotDeletable)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM orders WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		event, err := entity.NewEvent(entity.AggregateOrder, id.String(), entity.EventOrderDeleted, model.OrderDeletedEvent{ID: id.String()}, time.Now())
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertOutboxEvents(ctx, tx, event); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	})
+}
+
+// GetOrderStatusHistory lists the status changes of an order. History outlives
+// its order, so a deleted order is only reported missing when it has none.
+func (r *postgresOrdersRepository) GetOrderStatusHistory(ctx context.Context, id entity.UUID) ([]entity.StatusTransition, error) {
+	const op = "postgresOrdersRepository.GetOrderStatusHistory"
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, order_id, from_status, to_status, actor, reason, changed_at, return_id, return_status
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY id`, id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var history []entity.StatusTransition
+	for rows.Next() {
+		var m model.StatusHistoryEntry
+		err := rows.Scan(
+			&m.ID,
+			&m.OrderID,
+			&m.FromStatus,
+			&m.ToStatus,
+			&m.Actor,
+			&m.Reason,
+			&m.ChangedAt,
+			&m.ReturnID,
+			&m.ReturnStatus,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		history = append(history, model.ModelToStatusTransition(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(history) == 0 {
+		if _, err := fetchOrder(ctx, r.db, id, false); err != nil {
+			if errors.Is(err, entity.ErrOrderNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return history, nil
+}
+
+// orderUpdatedEvents describes an update of order. A status transition gets
+// its own event besides order.updated so consumers can follow the lifecycle
+// without diffing snapshots.
+func orderUpdatedEvents(order *entity.Order, previousStatus entity.OrderStatus) ([]entity.Event, error) {
+	aggregateID := order.ID.String()
+	payload, err := model.OrderToEvent(order)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := entity.NewEvent(entity.AggregateOrder, aggregateID, entity.EventOrderUpdated, payload, order.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	events := []entity.Event{updated}
+
+	if order.Status != previousStatus {
+		changed, err := entity.NewEvent(entity.AggregateOrder, aggregateID, entity.EventOrderStatusChanged, model.OrderStatusChangedEvent{
+			ID:   aggregateID,
+			From: string(previousStatus),
+			To:   string(order.Status),
+		}, order.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, changed)
+	}
+	return events, nil
 }
-func (r *postgresOrdersRepository) GetTotalOrdersCount(ctx context.Context) (int64, error) {
-	return 0, entity.ErrNotImplemented
+
+func (r *postgresOrdersRepository) GetTotalOrdersCount(ctx context.Context, filter entity.OrderFilter) (int64, error) {
+	const op = "postgresOrdersRepository.GetTotalOrdersCount"
+
+	where, args := orderFilterClause(filter)
+	var total int64
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders"+where, args...).Scan(&total)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return total, nil
 }
-func (r *postgresOrdersRepository) GetAllOrders(ctx context.Context, pagination *entity.Pagination) ([]*entity.Order, error) {
-	return nil, entity.ErrNotImplemented
+
+func (r *postgresOrdersRepository) GetAllOrders(ctx context.Context, filter entity.OrderFilter, pagination *entity.Pagination) ([]*entity.Order, error) {
+	const op = "postgresOrdersRepository.GetAllOrders"
+
+	where, args := orderFilterClause(filter)
+	query := `
+		SELECT id, user_id, user_name, total_amount, currency, status, tax_region, edit_version, created_at, updated_at
+		FROM orders
+	` + where
+
+	// id breaks ties, so pages do not overlap when many orders share a value.
+	if column := pagination.SortBy.ColumnName(); column != "" {
+		query += " ORDER BY " + column + ", id"
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	query += " LIMIT " + strconv.FormatInt(pagination.PageSize, 10) + " OFFSET " + strconv.FormatInt(offset, 10)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var modelOrders []model.Order
+	for rows.Next() {
+		var m model.Order
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.UserName,
+			&m.TotalAmount,
+			&m.Currency,
+			&m.Status,
+			&m.TaxRegion,
+			&m.EditVersion,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		modelOrders = append(modelOrders, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids := make([]string, 0, len(modelOrders))
+	for _, m := range modelOrders {
+		ids = append(ids, m.ID)
+	}
+	itemsByOrder, err := fetchItemsForOrders(ctx, r.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	discountsByOrder, err := fetchDiscountsForOrders(ctx, r.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	taxLinesByOrder, err := fetchTaxLinesForOrders(ctx, r.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	addressesByOrder, err := fetchAddressesForOrders(ctx, r.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	entities := make([]*entity.Order, 0, len(modelOrders))
+	for _, m := range modelOrders {
+		e, err := model.ModelToOrder(&m, itemsByOrder[m.ID], discountsByOrder[m.ID], taxLinesByOrder[m.ID], addressesByOrder[m.ID])
+		if err != nil {
+			return nil, fmt.Errorf("%s: order %s: %w", op, m.ID, err)
+		}
+		entities = append(entities, e)
+	}
+
+	return entities, nil
+}
+
+// orderFilterClause turns filter into a WHERE clause over orders and its
+// arguments, numbered from $1. It is empty when nothing is filtered.
+func orderFilterClause(filter entity.OrderFilter) (string, []any) {
+	var conditions []string
+	var args []any
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.UserID != "" {
+		add("user_id = $%d", filter.UserID)
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, string(s))
+		}
+		add("status = ANY($%d::order_status[])", pq.Array(statuses))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		add("created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		add("created_at < $%d", filter.CreatedTo)
+	}
+	if currency := filter.TotalCurrency(); currency != "" {
+		add("currency = $%d", currency)
+	}
+	if filter.MinTotal != nil {
+		add("total_amount >= $%d", filter.MinTotal.Decimal())
+	}
+	if filter.MaxTotal != nil {
+		add("total_amount <= $%d", filter.MaxTotal.Decimal())
+	}
+	if filter.ProductID != "" {
+		add("EXISTS (SELECT 1 FROM order_items i WHERE i.order_id = orders.id AND i.product_id = $%d)", filter.ProductID)
+	}
+	if filter.Unpaid {
+		add("NOT EXISTS (SELECT 1 FROM payments p WHERE p.order_id = orders.id AND p.kind = '"+string(entity.PaymentKindCharge)+"' AND p.status = ANY($%d))",
+			pq.Array([]string{string(entity.PaymentStatusAuthorized), string(entity.PaymentStatusCaptured)}))
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// loadOrder reads an order together with everything stored alongside it.
+func loadOrder(ctx context.Context, q queryer, id entity.UUID, forUpdate bool) (*entity.Order, error) {
+	order, err := fetchOrder(ctx, q, id, forUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := fetchOrderItems(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{order.ID}
+	discounts, err := fetchDiscountsForOrders(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	taxLines, err := fetchTaxLinesForOrders(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := fetchAddressesForOrders(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	return model.ModelToOrder(order, items, discounts[order.ID], taxLines[order.ID], addresses[order.ID])
+}
+
+func fetchOrder(ctx context.Context, q queryer, id entity.UUID, forUpdate bool) (*model.Order, error) {
+	query := `SELECT
+			id,
+			user_id,
+			user_name,
+			total_amount,
+			currency,
+			status,
+			tax_region,
+			edit_version,
+			created_at,
+			updated_at
+		FROM orders
+		WHERE id = $1`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	var order model.Order
+	err := q.QueryRowContext(ctx, query, id).Scan(
+		&order.ID,
+		&order.UserID,
+		&order.UserName,
+		&order.TotalAmount,
+		&order.Currency,
+		&order.Status,
+		&order.TaxRegion,
+		&order.EditVersion,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+func fetchOrderItems(ctx context.Context, q queryer, orderID entity.UUID) ([]model.OrderItem, error) {
+	itemsByOrder, err := fetchItemsForOrders(ctx, q, []string{orderID.String()})
+	if err != nil {
+		return nil, err
+	}
+	return itemsByOrder[orderID.String()], nil
+}
+
+func fetchItemsForOrders(ctx context.Context, q queryer, orderIDs []string) (map[string][]model.OrderItem, error) {
+	itemsByOrder := make(map[string][]model.OrderItem, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return itemsByOrder, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT id, order_id, product_id, product_name, product_price, quantity, created_at, updated_at
+		FROM order_items
+		WHERE order_id = ANY($1)
+		ORDER BY created_at, product_id`, pq.Array(orderIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.OrderItem
+		err := rows.Scan(
+			&item.ID,
+			&item.OrderID,
+			&item.ProductID,
+			&item.ProductName,
+			&item.ProductPrice,
+			&item.Quantity,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		itemsByOrder[item.OrderID] = append(itemsByOrder[item.OrderID], item)
+	}
+
+	return itemsByOrder, rows.Err()
+}
+
+func insertOrderItems(ctx context.Context, tx *sql.Tx, items []model.OrderItem) error {
+	for _, item := range items {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO order_items (order_id, product_id, product_name, product_price, quantity, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			item.OrderID, item.ProductID, item.ProductName,
+			item.ProductPrice, item.Quantity,
+			item.CreatedAt, item.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertStatusHistory(ctx context.Context, tx *sql.Tx, history []entity.StatusTransition) error {
+	for _, transition := range history {
+		m := model.StatusTransitionToModel(transition)
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO order_status_history (order_id, from_status, to_status, actor, reason, changed_at, return_id, return_status)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			m.OrderID, m.FromStatus, m.ToStatus,
+			m.Actor, m.Reason, m.ChangedAt,
+			m.ReturnID, m.ReturnStatus,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertTaxLines(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	for _, line := range order.TaxLines {
+		m := model.TaxLineToModel(order.ID, line)
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO order_tax_lines (order_id, product_id, tax_class, region, rate, taxable, amount, inclusive)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			m.OrderID, m.ProductID, m.TaxClass, m.Region, m.Rate, m.Taxable, m.Amount, m.Inclusive,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fetchTaxLinesForOrders(ctx context.Context, q queryer, orderIDs []string) (map[string][]model.OrderTaxLine, error) {
+	linesByOrder := make(map[string][]model.OrderTaxLine, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return linesByOrder, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT order_id, product_id, tax_class, region, rate, taxable, amount, inclusive
+		FROM order_tax_lines
+		WHERE order_id = ANY($1)
+		ORDER BY order_id, product_id`, pq.Array(orderIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var l model.OrderTaxLine
+		err := rows.Scan(
+			&l.OrderID,
+			&l.ProductID,
+			&l.TaxClass,
+			&l.Region,
+			&l.Rate,
+			&l.Taxable,
+			&l.Amount,
+			&l.Inclusive,
+		)
+		if err != nil {
+			return nil, err
+		}
+		linesByOrder[l.OrderID] = append(linesByOrder[l.OrderID], l)
+	}
+	return linesByOrder, rows.Err()
+}
+
+func insertAddresses(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	for _, a := range model.AddressesToModel(order) {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO order_addresses (order_id, kind, name, line1, line2, city, region, postal_code, country, phone)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			a.OrderID, a.Kind, a.Name, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.Country, a.Phone,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fetchAddressesForOrders(ctx context.Context, q queryer, orderIDs []string) (map[string][]model.OrderAddress, error) {
+	addressesByOrder := make(map[string][]model.OrderAddress, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return addressesByOrder, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT order_id, kind, name, line1, line2, city, region, postal_code, country, phone
+		FROM order_addresses
+		WHERE order_id = ANY($1)`, pq.Array(orderIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a model.OrderAddress
+		err := rows.Scan(
+			&a.OrderID,
+			&a.Kind,
+			&a.Name,
+			&a.Line1,
+			&a.Line2,
+			&a.City,
+			&a.Region,
+			&a.PostalCode,
+			&a.Country,
+			&a.Phone,
+		)
+		if err != nil {
+			return nil, err
+		}
+		addressesByOrder[a.OrderID] = append(addressesByOrder[a.OrderID], a)
+	}
+	return addressesByOrder, rows.Err()
 }
@@ -7,8 +7,12 @@ import (
 )
 
 func runInTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	return runInTxWithIsolation(ctx, db, sql.LevelRepeatableRead, fn)
+}
+
+func runInTxWithIsolation(ctx context.Context, db *sql.DB, isolation sql.IsolationLevel, fn func(tx *sql.Tx) error) error {
 	tx, err := db.BeginTx(ctx, &sql.TxOptions{
-		Isolation: sql.LevelRepeatableRead,
+		Isolation: isolation,
 		ReadOnly:  false,
 	})
 	if err != nil {
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+// JSONLPublisher appends each event as one JSON line to a file, which is
+// handy for watching events during local runs.
+type JSONLPublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+type jsonlEvent struct {
+	ID            string          `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Type          string          `json:"type"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+func NewJSONLPublisher(path string) (*JSONLPublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open events file: %w", err)
+	}
+	return &JSONLPublisher{file: file}, nil
+}
+
+// Publish returns only once the line is synced to disk, so an event the relay
+// marks published is not lost with the process.
+func (p *JSONLPublisher) Publish(ctx context.Context, event entity.Event) error {
+	line, err := json.Marshal(jsonlEvent{
+		ID:            event.ID.String(),
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		Type:          event.Type,
+		Payload:       event.Payload,
+		OccurredAt:    event.OccurredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event %s: %w", event.ID, err)
+	}
+	return p.file.Sync()
+}
+
+func (p *JSONLPublisher) Close() error {
+	return p.file.Close()
+}
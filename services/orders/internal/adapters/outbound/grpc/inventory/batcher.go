@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+var ErrBatcherClosed = fmt.Errorf("reservation batcher is closed")
+
+type ReservationBatcherConfig struct {
+	// MaxBatchSize is the number of lines that triggers an early flush.
+	// Values below 2 disable batching.
+	MaxBatchSize int
+	// FlushInterval is the longest time a request waits for companions.
+	FlushInterval time.Duration
+	// Timeout bounds each combined request sent to inventory.
+	Timeout time.Duration
+}
+
+func (c ReservationBatcherConfig) Enabled() bool {
+	return c.MaxBatchSize > 1 && c.FlushInterval > 0
+}
+
+// ReservationHold is one caller's share of a combined reservation.
+type ReservationHold struct {
+	OrderID entity.UUID
+	Items   []entity.OrderItem
+}
+
+type reservationRequest struct {
+	ctx    context.Context
+	hold   ReservationHold
+	result chan error
+}
+
+// ReservationBatcher coalesces concurrent reservations into one combined
+// request per flush. Every caller gets its own result: if inventory rejects a
+// combined batch, the batcher retries each caller's lines on their own so a
+// single unavailable product cannot fail unrelated orders.
+type ReservationBatcher struct {
+	cfg      ReservationBatcherConfig
+	send     func(ctx context.Context, holds []ReservationHold) error
+	requests chan *reservationRequest
+	stop     chan struct{}
+	done     chan struct{}
+	flushes  sync.WaitGroup
+	once     sync.Once
+}
+
+func NewReservationBatcher(cfg ReservationBatcherConfig, send func(ctx context.Context, holds []ReservationHold) error) *ReservationBatcher {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	b := &ReservationBatcher{
+		cfg:      cfg,
+		send:     send,
+		requests: make(chan *reservationRequest),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// Reserve queues items for the next flush and blocks until inventory has
+// answered. Once queued, the request is answered even if ctx is cancelled
+// later, so the caller never loses track of a reservation that was made.
+func (b *ReservationBatcher) Reserve(ctx context.Context, orderID entity.UUID, items []entity.OrderItem) error {
+	req := &reservationRequest{
+		ctx:    ctx,
+		hold:   ReservationHold{OrderID: orderID, Items: items},
+		result: make(chan error, 1),
+	}
+
+	select {
+	case b.requests <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.stop:
+		return ErrBatcherClosed
+	}
+
+	return <-req.result
+}
+
+// Close flushes the pending requests and waits for in-flight batches.
+func (b *ReservationBatcher) Close() {
+	b.once.Do(func() {
+		close(b.stop)
+		<-b.done
+		b.flushes.Wait()
+	})
+}
+
+func (b *ReservationBatcher) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	var pending []*reservationRequest
+	lines := 0
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		batch := pending
+		pending, lines = nil, 0
+
+		b.flushes.Add(1)
+		go func() {
+			defer b.flushes.Done()
+			b.flush(batch)
+		}()
+	}
+
+	for {
+		select {
+		case req := <-b.requests:
+			pending = append(pending, req)
+			lines += len(req.hold.Items)
+			if lines >= b.cfg.MaxBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-b.stop:
+			flush()
+			return
+		}
+	}
+}
+
+func (b *ReservationBatcher) flush(batch []*reservationRequest) {
+	live := make([]*reservationRequest, 0, len(batch))
+	for _, req := range batch {
+		// Requests abandoned before the flush are dropped, nothing was sent for them yet.
+		if err := req.ctx.Err(); err != nil {
+			req.result <- err
+			continue
+		}
+		live = append(live, req)
+	}
+	if len(live) == 0 {
+		return
+	}
+
+	combined := make([]ReservationHold, 0, len(live))
+	for _, req := range live {
+		combined = append(combined, req.hold)
+	}
+
+	err := b.sendWithTimeout(combined)
+	if err == nil || len(live) == 1 || !isReservationRejection(err) {
+		for _, req := range live {
+			req.result <- err
+		}
+		return
+	}
+
+	for _, req := range live {
+		req.result <- b.sendWithTimeout([]ReservationHold{req.hold})
+	}
+}
+
+func (b *ReservationBatcher) sendWithTimeout(holds []ReservationHold) error {
+	ctx, cancel := context.WithTimeout(context.Background(), b.cfg.Timeout)
+	defer cancel()
+	return b.send(ctx, holds)
+}
+
+func isReservationRejection(err error) bool {
+	return errors.Is(err, entity.ErrInsufficientQuantity) ||
+		errors.Is(err, entity.ErrItemNotFound) ||
+		errors.Is(err, entity.ErrPriceMismatch)
+}
@@ -2,26 +2,43 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type InventoryClient struct {
-	conn   *grpc.ClientConn
-	client InventoryServiceClient
+	conn    *grpc.ClientConn
+	client  InventoryServiceClient
+	batcher *ReservationBatcher
 }
 
-func NewInventoryClient(address string) (*InventoryClient, error) {
+func NewInventoryClient(address string, batching ReservationBatcherConfig) (*InventoryClient, error) {
 	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return nil, err
 	}
-	return &InventoryClient{
+	c := &InventoryClient{
 		conn:   conn,
 		client: NewInventoryServiceClient(conn),
-	}, nil
+	}
+	if batching.Enabled() {
+		c.batcher = NewReservationBatcher(batching, c.batchReserve)
+	}
+	return c, nil
+}
+
+func (c *InventoryClient) Close() error {
+	if c.batcher != nil {
+		c.batcher.Close()
+	}
+	return c.conn.Close()
 }
 
 func (c *InventoryClient) GetProduct(ctx context.Context, productID entity.UUID) (*entity.OrderItem, error) {
@@ -35,8 +52,9 @@ func (c *InventoryClient) GetProduct(ctx context.Context, productID entity.UUID)
 	entity := &entity.OrderItem{
 		ProductID:    entity.UUID(product.GetId()),
 		ProductName:  product.GetName(),
-		ProductPrice: product.GetPrice(),
-		Quantity:     int64(product.GetQuantity()),
+		ProductPrice: productPrice(product),
+		Quantity:     int64(product.GetAvailableQuantity()),
+		TaxClass:     product.GetCategory().GetTaxClass(),
 		CreatedAt:    product.CreatedAt.AsTime(),
 		UpdatedAt:    product.UpdatedAt.AsTime(),
 	}
@@ -44,12 +62,150 @@ func (c *InventoryClient) GetProduct(ctx context.Context, productID entity.UUID)
 	return entity, nil
 }
 
-func (c *InventoryClient) ReserveItem(ctx context.Context, itemID entity.UUID, quantity int64) (bool, error) {
-	// TODO: Make it to be a worker that collects requests and sends a combined request once in a second, instead of constant updating
-	_, err := c.client.ReserveProducts(ctx, &ReserveProductRequest{
-		Id:       itemID.String(),
-		Quantity: int32(quantity),
-	})
+// productPrice prefers the exact price and falls back to the deprecated float
+// for inventory servers that do not send it yet.
+func productPrice(product *Product) money.Money {
+	if price := product.GetPriceMoney(); price != nil {
+		return money.New(price.GetMinorUnits(), price.GetCurrencyCode())
+	}
+	return money.FromFloat(product.GetPrice(), entity.DefaultCurrency)
+}
+
+func (c *InventoryClient) ReserveItem(ctx context.Context, orderID, itemID entity.UUID, quantity int64) (bool, error) {
+	err := c.ReserveItems(ctx, orderID, []entity.OrderItem{{ProductID: itemID, Quantity: quantity}})
+	if err != nil {
+		if errors.Is(err, entity.ErrInsufficientQuantity) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReserveItems holds stock for all items of the order atomically: on error
+// nothing is held. With batching enabled the items travel together with
+// other callers' items in one BatchReserveProducts call.
+func (c *InventoryClient) ReserveItems(ctx context.Context, orderID entity.UUID, items []entity.OrderItem) error {
+	if c.batcher != nil {
+		return c.batcher.Reserve(ctx, orderID, items)
+	}
+	return c.batchReserve(ctx, []ReservationHold{{OrderID: orderID, Items: items}})
+}
+
+func (c *InventoryClient) batchReserve(ctx context.Context, holds []ReservationHold) error {
+	req := &BatchReserveProductsRequest{}
+	for _, hold := range holds {
+		for _, item := range hold.Items {
+			line := &ReservationLine{
+				OrderId:   hold.OrderID.String(),
+				ProductId: item.ProductID.String(),
+				Quantity:  int32(item.Quantity),
+			}
+			if item.ExpectedPrice != nil {
+				line.ExpectedPrice = &Money{
+					MinorUnits:   item.ExpectedPrice.Amount,
+					CurrencyCode: item.ExpectedPrice.Currency,
+				}
+			}
+			req.Items = append(req.Items, line)
+		}
+	}
+
+	_, err := c.client.BatchReserveProducts(ctx, req)
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			return err
+		}
+		switch st.Code() {
+		case codes.FailedPrecondition:
+			if mismatch := priceMismatchFromStatus(st); mismatch != nil {
+				return mismatch
+			}
+			return fmt.Errorf("%w: %s", entity.ErrInsufficientQuantity, st.Message())
+		case codes.NotFound:
+			return fmt.Errorf("%w: %s", entity.ErrItemNotFound, st.Message())
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// priceMismatchFromStatus reads the PriceMismatchDetails inventory attaches
+// to a reservation rejected because prices changed.
+func priceMismatchFromStatus(st *status.Status) *entity.PriceMismatchError {
+	for _, detail := range st.Details() {
+		details, ok := detail.(*PriceMismatchDetails)
+		if !ok {
+			continue
+		}
+		err := &entity.PriceMismatchError{}
+		for _, m := range details.GetMismatches() {
+			err.Mismatches = append(err.Mismatches, entity.PriceMismatch{
+				ProductID: entity.UUID(m.GetProductId()),
+				Expected:  money.New(m.GetExpectedPrice().GetMinorUnits(), m.GetExpectedPrice().GetCurrencyCode()),
+				Current:   money.New(m.GetCurrentPrice().GetMinorUnits(), m.GetCurrentPrice().GetCurrencyCode()),
+			})
+		}
+		return err
+	}
+	return nil
+}
+
+// ConfirmReservation turns the order's held stock into a permanent deduction.
+func (c *InventoryClient) ConfirmReservation(ctx context.Context, orderID entity.UUID) error {
+	_, err := c.client.ConfirmReservation(ctx, &ConfirmReservationRequest{OrderId: orderID.String()})
+	return mapReservationError(err)
+}
+
+// ReleaseReservation gives the order's held stock back.
+func (c *InventoryClient) ReleaseReservation(ctx context.Context, orderID entity.UUID) error {
+	_, err := c.client.ReleaseReservation(ctx, &ReleaseReservationRequest{OrderId: orderID.String()})
+	return mapReservationError(err)
+}
 
-	return true, err
+func mapReservationError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", entity.ErrReservationNotFound, st.Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", entity.ErrReservationExpired, st.Message())
+	default:
+		return err
+	}
+}
+
+// ReleaseItems returns the items' stock to inventory. Stock still held for
+// the order is released from its hold and the rest is credited back. A
+// release for more than the order took is rejected with
+// entity.ErrReleaseExceedsTaken.
+func (c *InventoryClient) ReleaseItems(ctx context.Context, releaseID string, orderID entity.UUID, items []entity.OrderItem) error {
+	req := &ReleaseProductRequest{
+		ReleaseId: releaseID,
+		OrderId:   orderID.String(),
+		Items:     make([]*ReservationLine, 0, len(items)),
+	}
+	for _, item := range items {
+		req.Items = append(req.Items, &ReservationLine{
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+			OrderId:   orderID.String(),
+		})
+	}
+
+	_, err := c.client.ReleaseProducts(ctx, req)
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", entity.ErrReleaseExceedsTaken, st.Message())
+	}
+	return err
 }
@@ -0,0 +1,67 @@
+package invoices
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// templateRenderer renders invoices from the templates embedded in the
+// binary: templates/invoice.html.tmpl and templates/invoice.txt.tmpl. Both
+// get the *entity.Invoice.
+type templateRenderer struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+func NewTemplateRenderer() (ports.InvoiceRenderer, error) {
+	html, err := htmltemplate.New("invoice.html.tmpl").Funcs(htmltemplate.FuncMap(funcs)).ParseFS(templates, "templates/invoice.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the HTML invoice template: %w", err)
+	}
+	text, err := texttemplate.New("invoice.txt.tmpl").Funcs(funcs).ParseFS(templates, "templates/invoice.txt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the text invoice template: %w", err)
+	}
+	return &templateRenderer{html: html, text: text}, nil
+}
+
+func (r *templateRenderer) RenderHTML(invoice *entity.Invoice) (string, error) {
+	var buf bytes.Buffer
+	if err := r.html.Execute(&buf, invoice); err != nil {
+		return "", fmt.Errorf("failed to render invoice %s as HTML: %w", invoice.Number, err)
+	}
+	return buf.String(), nil
+}
+
+func (r *templateRenderer) RenderText(invoice *entity.Invoice) (string, error) {
+	var buf bytes.Buffer
+	if err := r.text.Execute(&buf, invoice); err != nil {
+		return "", fmt.Errorf("failed to render invoice %s as text: %w", invoice.Number, err)
+	}
+	return buf.String(), nil
+}
+
+var funcs = texttemplate.FuncMap{
+	"rate": formatRate,
+}
+
+// formatRate formats a tax rate in thousandths of a percent: 7250 is
+// "7.25%".
+func formatRate(rate int64) string {
+	s := strconv.FormatInt(rate/1000, 10)
+	if frac := rate % 1000; frac != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%03d", frac), "0")
+	}
+	return s + "%"
+}
@@ -0,0 +1,137 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/ExonegeS/money"
+)
+
+// Payment methods with a scripted outcome in FakeProvider. Any other method
+// is authorized and captured.
+const (
+	FakeMethodDecline      = "fake_decline"
+	FakeMethodCaptureError = "fake_capture_error"
+)
+
+// FakeProvider is an in-memory PaymentProvider for local runs and tests. Its
+// outcomes depend only on the payment method, and references are derived
+// from payment IDs, so the same calls always give the same results.
+type FakeProvider struct {
+	mu      sync.Mutex
+	intents map[string]*fakeIntent
+}
+
+type fakeIntent struct {
+	amount   money.Money
+	method   string
+	status   entity.PaymentStatus
+	captured money.Money
+	refunds  map[entity.UUID]money.Money
+	refunded money.Money
+}
+
+func NewFakeProvider() ports.PaymentProvider {
+	return &FakeProvider{intents: make(map[string]*fakeIntent)}
+}
+
+func (p *FakeProvider) CreateIntent(ctx context.Context, payment entity.Payment) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	reference := "fake_pi_" + payment.ID.String()
+	if _, ok := p.intents[reference]; !ok {
+		p.intents[reference] = &fakeIntent{
+			amount: payment.Amount,
+			method: payment.Method,
+			status: entity.PaymentStatusPending,
+		}
+	}
+	return reference, nil
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, reference string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[reference]
+	if !ok {
+		return fmt.Errorf("fake provider: unknown intent %s", reference)
+	}
+	switch intent.status {
+	case entity.PaymentStatusAuthorized, entity.PaymentStatusCaptured:
+		return nil
+	case entity.PaymentStatusFailed:
+		return fmt.Errorf("%w: intent %s was declined", entity.ErrPaymentDeclined, reference)
+	}
+
+	if intent.method == FakeMethodDecline {
+		intent.status = entity.PaymentStatusFailed
+		return fmt.Errorf("%w: card declined", entity.ErrPaymentDeclined)
+	}
+	intent.status = entity.PaymentStatusAuthorized
+	return nil
+}
+
+func (p *FakeProvider) Capture(ctx context.Context, reference string, amount money.Money) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[reference]
+	if !ok {
+		return fmt.Errorf("fake provider: unknown intent %s", reference)
+	}
+	if intent.status == entity.PaymentStatusCaptured {
+		return nil
+	}
+	if intent.status != entity.PaymentStatusAuthorized {
+		return fmt.Errorf("fake provider: intent %s is %s, not authorized", reference, intent.status)
+	}
+	if cmp, err := amount.Compare(intent.amount); err != nil {
+		return fmt.Errorf("fake provider: %w", err)
+	} else if cmp > 0 {
+		return fmt.Errorf("fake provider: capture of %v exceeds authorized %v", amount, intent.amount)
+	}
+	if intent.method == FakeMethodCaptureError {
+		return fmt.Errorf("fake provider: capture of %s failed", reference)
+	}
+
+	intent.status = entity.PaymentStatusCaptured
+	intent.captured = amount
+	return nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, reference string, refundID entity.UUID, amount money.Money) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[reference]
+	if !ok {
+		return fmt.Errorf("fake provider: unknown intent %s", reference)
+	}
+	if intent.status != entity.PaymentStatusCaptured {
+		return fmt.Errorf("fake provider: intent %s is %s, not captured", reference, intent.status)
+	}
+	if _, ok := intent.refunds[refundID]; ok {
+		return nil
+	}
+	refunded, err := intent.refunded.Add(amount)
+	if err != nil {
+		return fmt.Errorf("fake provider: %w", err)
+	}
+	if cmp, err := refunded.Compare(intent.captured); err != nil {
+		return fmt.Errorf("fake provider: %w", err)
+	} else if cmp > 0 {
+		return fmt.Errorf("%w: refunding %v of %v with %v already refunded", entity.ErrRefundExceedsCaptured, amount, intent.captured, intent.refunded)
+	}
+
+	if intent.refunds == nil {
+		intent.refunds = make(map[entity.UUID]money.Money)
+	}
+	intent.refunds[refundID] = amount
+	intent.refunded = refunded
+	return nil
+}
@@ -0,0 +1,176 @@
+package tax
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// AnyRegion keys the rules of orders whose region has none of its own.
+const AnyRegion = "*"
+
+// Rules is the tax rules file:
+//
+//	{
+//	  "regions": {
+//	    "US-CA": {"rates": {"standard": "7.25"}},
+//	    "DE": {"prices_include_tax": true, "rates": {"standard": "19", "reduced": "7"}}
+//	  }
+//	}
+//
+// Rates are percentages with at most three decimals, keyed by the tax class
+// of the inventory category.
+type Rules struct {
+	Regions map[string]RegionRules `json:"regions"`
+}
+
+type RegionRules struct {
+	// PricesIncludeTax means product prices already contain the tax, so it
+	// is carved out of them rather than added.
+	PricesIncludeTax bool              `json:"prices_include_tax"`
+	Rates            map[string]string `json:"rates"`
+}
+
+type region struct {
+	inclusive bool
+	rates     map[string]int64
+}
+
+// RulesCalculator taxes orders by region and tax class. A region matches
+// exactly, then by its country ("US" for "US-CA"), then AnyRegion; a class
+// without a rate of its own pays the standard rate. Orders in a region
+// without rules are not taxed.
+type RulesCalculator struct {
+	regions map[string]region
+}
+
+// LoadRules reads a rules file. An empty path gives rules that tax nothing.
+func LoadRules(path string) (Rules, error) {
+	if path == "" {
+		return Rules{}, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Rules{}, err
+	}
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return Rules{}, fmt.Errorf("%w: %s: %w", entity.ErrInvalidTaxRules, path, err)
+	}
+	return rules, nil
+}
+
+func NewRulesCalculator(rules Rules) (ports.TaxCalculator, error) {
+	c := &RulesCalculator{regions: make(map[string]region, len(rules.Regions))}
+	for name, r := range rules.Regions {
+		parsed := region{inclusive: r.PricesIncludeTax, rates: make(map[string]int64, len(r.Rates))}
+		for class, rate := range r.Rates {
+			v, err := parseRate(rate)
+			if err != nil {
+				return nil, fmt.Errorf("%w: region %s class %s: %w", entity.ErrInvalidTaxRules, name, class, err)
+			}
+			parsed.rates[normalizeTaxClass(class)] = v
+		}
+		c.regions[strings.ToUpper(name)] = parsed
+	}
+	return c, nil
+}
+
+func (c *RulesCalculator) Calculate(ctx context.Context, order *entity.Order) ([]entity.TaxLine, error) {
+	name, r, ok := c.region(order.TaxRegion)
+	if !ok || len(order.Items) == 0 {
+		return nil, nil
+	}
+
+	subtotal, err := order.Subtotal()
+	if err != nil {
+		return nil, err
+	}
+	net, err := subtotal.Sub(order.DiscountTotal())
+	if err != nil {
+		return nil, err
+	}
+	// The discount is shared by the items in proportion to their value, so
+	// each is taxed on what it actually sold for.
+	weights := make([]int64, len(order.Items))
+	for i, item := range order.Items {
+		weights[i] = item.ProductPrice.Mul(item.Quantity).Amount
+	}
+	taxable := net.Allocate(weights...)
+
+	var lines []entity.TaxLine
+	for i, item := range order.Items {
+		class := normalizeTaxClass(item.TaxClass)
+		rate, ok := r.rates[class]
+		if !ok {
+			if rate, ok = r.rates[entity.StandardTaxClass]; !ok {
+				continue
+			}
+		}
+
+		line := entity.TaxLine{
+			ProductID: item.ProductID,
+			TaxClass:  class,
+			Region:    name,
+			Rate:      rate,
+			Taxable:   taxable[i],
+			Inclusive: r.inclusive,
+		}
+		// Every line is rounded on its own, so the order's tax is the sum
+		// of its lines.
+		if r.inclusive {
+			line.Amount = taxable[i].MulRate(rate, entity.TaxRateScale+rate)
+		} else {
+			line.Amount = taxable[i].MulRate(rate, entity.TaxRateScale)
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func (c *RulesCalculator) region(name string) (string, region, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	candidates := []string{name}
+	if country, _, ok := strings.Cut(name, "-"); ok {
+		candidates = append(candidates, country)
+	}
+	candidates = append(candidates, AnyRegion)
+
+	for _, candidate := range candidates {
+		if r, ok := c.regions[candidate]; ok && candidate != "" {
+			return candidate, r, true
+		}
+	}
+	return "", region{}, false
+}
+
+func normalizeTaxClass(class string) string {
+	class = strings.ToLower(strings.TrimSpace(class))
+	if class == "" {
+		return entity.StandardTaxClass
+	}
+	return class
+}
+
+// parseRate reads a percentage such as "8.875" in thousandths of a percent.
+func parseRate(s string) (int64, error) {
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if len(frac) > 3 {
+		return 0, fmt.Errorf("rate %q has more than three decimals", s)
+	}
+	frac += strings.Repeat("0", 3-len(frac))
+	v, err := strconv.ParseUint(whole+frac, 10, 63)
+	if err != nil || whole == "" {
+		return 0, fmt.Errorf("rate %q is not a percentage", s)
+	}
+	if v > 100000 {
+		return 0, fmt.Errorf("rate %q is over 100%%", s)
+	}
+	return int64(v), nil
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log/slog"
@@ -9,9 +10,15 @@ import (
 
 	grpc "github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/inbound/grpc"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/database"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/events"
 	inventory "github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/grpc/inventory"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/invoices"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/payments"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/tax"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/config"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
 )
 
 type APIServer struct {
@@ -32,13 +39,128 @@ func NewAPIServer(cfg config.Config, db *sql.DB, logger *slog.Logger) *APIServer
 
 func (s *APIServer) Run() error {
 	orderRepo := database.NewPostgresOrdersRepository(s.db)
+	sagaRepo := database.NewPostgresSagaRepository(s.db)
+	paymentsRepo := database.NewPostgresPaymentsRepository(s.db)
+	idempotencyRepo := database.NewPostgresIdempotencyRepository(s.db)
+	outboxRepo := database.NewPostgresOutboxRepository(s.db)
+	couponRepo := database.NewPostgresCouponRepository(s.db)
+	shipmentRepo := database.NewPostgresShipmentRepository(s.db)
+	returnRepo := database.NewPostgresReturnRepository(s.db)
+	cartRepo := database.NewPostgresCartRepository(s.db)
+	subscriptionRepo := database.NewPostgresSubscriptionRepository(s.db)
+	invoiceRepo := database.NewPostgresInvoiceRepository(s.db)
 
 	inventoryAddr := fmt.Sprintf("%s:%s", s.cfg.Clients["inventory client"].Address, s.cfg.Clients["inventory client"].GRPCPort)
-	inventoryClient, err := inventory.NewInventoryClient(inventoryAddr)
+	inventoryClient, err := inventory.NewInventoryClient(inventoryAddr, inventory.ReservationBatcherConfig{
+		MaxBatchSize:  s.cfg.Reservation.BatchSize,
+		FlushInterval: s.cfg.Reservation.FlushInterval,
+		Timeout:       s.cfg.Reservation.Timeout,
+	})
 	if err != nil {
 		return err
 	}
-	orderService := application.NewOrdersService(orderRepo, inventoryClient, time.Now)
+	defer inventoryClient.Close()
 
-	return grpc.StartGRPCServer(s.cfg.Server.GRPCPort, orderService, s.logger)
+	taxRules, err := tax.LoadRules(s.cfg.Tax.RulesFile)
+	if err != nil {
+		return err
+	}
+	taxCalculator, err := tax.NewRulesCalculator(taxRules)
+	if err != nil {
+		return err
+	}
+
+	invoiceRenderer, err := invoices.NewTemplateRenderer()
+	if err != nil {
+		return err
+	}
+
+	stateMachine := entity.NewOrderStateMachine()
+	stateMachine.OnTransition(func(ctx context.Context, order *entity.Order, t entity.StatusTransition) error {
+		s.logger.Info("Order status changed", "id", order.ID, "from", t.From, "to", t.To)
+		return nil
+	})
+
+	orderService := application.NewOrdersService(orderRepo, sagaRepo, couponRepo, taxCalculator, inventoryClient, stateMachine, time.Now)
+	// Only the fake provider exists so far; it keeps payments working offline.
+	paymentsService := application.NewPaymentsService(paymentsRepo, payments.NewFakeProvider(), orderService, inventoryClient, time.Now)
+	promotionsService := application.NewPromotionsService(couponRepo, time.Now)
+	shipmentsService := application.NewShipmentsService(shipmentRepo, orderService, time.Now)
+	returnsService := application.NewReturnsService(returnRepo, orderService, paymentsService, inventoryClient, time.Now)
+	cartsService := application.NewCartsService(cartRepo, orderService, inventoryClient, s.cfg.Cart.TTL, time.Now)
+	subscriptionsService := application.NewSubscriptionsService(subscriptionRepo, orderService, inventoryClient, time.Now)
+	invoicesService := application.NewInvoicesService(invoiceRepo, invoiceRenderer, time.Now)
+	stateMachine.OnEnterTask(entity.OrderStatusCancelled, entity.OrderTaskRefund)
+	orderService.HandleTask(entity.OrderTaskRefund, application.RefundOnCancel(paymentsService))
+	// Orders are invoiced here only: reading an invoice never issues one.
+	stateMachine.OnEnterTask(entity.OrderStatusCompleted, entity.OrderTaskIssueInvoice)
+	orderService.HandleTask(entity.OrderTaskIssueInvoice, application.IssueOnComplete(invoicesService))
+	idempotencyService := application.NewIdempotencyService(idempotencyRepo, s.cfg.Idempotency.Window, time.Now)
+	watcher := application.NewOrderWatcher(orderRepo, database.NewPostgresOrderEventsRepository(s.db), s.cfg.Watch.PollInterval, s.cfg.Events.BatchSize)
+
+	publisher, closePublisher, err := s.newEventPublisher()
+	if err != nil {
+		return err
+	}
+	defer closePublisher()
+
+	relay := application.NewOutboxRelay(outboxRepo, publisher, s.cfg.Events.RelayInterval, s.cfg.Events.BatchSize, s.logger)
+	go relay.Run(context.Background())
+	go s.purgeExpiredCarts(context.Background(), cartsService)
+	locker := database.NewPostgresLocker(s.db)
+	if s.cfg.UnpaidOrder.Timeout > 0 {
+		sweeper := application.NewUnpaidOrderSweeper(
+			orderService, paymentsService, locker,
+			s.cfg.UnpaidOrder.Timeout, s.cfg.UnpaidOrder.SweepInterval, s.cfg.UnpaidOrder.BatchSize, s.cfg.UnpaidOrder.DryRun,
+			time.Now, s.logger,
+		)
+		go sweeper.Run(context.Background())
+	}
+	taskRunner := application.NewOrderTaskRunner(orderService, s.cfg.OrderTask.RunInterval, s.cfg.OrderTask.BatchSize, s.logger)
+	go taskRunner.Run(context.Background())
+	scheduler := application.NewSubscriptionScheduler(subscriptionsService, locker, s.cfg.Subscription.SchedulerInterval, s.cfg.Subscription.BatchSize, s.logger)
+	go scheduler.Run(context.Background())
+	recoverer := application.NewSagaRecoverer(orderService, locker, s.cfg.Saga.RecoveryAge, s.cfg.Saga.RecoveryInterval, s.logger)
+	go recoverer.Run(context.Background())
+
+	return grpc.StartGRPCServer(s.cfg.Server.GRPCPort, orderService, paymentsService, promotionsService, shipmentsService, returnsService, cartsService, subscriptionsService, invoicesService, watcher, idempotencyService, s.logger)
+}
+
+// purgeExpiredCarts deletes expired carts every Cart.PurgeInterval until ctx
+// is cancelled.
+func (s *APIServer) purgeExpiredCarts(ctx context.Context, carts application.CartsService) {
+	ticker := time.NewTicker(s.cfg.Cart.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		deleted, err := carts.PurgeExpiredCarts(ctx)
+		if err != nil {
+			s.logger.Error("Failed to purge expired carts", "error", err)
+			continue
+		}
+		if deleted > 0 {
+			s.logger.Info("Purged expired carts", "count", deleted)
+		}
+	}
+}
+
+func (s *APIServer) newEventPublisher() (ports.EventPublisher, func() error, error) {
+	switch s.cfg.Events.Publisher {
+	case "memory":
+		return events.NewMemoryPublisher(), func() error { return nil }, nil
+	case "jsonl":
+		publisher, err := events.NewJSONLPublisher(s.cfg.Events.File)
+		if err != nil {
+			return nil, nil, err
+		}
+		return publisher, publisher.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown events publisher %q", s.cfg.Events.Publisher)
+	}
 }
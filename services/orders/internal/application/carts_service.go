@@ -0,0 +1,212 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CartsService interface {
+	GetCart(ctx context.Context, userID entity.UUID) (*entity.Cart, error)
+	AddCartItem(ctx context.Context, userID, productID entity.UUID, quantity int64) (*entity.Cart, error)
+	UpdateCartItem(ctx context.Context, userID, productID entity.UUID, quantity int64) (*entity.Cart, error)
+	RemoveCartItem(ctx context.Context, userID, productID entity.UUID) (*entity.Cart, error)
+	Checkout(ctx context.Context, userID entity.UUID, order *entity.Order) (*entity.Order, error)
+	PurgeExpiredCarts(ctx context.Context) (int64, error)
+}
+
+type cartsService struct {
+	cartRepo        ports.CartRepository
+	orders          OrdersService
+	inventoryClient ports.InventoryService
+	ttl             time.Duration
+	timeSource      func() time.Time
+}
+
+func NewCartsService(cartRepo ports.CartRepository, orders OrdersService, inventoryClient ports.InventoryService, ttl time.Duration, timeSource func() time.Time) CartsService {
+	return &cartsService{
+		cartRepo:        cartRepo,
+		orders:          orders,
+		inventoryClient: inventoryClient,
+		ttl:             ttl,
+		timeSource:      timeSource,
+	}
+}
+
+// GetCart returns the user's cart priced by inventory. A user without a
+// cart, or whose cart expired, gets an empty one.
+func (s *cartsService) GetCart(ctx context.Context, userID entity.UUID) (*entity.Cart, error) {
+	now := s.timeSource().UTC()
+	cart, err := s.cartRepo.GetCart(ctx, userID)
+	if errors.Is(err, entity.ErrCartNotFound) || (err == nil && cart.Expired(now)) {
+		return &entity.Cart{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.price(ctx, cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// AddCartItem adds quantity of a product, which inventory must have enough
+// of for everything the cart then holds.
+func (s *cartsService) AddCartItem(ctx context.Context, userID, productID entity.UUID, quantity int64) (*entity.Cart, error) {
+	if quantity <= 0 {
+		return nil, entity.ErrInvalidQuantity
+	}
+	product, err := s.product(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, userID, func(cart *entity.Cart, now time.Time) error {
+		if err := cart.AddItem(productID, quantity, now); err != nil {
+			return err
+		}
+		if item, _ := cart.Item(productID); item.Quantity > product.Quantity {
+			return entity.ErrInsufficientQuantity
+		}
+		return nil
+	})
+}
+
+// UpdateCartItem sets the quantity of a product already in the cart. Zero
+// removes it.
+func (s *cartsService) UpdateCartItem(ctx context.Context, userID, productID entity.UUID, quantity int64) (*entity.Cart, error) {
+	if quantity == 0 {
+		return s.RemoveCartItem(ctx, userID, productID)
+	}
+	if quantity < 0 {
+		return nil, entity.ErrInvalidQuantity
+	}
+	product, err := s.product(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if quantity > product.Quantity {
+		return nil, entity.ErrInsufficientQuantity
+	}
+
+	return s.update(ctx, userID, func(cart *entity.Cart, now time.Time) error {
+		return cart.SetItemQuantity(productID, quantity)
+	})
+}
+
+func (s *cartsService) RemoveCartItem(ctx context.Context, userID, productID entity.UUID) (*entity.Cart, error) {
+	return s.update(ctx, userID, func(cart *entity.Cart, now time.Time) error {
+		return cart.RemoveItem(productID)
+	})
+}
+
+// Checkout places the cart's items as an order through CreateOrder. order
+// carries everything else the order needs, such as the user's name and
+// addresses. The ordered items then leave the cart. Once the order is placed
+// it is returned even when an error comes with it, whether from the steps
+// CreateOrder left to recovery or from emptying the cart.
+func (s *cartsService) Checkout(ctx context.Context, userID entity.UUID, order *entity.Order) (*entity.Order, error) {
+	cart, err := s.cartRepo.GetCart(ctx, userID)
+	if errors.Is(err, entity.ErrCartNotFound) {
+		return nil, entity.ErrCartEmpty
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cart.Expired(s.timeSource().UTC()) || len(cart.Items) == 0 {
+		return nil, entity.ErrCartEmpty
+	}
+
+	order.UserID = userID
+	order.Items = make([]entity.OrderItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		order.Items = append(order.Items, entity.OrderItem{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+	// An order placed with errors still empties the cart, or a retry would
+	// order the same items again.
+	placeErr := s.orders.CreateOrder(ctx, order)
+	if placeErr != nil && !errors.Is(placeErr, entity.ErrOrderPlaced) {
+		return nil, placeErr
+	}
+
+	err = s.cartRepo.UpdateCart(ctx, userID, func(cart *entity.Cart) (bool, error) {
+		if cart.CreatedAt.IsZero() {
+			// Purged in the meantime.
+			return false, nil
+		}
+		cart.RemoveOrdered(order)
+		cart.UpdatedAt = s.timeSource().UTC()
+		return true, nil
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotUpdated) {
+		return order, errors.Join(placeErr, fmt.Errorf("order %s was placed, but the cart was not emptied: %w", order.ID, err))
+	}
+	return order, placeErr
+}
+
+// PurgeExpiredCarts deletes the carts that expired. They already read as
+// empty; this only reclaims their storage.
+func (s *cartsService) PurgeExpiredCarts(ctx context.Context) (int64, error) {
+	return s.cartRepo.DeleteExpiredCarts(ctx, s.timeSource().UTC())
+}
+
+// update changes the user's cart, starting a new one if it has none or it
+// expired, and returns it priced.
+func (s *cartsService) update(ctx context.Context, userID entity.UUID, fn func(cart *entity.Cart, now time.Time) error) (*entity.Cart, error) {
+	var cartData *entity.Cart
+	err := s.cartRepo.UpdateCart(ctx, userID, func(cart *entity.Cart) (bool, error) {
+		now := s.timeSource().UTC()
+		cart.UserID = userID
+		cart.Renew(s.ttl, now)
+		if err := fn(cart, now); err != nil {
+			return false, err
+		}
+		cartData = cart
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.price(ctx, cartData); err != nil {
+		return nil, err
+	}
+	return cartData, nil
+}
+
+// price fills in the items' names, prices and stock from inventory.
+func (s *cartsService) price(ctx context.Context, cart *entity.Cart) error {
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		product, err := s.product(ctx, item.ProductID)
+		if errors.Is(err, entity.ErrItemNotFound) {
+			item.Missing = true
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		item.ProductName = product.ProductName
+		item.UnitPrice = product.ProductPrice
+		item.AvailableQuantity = product.Quantity
+	}
+	return nil
+}
+
+// product looks the product up in inventory. Its Quantity is the stock
+// available to order.
+func (s *cartsService) product(ctx context.Context, productID entity.UUID) (*entity.OrderItem, error) {
+	product, err := s.inventoryClient.GetProduct(ctx, productID)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return nil, entity.ErrItemNotFound
+		}
+		return nil, err
+	}
+	return product, nil
+}
@@ -0,0 +1,109 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// idempotencyClaimTTL bounds how long a request that never finished, for
+// example because the process died, keeps its key from being retried. A
+// request still running renews its claim every idempotencyClaimRenewal, so a
+// slow one never loses its key to a retry.
+const (
+	idempotencyClaimTTL     = time.Minute
+	idempotencyClaimRenewal = idempotencyClaimTTL / 3
+)
+
+type IdempotencyService interface {
+	// Execute runs fn unless scope and key were already used within the
+	// replay window. A replay returns the stored response without calling fn,
+	// and a key reused with a different fingerprint fails with
+	// entity.ErrIdempotencyKeyReused. When fn fails without a response
+	// nothing is stored, so the request can be retried under the same key.
+	// When it fails with a response, the change it made stands: the response
+	// is stored for replays and fn's error returned.
+	Execute(ctx context.Context, scope, key, fingerprint string, fn func(ctx context.Context) ([]byte, error)) (response []byte, replayed bool, err error)
+}
+
+type idempotencyService struct {
+	repo       ports.IdempotencyRepository
+	window     time.Duration
+	timeSource func() time.Time
+}
+
+func NewIdempotencyService(repo ports.IdempotencyRepository, window time.Duration, timeSource func() time.Time) IdempotencyService {
+	return &idempotencyService{
+		repo:       repo,
+		window:     window,
+		timeSource: timeSource,
+	}
+}
+
+func (s *idempotencyService) Execute(ctx context.Context, scope, key, fingerprint string, fn func(ctx context.Context) ([]byte, error)) ([]byte, bool, error) {
+	now := s.timeSource().UTC()
+	existing, err := s.repo.ClaimIdempotencyKey(ctx, entity.IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(idempotencyClaimTTL),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		switch {
+		case existing.Fingerprint != fingerprint:
+			return nil, false, entity.ErrIdempotencyKeyReused
+		case !existing.Completed:
+			return nil, false, entity.ErrIdempotencyKeyInProgress
+		}
+		return existing.Response, true, nil
+	}
+
+	stopRenewing := s.renewClaim(ctx, scope, key)
+	response, err := fn(ctx)
+	stopRenewing()
+	if err != nil && response == nil {
+		// A claim that cannot be released still expires after
+		// idempotencyClaimTTL, so fn's error is the one worth returning.
+		_ = s.repo.ReleaseIdempotencyKey(ctx, scope, key)
+		return nil, false, err
+	}
+
+	expiresAt := s.timeSource().UTC().Add(s.window)
+	if storeErr := s.repo.CompleteIdempotencyKey(ctx, scope, key, response, expiresAt); storeErr != nil {
+		return response, false, errors.Join(err, fmt.Errorf("failed to store response for idempotency key %s: %w", key, storeErr))
+	}
+	return response, false, err
+}
+
+// renewClaim keeps the claim on key alive until the returned func is called.
+// A renewal that fails is retried on the next tick; the claim only lapses if
+// none gets through for idempotencyClaimTTL.
+func (s *idempotencyService) renewClaim(ctx context.Context, scope, key string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(idempotencyClaimRenewal)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = s.repo.RenewIdempotencyKey(ctx, scope, key, s.timeSource().UTC().Add(idempotencyClaimTTL))
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
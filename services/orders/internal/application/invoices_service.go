@@ -0,0 +1,85 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+type InvoicesService interface {
+	// IssueInvoice invoices a completed order. An order is invoiced once;
+	// issuing it again returns its invoice.
+	IssueInvoice(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error)
+	GetInvoice(ctx context.Context, id entity.UUID) (*entity.Invoice, error)
+	GetOrderInvoice(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error)
+	RenderInvoice(invoice *entity.Invoice) (*RenderedInvoice, error)
+}
+
+// RenderedInvoice is an invoice as documents for people to read.
+type RenderedInvoice struct {
+	HTML string
+	Text string
+}
+
+type invoicesService struct {
+	invoiceRepo ports.InvoiceRepository
+	renderer    ports.InvoiceRenderer
+	timeSource  func() time.Time
+}
+
+func NewInvoicesService(invoiceRepo ports.InvoiceRepository, renderer ports.InvoiceRenderer, timeSource func() time.Time) InvoicesService {
+	return &invoicesService{
+		invoiceRepo: invoiceRepo,
+		renderer:    renderer,
+		timeSource:  timeSource,
+	}
+}
+
+func (s *invoicesService) IssueInvoice(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error) {
+	return s.invoiceRepo.IssueInvoice(ctx, orderID, func(order *entity.Order) (*entity.Invoice, error) {
+		return entity.NewInvoice(order, s.timeSource().UTC())
+	})
+}
+
+// IssueOnComplete handles the invoice tasks of completed orders. Issuing is
+// safe to repeat, as an order is invoiced once. An order that moved on before
+// its task ran can no longer be invoiced, so the task fails for good.
+func IssueOnComplete(invoices InvoicesService) OrderTaskHandler {
+	return func(ctx context.Context, order *entity.Order, _ entity.OrderTask) error {
+		_, err := invoices.IssueInvoice(ctx, order.ID)
+		if errors.Is(err, entity.ErrOrderNotInvoiceable) {
+			return fmt.Errorf("%w: %w", entity.ErrTaskNotRetryable, err)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to invoice completed order: %w", err)
+		}
+		return nil
+	}
+}
+
+func (s *invoicesService) GetInvoice(ctx context.Context, id entity.UUID) (*entity.Invoice, error) {
+	return s.invoiceRepo.GetInvoiceByID(ctx, id)
+}
+
+// GetOrderInvoice returns the order's invoice, or fails with
+// entity.ErrInvoiceNotFound when it has none. Orders are invoiced only when
+// they complete, never when their invoice is read.
+func (s *invoicesService) GetOrderInvoice(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error) {
+	return s.invoiceRepo.GetInvoiceByOrderID(ctx, orderID)
+}
+
+func (s *invoicesService) RenderInvoice(invoice *entity.Invoice) (*RenderedInvoice, error) {
+	html, err := s.renderer.RenderHTML(invoice)
+	if err != nil {
+		return nil, err
+	}
+	text, err := s.renderer.RenderText(invoice)
+	if err != nil {
+		return nil, err
+	}
+	return &RenderedInvoice{HTML: html, Text: text}, nil
+}
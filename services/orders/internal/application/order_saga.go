@@ -0,0 +1,216 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+// reserveItems runs the forward step of the saga: all lines are held for the
+// order in one atomic inventory call. The steps are already stored as
+// pending, so a crash during the call leaves a trace of it.
+//
+// Each line carries the price the order was built on: the client's quote if
+// it sent one, otherwise the price read while pricing the order. Inventory
+// refuses the lines whose price has since moved outside its tolerance.
+func (s *ordersService) reserveItems(ctx context.Context, saga *entity.OrderSaga, order *entity.Order) error {
+	prices := make(map[entity.UUID]money.Money, len(order.Items))
+	for _, item := range order.Items {
+		price := item.ProductPrice
+		if item.ExpectedPrice != nil {
+			price = *item.ExpectedPrice
+		}
+		prices[item.ProductID] = price
+	}
+
+	items := make([]entity.OrderItem, 0, len(saga.Steps))
+	for _, step := range saga.Steps {
+		item := entity.OrderItem{
+			ProductID: step.ProductID,
+			Quantity:  step.Quantity,
+		}
+		if price, ok := prices[step.ProductID]; ok {
+			item.ExpectedPrice = &price
+		}
+		items = append(items, item)
+	}
+
+	if err := s.inventoryClient.ReserveItems(ctx, saga.OrderID, items); err != nil {
+		// Only a rejection proves nothing was held. Any other error leaves the
+		// steps pending, so compensation releases whatever inventory holds.
+		if reservationRejected(err) {
+			for i := range saga.Steps {
+				s.setStepStatus(ctx, saga, &saga.Steps[i], entity.SagaStepFailed)
+			}
+		}
+		return err
+	}
+
+	for i := range saga.Steps {
+		if err := s.setStepStatus(ctx, saga, &saga.Steps[i], entity.SagaStepReserved); err != nil {
+			// The reservation exists but we could not record it. The step is
+			// kept in memory as reserved so compensation still gives it back.
+			for j := i + 1; j < len(saga.Steps); j++ {
+				saga.Steps[j].Status = entity.SagaStepReserved
+			}
+			return fmt.Errorf("failed to record reservation: %w", err)
+		}
+	}
+	return nil
+}
+
+// reservationRejected reports whether inventory refused a reservation, which
+// proves it holds nothing for it.
+func reservationRejected(err error) bool {
+	return errors.Is(err, entity.ErrInsufficientQuantity) || errors.Is(err, entity.ErrItemNotFound) || errors.Is(err, entity.ErrPriceMismatch)
+}
+
+// compensate releases the stock held for the saga's order. It runs detached
+// from the caller's context so a cancelled request does not leave stock held.
+// When the release fails the saga stays in the compensating state and is
+// retried by RecoverSagas; holds that are never released expire on their own.
+func (s *ordersService) compensate(ctx context.Context, saga *entity.OrderSaga, cause error) error {
+	ctx = context.WithoutCancel(ctx)
+
+	reason := ""
+	if cause != nil {
+		reason = cause.Error()
+	}
+	saga.Status = entity.SagaStatusCompensating
+	saga.Error = reason
+	if err := s.sagaRepo.UpdateSagaStatus(ctx, saga.OrderID, saga.Status, reason); err != nil {
+		return fmt.Errorf("failed to mark saga as compensating: %w", err)
+	}
+
+	held := false
+	for _, step := range saga.Steps {
+		// A pending step may have been held before a crash. Releasing by
+		// order only returns what inventory actually holds, so it is safe.
+		if step.Status == entity.SagaStepReserved || step.Status == entity.SagaStepPending {
+			held = true
+		}
+	}
+	if held {
+		err := s.inventoryClient.ReleaseReservation(ctx, saga.OrderID)
+		if err != nil && !errors.Is(err, entity.ErrReservationNotFound) {
+			return fmt.Errorf("failed to release reservation: %w", err)
+		}
+	}
+
+	var errs []error
+	for i := range saga.Steps {
+		step := &saga.Steps[i]
+		switch step.Status {
+		case entity.SagaStepReserved:
+			if err := s.setStepStatus(ctx, saga, step, entity.SagaStepReleased); err != nil {
+				errs = append(errs, err)
+			}
+		case entity.SagaStepPending:
+			if err := s.setStepStatus(ctx, saga, step, entity.SagaStepFailed); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	saga.Status = entity.SagaStatusCompensated
+	return s.sagaRepo.UpdateSagaStatus(ctx, saga.OrderID, saga.Status, reason)
+}
+
+// RecoverSagas finishes sagas left behind by a crash. Only sagas that have
+// not moved for olderThan are touched, so those still running here or on
+// another replica are left to finish. A saga whose order was persisted has
+// its reservation confirmed and is completed; if the holds expired first, the
+// order is cancelled and the saga compensated. Every other saga is
+// compensated.
+func (s *ordersService) RecoverSagas(ctx context.Context, olderThan time.Duration) error {
+	sagas, err := s.sagaRepo.GetUnfinishedSagas(ctx, s.timeSource().UTC().Add(-olderThan))
+	if err != nil {
+		return fmt.Errorf("failed to load unfinished sagas: %w", err)
+	}
+
+	var errs []error
+	for _, saga := range sagas {
+		if saga.Status == entity.SagaStatusStarted {
+			_, err := s.ordersRepo.GetOrderByID(ctx, saga.OrderID)
+			if err == nil {
+				err := s.inventoryClient.ConfirmReservation(ctx, saga.OrderID)
+				if errors.Is(err, entity.ErrReservationExpired) {
+					if err := s.cancelUnreserved(ctx, saga, err); err != nil {
+						errs = append(errs, fmt.Errorf("saga %s: %w", saga.OrderID, err))
+					}
+					continue
+				}
+				if err != nil {
+					errs = append(errs, fmt.Errorf("saga %s: failed to confirm reservation: %w", saga.OrderID, err))
+					continue
+				}
+				if err := s.sagaRepo.UpdateSagaStatus(ctx, saga.OrderID, entity.SagaStatusCompleted, ""); err != nil {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			if !errors.Is(err, entity.ErrOrderNotFound) {
+				errs = append(errs, err)
+				continue
+			}
+		}
+
+		reason := saga.Error
+		if reason == "" {
+			reason = "interrupted before completion"
+		}
+		if err := s.compensate(ctx, saga, errors.New(reason)); err != nil {
+			errs = append(errs, fmt.Errorf("saga %s: %w", saga.OrderID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// cancelUnreserved cancels the order of a saga whose holds expired before
+// they were confirmed, as its stock is no longer set aside for it, and then
+// compensates the saga. The order is cancelled first, so a saga whose order
+// could not be cancelled stays started and is recovered again.
+func (s *ordersService) cancelUnreserved(ctx context.Context, saga *entity.OrderSaga, cause error) error {
+	cancelled := entity.OrderStatusCancelled
+	order, err := s.UpdateOrder(WithActor(ctx, entity.ActorSystem), saga.OrderID, UpdateOrderParams{
+		Status: &cancelled,
+		Reason: "stock reservation expired before it was confirmed",
+	})
+	if order == nil {
+		return fmt.Errorf("failed to cancel order with expired reservation: %w", err)
+	}
+	// The order is cancelled even when its tasks failed; they are retried.
+	return errors.Join(err, s.compensate(ctx, saga, cause))
+}
+
+func (s *ordersService) setStepStatus(ctx context.Context, saga *entity.OrderSaga, step *entity.SagaStep, status entity.SagaStepStatus) error {
+	step.Status = status
+	step.UpdatedAt = s.timeSource().UTC()
+	return s.sagaRepo.UpdateSagaStep(ctx, saga.OrderID, *step)
+}
+
+// mergeOrderItems folds repeated product lines into one, so every product
+// is reserved and stored once per order.
+func mergeOrderItems(items []entity.OrderItem) []entity.OrderItem {
+	merged := make([]entity.OrderItem, 0, len(items))
+	index := make(map[entity.UUID]int, len(items))
+	for _, item := range items {
+		if i, ok := index[item.ProductID]; ok {
+			merged[i].Quantity += item.Quantity
+			if merged[i].ExpectedPrice == nil {
+				merged[i].ExpectedPrice = item.ExpectedPrice
+			}
+			continue
+		}
+		index[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
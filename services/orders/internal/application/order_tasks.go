@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+const (
+	// orderTaskRetryDelay is how long a failed task waits before its first
+	// retry. Each further failure doubles the wait, up to orderTaskMaxDelay.
+	orderTaskRetryDelay = 30 * time.Second
+	orderTaskMaxDelay   = time.Hour
+)
+
+// OrderTaskHandler does the work of a task. It may run more than once for
+// the same task, so it must be safe to repeat. Errors wrapping
+// entity.ErrTaskNotRetryable fail the task for good; any other error has it
+// retried later.
+type OrderTaskHandler func(ctx context.Context, order *entity.Order, task entity.OrderTask) error
+
+// HandleTask registers the handler of tasks of kind. Tasks without a handler
+// stay pending.
+func (s *ordersService) HandleTask(kind entity.OrderTaskKind, handler OrderTaskHandler) {
+	s.taskHandlers[kind] = handler
+}
+
+// RunDueTasks runs up to limit tasks whose time has come and returns how
+// many it ran, including those that failed again.
+func (s *ordersService) RunDueTasks(ctx context.Context, limit int) (int, error) {
+	tasks, err := s.ordersRepo.GetOrderTasks(ctx, entity.OrderTaskFilter{DueBy: s.timeSource().UTC(), Limit: limit})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due order tasks: %w", err)
+	}
+	ran := 0
+	var errs []error
+	for _, task := range tasks {
+		err := s.runTask(ctx, task.ID)
+		if errors.Is(err, entity.ErrNotUpdated) {
+			continue
+		}
+		ran++
+		if err != nil {
+			errs = append(errs, fmt.Errorf("task %s: %w", task.ID, err))
+		}
+	}
+	return ran, errors.Join(errs...)
+}
+
+// runOrderTasks runs every pending task of the order, due or not, as a
+// caller retrying a change of the order expects its work done now.
+func (s *ordersService) runOrderTasks(ctx context.Context, orderID entity.UUID) error {
+	tasks, err := s.ordersRepo.GetOrderTasks(ctx, entity.OrderTaskFilter{OrderID: orderID})
+	if err != nil {
+		return fmt.Errorf("failed to list order tasks: %w", err)
+	}
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return s.runTasks(ctx, ids)
+}
+
+// runTasks runs the tasks with ids and joins their errors. Tasks already
+// done, or being run elsewhere, are skipped.
+func (s *ordersService) runTasks(ctx context.Context, ids []string) error {
+	var errs []error
+	for _, id := range ids {
+		if err := s.runTask(ctx, id); err != nil && !errors.Is(err, entity.ErrNotUpdated) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runTask runs the task with id once and records how it went. It returns
+// the handler's error, if any, once the failure is stored.
+func (s *ordersService) runTask(ctx context.Context, id string) error {
+	var runErr error
+	err := s.ordersRepo.RunOrderTask(ctx, id, func(order *entity.Order, task *entity.OrderTask) {
+		now := s.timeSource().UTC()
+		handler, ok := s.taskHandlers[task.Kind]
+		if !ok {
+			runErr = fmt.Errorf("no handler for %s tasks", task.Kind)
+		} else {
+			runErr = handler(ctx, order, *task)
+		}
+		if runErr != nil {
+			task.Fail(runErr, orderTaskBackoff(task.Attempts), now)
+			return
+		}
+		task.Complete(now)
+	})
+	if err != nil {
+		return err
+	}
+	return runErr
+}
+
+// orderTaskBackoff is how long a task that failed attempts times so far
+// waits before its next attempt.
+func orderTaskBackoff(attempts int) time.Duration {
+	delay := orderTaskRetryDelay
+	for i := 0; i < attempts && delay < orderTaskMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, orderTaskMaxDelay)
+}
+
+// OrderTaskRunner periodically runs the order tasks that are due. Replicas
+// share the tasks without a lock, as the repository hands each task to one
+// runner at a time.
+type OrderTaskRunner struct {
+	orders    OrdersService
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewOrderTaskRunner(orders OrdersService, interval time.Duration, batchSize int, logger *slog.Logger) *OrderTaskRunner {
+	return &OrderTaskRunner{
+		orders:    orders,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run runs due tasks until ctx is cancelled.
+func (r *OrderTaskRunner) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ran, err := r.orders.RunDueTasks(ctx, r.batchSize)
+		if err != nil {
+			r.logger.Error("Order tasks failed", "ran", ran, "error", err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// OrderWatcher follows the events of one order. It reads them straight from
+// the outbox, so a watcher sees a change as soon as it commits, without
+// waiting for the relay, and can resume after any event it has received.
+type OrderWatcher struct {
+	orders       ports.OrdersRepository
+	events       ports.OrderEventsRepository
+	pollInterval time.Duration
+	batchSize    int
+}
+
+func NewOrderWatcher(orders ports.OrdersRepository, events ports.OrderEventsRepository, pollInterval time.Duration, batchSize int) *OrderWatcher {
+	return &OrderWatcher{
+		orders:       orders,
+		events:       events,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+	}
+}
+
+// Watch passes the events of order id to send until ctx is cancelled, send
+// fails or the order is deleted. Without a resume token it starts with an
+// order.snapshot of the current order; with one it continues right after the
+// event the token belongs to.
+func (w *OrderWatcher) Watch(ctx context.Context, id entity.UUID, resumeToken string, send func(*entity.OrderEvent) error) error {
+	var after int64
+	gone := false
+	if resumeToken != "" {
+		seq, err := entity.ParseResumeToken(resumeToken)
+		if err != nil {
+			return err
+		}
+		after = seq
+
+		if _, err := w.orders.GetOrderByID(ctx, id); err != nil {
+			if !errors.Is(err, entity.ErrOrderNotFound) {
+				return err
+			}
+			// The order may have been deleted while the watcher was away;
+			// it still gets the events it missed, the deletion included.
+			gone = true
+		}
+	} else {
+		// The snapshot resumes from the order's own newest event, read along
+		// with it, so it holds every change up to that event and none after.
+		order, seq, err := w.events.GetOrderSnapshot(ctx, id)
+		if err != nil {
+			return err
+		}
+		after = seq
+
+		err = send(&entity.OrderEvent{
+			Seq:        seq,
+			OrderID:    id,
+			Type:       entity.EventOrderSnapshot,
+			Order:      order,
+			OccurredAt: order.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := w.events.ListOrderEvents(ctx, id, after, w.batchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+			after = event.Seq
+			if event.Type == entity.EventOrderDeleted {
+				return nil
+			}
+		}
+		if len(events) == w.batchSize {
+			continue
+		}
+		if gone {
+			return entity.ErrOrderNotFound
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -2,10 +2,15 @@ package application
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
 	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/ExonegeS/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -14,42 +19,126 @@ type OrdersService interface {
 	GetOrderByID(ctx context.Context, id entity.UUID) (*entity.Order, error)
 	CreateOrder(ctx context.Context, order *entity.Order) error
 	UpdateOrder(ctx context.Context, id entity.UUID, params UpdateOrderParams) (*entity.Order, error)
+	EditOrderItems(ctx context.Context, id entity.UUID, params EditOrderItemsParams) (*entity.Order, error)
 	DeleteOrder(ctx context.Context, id entity.UUID) (*entity.Order, error)
-	GetPaginatedOrders(ctx context.Context, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Order], error)
+	CancelOrder(ctx context.Context, id entity.UUID, reason string) (*entity.Order, error)
+	GetOrderHistory(ctx context.Context, id entity.UUID) ([]entity.StatusTransition, error)
+	GetPaginatedOrders(ctx context.Context, filter entity.OrderFilter, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Order], error)
+
+	RecoverSagas(ctx context.Context, olderThan time.Duration) error
+
+	HandleTask(kind entity.OrderTaskKind, handler OrderTaskHandler)
+	RunDueTasks(ctx context.Context, limit int) (int, error)
 }
 
 type UpdateOrderParams struct {
-	Name        *string
-	Description *string
-	CategoryID  *entity.UUID
-	Price       *float64
-	Quantity    *float64
-	Unit        *string
+	UserName *string
+	Status   *entity.OrderStatus
+	// Reason is recorded in the status history with a status change.
+	Reason string
+	// ExpectedStatus, if set, is the status the order must still be in for
+	// the update to apply; otherwise it fails with entity.ErrStatusChanged.
+	ExpectedStatus *entity.OrderStatus
+}
+
+type EditOrderItemsParams struct {
+	// Items is the full list of items the order should have. Products left
+	// out are removed from it.
+	Items []entity.OrderItem
+	// Reason is recorded in the status history with the edit.
+	Reason string
 }
 
 type ordersService struct {
 	ordersRepo      ports.OrdersRepository
+	sagaRepo        ports.OrderSagaRepository
+	couponRepo      ports.CouponRepository
+	taxCalculator   ports.TaxCalculator
 	inventoryClient ports.InventoryService
+	stateMachine    *entity.OrderStateMachine
+	taskHandlers    map[entity.OrderTaskKind]OrderTaskHandler
 	timeSource      func() time.Time
 }
 
-func NewOrdersService(ordersRepo ports.OrdersRepository, inventoryClient ports.InventoryService, timeSource func() time.Time) OrdersService {
-	return &ordersService{
+func NewOrdersService(ordersRepo ports.OrdersRepository, sagaRepo ports.OrderSagaRepository, couponRepo ports.CouponRepository, taxCalculator ports.TaxCalculator, inventoryClient ports.InventoryService, stateMachine *entity.OrderStateMachine, timeSource func() time.Time) OrdersService {
+	if stateMachine == nil {
+		stateMachine = entity.NewOrderStateMachine()
+	}
+	s := &ordersService{
 		ordersRepo:      ordersRepo,
+		sagaRepo:        sagaRepo,
+		couponRepo:      couponRepo,
+		taxCalculator:   taxCalculator,
 		inventoryClient: inventoryClient,
+		stateMachine:    stateMachine,
+		taskHandlers:    make(map[entity.OrderTaskKind]OrderTaskHandler),
 		timeSource:      timeSource,
 	}
+	stateMachine.OnEnterTask(entity.OrderStatusCancelled, entity.OrderTaskReturnStock)
+	s.HandleTask(entity.OrderTaskReturnStock, func(ctx context.Context, order *entity.Order, _ entity.OrderTask) error {
+		err := s.returnStock(ctx, order)
+		if errors.Is(err, entity.ErrReleaseExceedsTaken) {
+			return fmt.Errorf("%w: %w", entity.ErrTaskNotRetryable, err)
+		}
+		return err
+	})
+	s.HandleTask(entity.OrderTaskReleaseStock, func(ctx context.Context, order *entity.Order, task entity.OrderTask) error {
+		releaseID := "edit:" + order.ID.String() + ":" + task.Ref
+		err := s.inventoryClient.ReleaseItems(ctx, releaseID, order.ID, task.Items)
+		if errors.Is(err, entity.ErrReleaseExceedsTaken) {
+			return fmt.Errorf("%w: %w", entity.ErrTaskNotRetryable, err)
+		}
+		return err
+	})
+	return s
 }
 
 func (s *ordersService) GetOrderByID(ctx context.Context, id entity.UUID) (*entity.Order, error) {
 	return s.ordersRepo.GetOrderByID(ctx, id)
 }
 
+// GetOrderHistory lists the status changes of an order, oldest first,
+// starting with its creation.
+func (s *ordersService) GetOrderHistory(ctx context.Context, id entity.UUID) ([]entity.StatusTransition, error) {
+	return s.ordersRepo.GetOrderStatusHistory(ctx, id)
+}
+
 func (s *ordersService) CreateOrder(ctx context.Context, order *entity.Order) error {
-	if order == nil {
+	if order == nil || len(order.Items) == 0 {
 		return entity.ErrInvalidRequestPayload
 	}
-	for _, item := range order.Items {
+
+	now := s.timeSource().UTC()
+	order.ID = entity.NewUUID()
+	order.Status = entity.OrderStatusPending
+	order.Items = mergeOrderItems(order.Items)
+	order.TotalAmount = money.Money{}
+	order.Discounts = nil
+	order.TaxLines = nil
+	order.CreatedAt = now
+	order.UpdatedAt = now
+	order.StatusHistory = []entity.StatusTransition{{
+		OrderID: order.ID,
+		To:      entity.OrderStatusPending,
+		At:      now,
+		Actor:   ActorFromContext(ctx),
+		Reason:  "order created",
+	}}
+
+	for _, address := range []*entity.Address{order.ShippingAddress, order.BillingAddress} {
+		if address == nil {
+			continue
+		}
+		if err := address.Validate(); err != nil {
+			return err
+		}
+	}
+	if order.TaxRegion == "" && order.ShippingAddress != nil {
+		order.TaxRegion = order.ShippingAddress.TaxRegion()
+	}
+
+	for i := range order.Items {
+		item := &order.Items[i]
 		if item.Quantity <= 0 {
 			return entity.ErrInvalidQuantity
 		}
@@ -70,25 +159,424 @@ func (s *ordersService) CreateOrder(ctx context.Context, order *entity.Order) er
 		if item.Quantity > product.Quantity {
 			return entity.ErrInsufficientQuantity
 		}
-		ok, err := s.inventoryClient.ReserveItem(ctx, item.ProductID, item.Quantity)
+
+		item.ProductName = product.ProductName
+		item.ProductPrice = product.ProductPrice
+		item.TaxClass = product.TaxClass
+		item.CreatedAt = now
+		item.UpdatedAt = now
+		// An order is paid in one currency, so every item must be priced in it.
+		order.TotalAmount, err = order.TotalAmount.Add(product.ProductPrice.Mul(item.Quantity))
 		if err != nil {
-			return err
+			return fmt.Errorf("%w: %w", entity.ErrInvalidRequestPayload, err)
 		}
-		if !ok {
-			return entity.ErrInsufficientQuantity
+	}
+
+	if err := s.applyCoupon(ctx, order, now); err != nil {
+		return err
+	}
+	if err := s.applyTax(ctx, order); err != nil {
+		return err
+	}
+
+	saga := entity.NewOrderSaga(order, now)
+	if err := s.sagaRepo.CreateSaga(ctx, *saga); err != nil {
+		return fmt.Errorf("failed to start order saga: %w", err)
+	}
+
+	if err := s.reserveItems(ctx, saga, order); err != nil {
+		s.compensate(ctx, saga, err)
+		return err
+	}
+
+	if err := s.ordersRepo.SaveOrder(ctx, *order); err != nil {
+		err = fmt.Errorf("failed to save order: %w", err)
+		s.compensate(ctx, saga, err)
+		return err
+	}
+
+	if err := s.inventoryClient.ConfirmReservation(ctx, order.ID); err != nil {
+		// The order is persisted, so the saga stays started and recovery
+		// retries the confirmation.
+		s.sagaRepo.UpdateSagaStatus(ctx, saga.OrderID, entity.SagaStatusStarted, err.Error())
+		return fmt.Errorf("%w, but its stock reservation was not confirmed: %w", entity.ErrOrderPlaced, err)
+	}
+
+	if err := s.sagaRepo.UpdateSagaStatus(ctx, saga.OrderID, entity.SagaStatusCompleted, ""); err != nil {
+		// The order is persisted, so recovery will see it and only close the saga.
+		return fmt.Errorf("%w, but its saga was not completed: %w", entity.ErrOrderPlaced, err)
+	}
+	saga.Status = entity.SagaStatusCompleted
+
+	return nil
+}
+
+// applyCoupon takes the discount of the order's coupon, if it has one, off
+// its total. The redemption limits are checked here so a spent coupon fails
+// before stock is reserved, and again when the order is saved.
+func (s *ordersService) applyCoupon(ctx context.Context, order *entity.Order, now time.Time) error {
+	order.CouponCode = entity.NormalizeCouponCode(order.CouponCode)
+	if order.CouponCode == "" {
+		return nil
+	}
+
+	coupon, err := s.couponRepo.GetCouponByCode(ctx, order.CouponCode)
+	if err != nil {
+		return err
+	}
+	discount, err := coupon.Apply(order.TotalAmount, now)
+	if err != nil {
+		return err
+	}
+	used, err := s.couponRepo.CountUserRedemptions(ctx, coupon.ID, order.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to count coupon redemptions: %w", err)
+	}
+	if err := coupon.CheckUsage(used); err != nil {
+		return err
+	}
+
+	if order.TotalAmount, err = order.TotalAmount.Sub(discount.Amount); err != nil {
+		return err
+	}
+	order.Discounts = []entity.OrderDiscount{discount}
+	return nil
+}
+
+// applyTax records the order's tax lines and adds the tax its prices do not
+// include to the total.
+func (s *ordersService) applyTax(ctx context.Context, order *entity.Order) error {
+	if s.taxCalculator == nil {
+		return nil
+	}
+	lines, err := s.taxCalculator.Calculate(ctx, order)
+	if err != nil {
+		return fmt.Errorf("failed to calculate tax: %w", err)
+	}
+	for _, line := range lines {
+		if line.Inclusive {
+			continue
+		}
+		if order.TotalAmount, err = order.TotalAmount.Add(line.Amount); err != nil {
+			return err
 		}
 	}
-	return entity.ErrNotImplemented
+	order.TaxLines = lines
+	return nil
 }
 
+// UpdateOrder applies the changed fields. A status change goes through the
+// state machine; the tasks it leaves are stored with the order and, like its
+// hooks, run once the order is stored. Tasks that fail are retried later.
 func (s *ordersService) UpdateOrder(ctx context.Context, id entity.UUID, params UpdateOrderParams) (*entity.Order, error) {
-	return nil, entity.ErrNotImplemented
+	var orderData *entity.Order
+	var transition *entity.StatusTransition
+	var taskIDs []string
+
+	err := s.ordersRepo.UpdateOrderByID(ctx, id, func(order *entity.Order) (updated bool, err error) {
+		now := s.timeSource().UTC()
+
+		if params.ExpectedStatus != nil && order.Status != *params.ExpectedStatus {
+			return false, fmt.Errorf("%w: order is %s", entity.ErrStatusChanged, order.Status)
+		}
+
+		if params.UserName != nil && *params.UserName != order.UserName {
+			order.UserName = *params.UserName
+			updated = true
+		}
+
+		if params.Status != nil && *params.Status != order.Status {
+			cause := entity.TransitionCause{Actor: ActorFromContext(ctx), Reason: params.Reason}
+			t, err := s.stateMachine.Transition(order, *params.Status, cause, now)
+			if err != nil {
+				return false, err
+			}
+			transition = &t
+			taskIDs = nil
+			for _, task := range order.Tasks {
+				taskIDs = append(taskIDs, task.ID)
+			}
+			updated = true
+		}
+
+		if !updated {
+			return
+		}
+
+		order.UpdatedAt = now
+		orderData = order
+		return
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return s.ordersRepo.GetOrderByID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if transition != nil {
+		err := errors.Join(s.runTasks(ctx, taskIDs), s.stateMachine.RunHooks(ctx, orderData, *transition))
+		if err != nil {
+			return orderData, fmt.Errorf("order %s is %s, but its hooks failed: %w", id, transition.To, err)
+		}
+	}
+
+	return orderData, nil
+}
+
+// EditOrderItems replaces the items of a pending or processing order. Stock
+// for what the edit adds is reserved before the order changes, so an edit
+// inventory rejects leaves the order as it was; stock the edit takes off goes
+// back through a task stored with the edit, so it is retried until inventory
+// has it. Lines already on the order keep their price,
+// new ones are priced by inventory, and the discounts and tax are worked out
+// again for the new subtotal. An edit that would take the subtotal below a
+// coupon's minimum fails with entity.ErrCouponNotApplicable, and one that
+// changes the total of an order with a payment that has not failed is
+// refused by the repository, as the payment is for the old total.
+func (s *ordersService) EditOrderItems(ctx context.Context, id entity.UUID, params EditOrderItemsParams) (*entity.Order, error) {
+	if len(params.Items) == 0 {
+		return nil, entity.ErrInvalidRequestPayload
+	}
+
+	order, err := s.ordersRepo.GetOrderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !order.Editable() {
+		return nil, fmt.Errorf("%w: order is %s", entity.ErrOrderNotEditable, order.Status)
+	}
+
+	items, err := s.editedItems(ctx, order, params.Items)
+	if err != nil {
+		return nil, err
+	}
+	changes := entity.DiffItems(order.Items, items)
+	if len(changes) == 0 {
+		return order, nil
+	}
+	reserve, release := entity.StockDeltas(changes)
+
+	if len(reserve) > 0 {
+		// Whatever the order still holds is confirmed first, so a rollback
+		// releases only what the edit held.
+		if err := s.inventoryClient.ConfirmReservation(ctx, id); err != nil && !errors.Is(err, entity.ErrReservationNotFound) {
+			return nil, fmt.Errorf("failed to confirm stock reservation: %w", err)
+		}
+		if err := s.inventoryClient.ReserveItems(ctx, id, reserve); err != nil {
+			// Only a rejection proves nothing was held.
+			if !reservationRejected(err) {
+				return nil, errors.Join(err, s.rollbackEdit(ctx, id))
+			}
+			return nil, err
+		}
+	}
+
+	var orderData *entity.Order
+	var releaseTask string
+	err = s.ordersRepo.UpdateOrderByID(ctx, id, func(order *entity.Order) (bool, error) {
+		// The stock was reserved for the items read above, so they must not
+		// have changed since.
+		if !slices.Equal(entity.DiffItems(order.Items, items), changes) {
+			return false, entity.ErrOrderEditConflict
+		}
+
+		now := s.timeSource().UTC()
+		cause := entity.TransitionCause{Actor: ActorFromContext(ctx), Reason: params.Reason}
+		if err := order.EditItems(items, changes, cause, now); err != nil {
+			return false, err
+		}
+		if err := s.reprice(ctx, order); err != nil {
+			return false, err
+		}
+		releaseTask = ""
+		if len(release) > 0 {
+			task := entity.NewOrderTask(order.ID, entity.OrderTaskReleaseStock, strconv.Itoa(order.EditVersion), now)
+			task.Items = release
+			order.Tasks = append(order.Tasks, task)
+			releaseTask = task.ID
+		}
+		orderData = order
+		return true, nil
+	})
+	if err != nil {
+		if len(reserve) > 0 {
+			return nil, errors.Join(err, s.rollbackEdit(ctx, id))
+		}
+		return nil, err
+	}
+
+	if len(reserve) > 0 {
+		if err := s.inventoryClient.ConfirmReservation(ctx, id); err != nil {
+			return orderData, fmt.Errorf("order %s is edited, but its added stock was not confirmed: %w", id, err)
+		}
+	}
+	if releaseTask != "" {
+		if err := s.runTasks(ctx, []string{releaseTask}); err != nil {
+			return orderData, fmt.Errorf("order %s is edited, but its removed stock was not returned: %w", id, err)
+		}
+	}
+	return orderData, nil
 }
 
+// editedItems merges and checks the items of an edit. Products already on
+// the order keep the line they have; the others are priced by inventory,
+// which must price them in the order's currency. Every product's tax class
+// is read from inventory, as the order does not store it.
+func (s *ordersService) editedItems(ctx context.Context, order *entity.Order, requested []entity.OrderItem) ([]entity.OrderItem, error) {
+	current := make(map[entity.UUID]entity.OrderItem, len(order.Items))
+	for _, item := range order.Items {
+		current[item.ProductID] = item
+	}
+
+	now := s.timeSource().UTC()
+	items := mergeOrderItems(requested)
+	for i := range items {
+		item := &items[i]
+		if item.Quantity <= 0 {
+			return nil, entity.ErrInvalidQuantity
+		}
+		product, err := s.inventoryClient.GetProduct(ctx, item.ProductID)
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+				return nil, entity.ErrItemNotFound
+			}
+			return nil, err
+		}
+		item.TaxClass = product.TaxClass
+
+		if line, ok := current[item.ProductID]; ok {
+			item.ProductName = line.ProductName
+			item.ProductPrice = line.ProductPrice
+			item.CreatedAt = line.CreatedAt
+			item.UpdatedAt = line.UpdatedAt
+			if line.Quantity != item.Quantity {
+				item.UpdatedAt = now
+			}
+			continue
+		}
+
+		if product.ProductPrice.Currency != order.TotalAmount.Currency {
+			return nil, fmt.Errorf("%w: product %s is priced in %s, not %s", entity.ErrInvalidRequestPayload, item.ProductID, product.ProductPrice.Currency, order.TotalAmount.Currency)
+		}
+		item.ProductName = product.ProductName
+		item.ProductPrice = product.ProductPrice
+		item.CreatedAt = now
+		item.UpdatedAt = now
+	}
+	return items, nil
+}
+
+// reprice works out the order's total again from its items, the coupons it
+// was placed with, on their terms at the time, and its tax region.
+func (s *ordersService) reprice(ctx context.Context, order *entity.Order) error {
+	subtotal, err := order.Subtotal()
+	if err != nil {
+		return err
+	}
+	order.TotalAmount = subtotal
+	for i := range order.Discounts {
+		discount := &order.Discounts[i]
+		if err := discount.Reprice(subtotal); err != nil {
+			return err
+		}
+		if order.TotalAmount, err = order.TotalAmount.Sub(discount.Amount); err != nil {
+			return err
+		}
+	}
+	order.TaxLines = nil
+	return s.applyTax(ctx, order)
+}
+
+// rollbackEdit releases the stock held for an edit that was not stored. It
+// runs detached from the caller's context so a cancelled request does not
+// leave stock held; holds that are never released expire on their own.
+func (s *ordersService) rollbackEdit(ctx context.Context, orderID entity.UUID) error {
+	err := s.inventoryClient.ReleaseReservation(context.WithoutCancel(ctx), orderID)
+	if err != nil && !errors.Is(err, entity.ErrReservationNotFound) {
+		return fmt.Errorf("failed to release stock held for the edit: %w", err)
+	}
+	return nil
+}
+
+// CancelOrder cancels the order, which returns its stock and refunds it
+// through the tasks of cancelled orders. Cancelling an order that is already
+// cancelled runs whatever of that work is still pending, so a client can
+// safely retry a cancel that failed halfway.
+func (s *ordersService) CancelOrder(ctx context.Context, id entity.UUID, reason string) (*entity.Order, error) {
+	order, err := s.ordersRepo.GetOrderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status == entity.OrderStatusCancelled {
+		if err := s.runOrderTasks(ctx, id); err != nil {
+			return order, fmt.Errorf("order %s is cancelled, but its tasks failed: %w", id, err)
+		}
+		return order, nil
+	}
+
+	cancelled := entity.OrderStatusCancelled
+	return s.UpdateOrder(ctx, id, UpdateOrderParams{Status: &cancelled, Reason: reason})
+}
+
+func (s *ordersService) returnStock(ctx context.Context, order *entity.Order) error {
+	if len(order.Items) == 0 {
+		return nil
+	}
+	return s.inventoryClient.ReleaseItems(ctx, "cancel:"+order.ID.String(), order.ID, order.Items)
+}
+
+// DeleteOrder removes a cancelled order. Its stock is returned first, again
+// if need be, so deleting never strands stock held for it. Orders with
+// payments are kept for their records; the repository refuses to delete them.
 func (s *ordersService) DeleteOrder(ctx context.Context, id entity.UUID) (*entity.Order, error) {
-	return nil, entity.ErrNotImplemented
+	order, err := s.ordersRepo.GetOrderByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.Status != entity.OrderStatusCancelled {
+		return nil, fmt.Errorf("%w: order is %s", entity.ErrOrderNotDeletable, order.Status)
+	}
+	if err := s.returnStock(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to return stock before deleting: %w", err)
+	}
+	if err := s.ordersRepo.DeleteOrderByID(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrOrderNotFound) || errors.Is(err, entity.ErrOrderNotDeletable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to delete order: %w", err)
+	}
+	return order, nil
 }
 
-func (s *ordersService) GetPaginatedOrders(ctx context.Context, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Order], error) {
-	return nil, entity.ErrNotImplemented
+func (s *ordersService) GetPaginatedOrders(ctx context.Context, filter entity.OrderFilter, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Order], error) {
+	const op = "ordersService.GetPaginatedOrders"
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	totalOrders, err := s.ordersRepo.GetTotalOrdersCount(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	totalPages := (totalOrders + pagination.PageSize - 1) / pagination.PageSize
+
+	orders, err := s.ordersRepo.GetAllOrders(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &entity.PaginationResponse[*entity.Order]{
+		CurrentPage: pagination.Page,
+		HasNextPage: pagination.Page < totalPages,
+		PageSize:    pagination.PageSize,
+		TotalPages:  totalPages,
+		Data:        orders,
+	}, nil
 }
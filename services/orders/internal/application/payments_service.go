@@ -0,0 +1,479 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/ExonegeS/money"
+)
+
+// refundSettleTime is how long a refund may stay pending before RefundOrder
+// takes it for one whose request died before it was settled, and settles it.
+// Refunds younger than that may still be on their way to the provider.
+const refundSettleTime = 10 * time.Minute
+
+type PaymentsService interface {
+	CreatePaymentIntent(ctx context.Context, orderID entity.UUID, method, currency string) (*entity.Payment, error)
+	AuthorizePayment(ctx context.Context, id entity.UUID) (*entity.Payment, error)
+	CapturePayment(ctx context.Context, id entity.UUID) (*entity.Payment, error)
+	GetPayment(ctx context.Context, id entity.UUID) (*entity.Payment, error)
+	ListOrderPayments(ctx context.Context, orderID entity.UUID) ([]*entity.Payment, error)
+	RefundOrder(ctx context.Context, orderID entity.UUID, params RefundParams) (*entity.Payment, error)
+}
+
+// RefundParams selects what RefundOrder gives back. Lines only need
+// ProductID and Quantity; no lines refunds everything not refunded yet.
+type RefundParams struct {
+	Lines   []entity.RefundLine
+	Restock bool
+}
+
+type paymentsService struct {
+	paymentsRepo    ports.PaymentsRepository
+	provider        ports.PaymentProvider
+	orders          OrdersService
+	inventoryClient ports.InventoryService
+	timeSource      func() time.Time
+}
+
+func NewPaymentsService(paymentsRepo ports.PaymentsRepository, provider ports.PaymentProvider, orders OrdersService, inventoryClient ports.InventoryService, timeSource func() time.Time) PaymentsService {
+	return &paymentsService{
+		paymentsRepo:    paymentsRepo,
+		provider:        provider,
+		orders:          orders,
+		inventoryClient: inventoryClient,
+		timeSource:      timeSource,
+	}
+}
+
+// CreatePaymentIntent starts a payment for the order's total, in the order's
+// currency. A currency, if given, must match it. An order has at most one
+// payment that has not failed; asking again returns that payment instead of
+// opening a second one.
+func (s *paymentsService) CreatePaymentIntent(ctx context.Context, orderID entity.UUID, method, currency string) (*entity.Payment, error) {
+	if method == "" {
+		return nil, entity.ErrInvalidRequestPayload
+	}
+
+	order, err := s.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if currency != "" && !strings.EqualFold(currency, order.TotalAmount.Currency) {
+		return nil, fmt.Errorf("%w: order is priced in %s, not %s", entity.ErrInvalidRequestPayload, order.TotalAmount.Currency, strings.ToUpper(currency))
+	}
+
+	payments, err := s.paymentsRepo.GetPaymentsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if findCapturedCharge(payments) != nil {
+		return nil, entity.ErrOrderAlreadyPaid
+	}
+	if order.Status != entity.OrderStatusPending {
+		return nil, fmt.Errorf("%w: order is %s", entity.ErrOrderNotPayable, order.Status)
+	}
+	for _, payment := range payments {
+		if payment.Kind == entity.PaymentKindCharge && payment.IsActive() {
+			return payment, nil
+		}
+	}
+
+	now := s.timeSource().UTC()
+	payment := entity.Payment{
+		ID:        entity.NewUUID(),
+		OrderID:   order.ID,
+		Kind:      entity.PaymentKindCharge,
+		Amount:    order.TotalAmount,
+		Method:    method,
+		Status:    entity.PaymentStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	reference, err := s.provider.CreateIntent(ctx, payment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+	payment.ProviderReference = reference
+
+	if err := s.paymentsRepo.SavePayment(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to save payment: %w", err)
+	}
+	return &payment, nil
+}
+
+// AuthorizePayment asks the provider to authorize the payment. A decline is
+// recorded on the payment and returned as entity.ErrPaymentDeclined; any other
+// provider error leaves the payment pending so the call can be retried.
+func (s *paymentsService) AuthorizePayment(ctx context.Context, id entity.UUID) (*entity.Payment, error) {
+	payment, err := s.paymentsRepo.GetPaymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != entity.PaymentStatusPending {
+		if payment.Status == entity.PaymentStatusFailed {
+			return nil, fmt.Errorf("%w: payment is %s", entity.ErrInvalidPaymentTransition, payment.Status)
+		}
+		return payment, nil
+	}
+
+	if err := s.provider.Authorize(ctx, payment.ProviderReference); err != nil {
+		if !errors.Is(err, entity.ErrPaymentDeclined) {
+			return nil, fmt.Errorf("failed to authorize payment: %w", err)
+		}
+		failed, updateErr := s.transition(ctx, id, entity.PaymentStatusFailed, err.Error())
+		if updateErr != nil {
+			return nil, errors.Join(err, updateErr)
+		}
+		return failed, err
+	}
+
+	return s.transition(ctx, id, entity.PaymentStatusAuthorized, "")
+}
+
+// CapturePayment captures an authorized payment and moves its order to
+// processing. Capturing an already captured payment only retries the order
+// transition, so a capture that failed halfway can be repeated.
+func (s *paymentsService) CapturePayment(ctx context.Context, id entity.UUID) (*entity.Payment, error) {
+	payment, err := s.paymentsRepo.GetPaymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch payment.Status {
+	case entity.PaymentStatusAuthorized:
+		order, err := s.orders.GetOrderByID(ctx, payment.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status != entity.OrderStatusPending && order.Status != entity.OrderStatusProcessing {
+			return nil, fmt.Errorf("%w: order is %s", entity.ErrOrderNotPayable, order.Status)
+		}
+
+		if err := s.provider.Capture(ctx, payment.ProviderReference, payment.Amount); err != nil {
+			return nil, fmt.Errorf("failed to capture payment: %w", err)
+		}
+		payment, err = s.transition(ctx, id, entity.PaymentStatusCaptured, "")
+		if err != nil {
+			return nil, err
+		}
+	case entity.PaymentStatusCaptured:
+	default:
+		return nil, fmt.Errorf("%w: payment is %s", entity.ErrInvalidPaymentTransition, payment.Status)
+	}
+
+	processing := entity.OrderStatusProcessing
+	if _, err := s.orders.UpdateOrder(ctx, payment.OrderID, UpdateOrderParams{Status: &processing, Reason: "payment captured"}); err != nil {
+		return payment, fmt.Errorf("payment %s is captured, but order %s was not moved to processing: %w", id, payment.OrderID, err)
+	}
+	return payment, nil
+}
+
+func (s *paymentsService) GetPayment(ctx context.Context, id entity.UUID) (*entity.Payment, error) {
+	return s.paymentsRepo.GetPaymentByID(ctx, id)
+}
+
+func (s *paymentsService) ListOrderPayments(ctx context.Context, orderID entity.UUID) ([]*entity.Payment, error) {
+	if _, err := s.orders.GetOrderByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return s.paymentsRepo.GetPaymentsByOrderID(ctx, orderID)
+}
+
+// RefundOrder pays back part or all of the captured charge of a completed or
+// cancelled order. The refund is recorded as its own payment and can never
+// take the refunded total above the captured amount. Once everything is
+// refunded a completed order moves to refunded; a cancelled one stays
+// cancelled. With params.Restock the refunded items also go back to
+// inventory, keyed by the refund so a retried restock is applied once. A
+// cancelled order's stock went back when it was cancelled, so it cannot be
+// restocked again. Refunds left pending by an earlier request are settled
+// first, so they do not hold back the amount that can still be refunded.
+func (s *paymentsService) RefundOrder(ctx context.Context, orderID entity.UUID, params RefundParams) (*entity.Payment, error) {
+	order, err := s.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	switch order.Status {
+	case entity.OrderStatusCompleted:
+	case entity.OrderStatusCancelled:
+		if params.Restock {
+			return nil, fmt.Errorf("%w: a cancelled order's stock was returned when it was cancelled", entity.ErrInvalidRequestPayload)
+		}
+	default:
+		return nil, fmt.Errorf("%w: order is %s", entity.ErrOrderNotRefundable, order.Status)
+	}
+	markRefunded := order.Status == entity.OrderStatusCompleted
+
+	payments, err := s.paymentsRepo.GetPaymentsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	charge := findCapturedCharge(payments)
+	if charge == nil {
+		return nil, entity.ErrOrderNotPaid
+	}
+	if err := s.settleStaleRefunds(ctx, charge, payments); err != nil {
+		return nil, err
+	}
+
+	var refund *entity.Payment
+	var fullyRefunded bool
+	err = s.paymentsRepo.CreateRefund(ctx, charge.ID, func(charge *entity.Payment, refunds []*entity.Payment) (*entity.Payment, error) {
+		lines, amount, full, err := planRefund(order, charge, refunds, params.Lines)
+		if err != nil {
+			return nil, err
+		}
+
+		now := s.timeSource().UTC()
+		refund = &entity.Payment{
+			ID:                entity.NewUUID(),
+			OrderID:           order.ID,
+			Kind:              entity.PaymentKindRefund,
+			ParentID:          charge.ID,
+			Lines:             lines,
+			Amount:            amount,
+			Method:            charge.Method,
+			Status:            entity.PaymentStatusPending,
+			ProviderReference: charge.ProviderReference,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+		fullyRefunded = full
+		return refund, nil
+	})
+	if errors.Is(err, entity.ErrNothingToRefund) && markRefunded {
+		// An earlier refund may have emptied the charge without getting to
+		// the order, so finish that transition now.
+		refunded := entity.OrderStatusRefunded
+		if _, updateErr := s.orders.UpdateOrder(ctx, orderID, UpdateOrderParams{Status: &refunded, Reason: "order fully refunded"}); updateErr != nil {
+			return nil, errors.Join(err, updateErr)
+		}
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.provider.Refund(ctx, charge.ProviderReference, refund.ID, refund.Amount); err != nil {
+		failed, updateErr := s.transition(ctx, refund.ID, entity.PaymentStatusFailed, err.Error())
+		if updateErr != nil {
+			return nil, errors.Join(err, updateErr)
+		}
+		return failed, fmt.Errorf("failed to refund payment: %w", err)
+	}
+	refund, err = s.transition(ctx, refund.ID, entity.PaymentStatusRefunded, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	if params.Restock {
+		items := make([]entity.OrderItem, 0, len(refund.Lines))
+		for _, line := range refund.Lines {
+			items = append(items, entity.OrderItem{ProductID: line.ProductID, Quantity: line.Quantity})
+		}
+		if err := s.inventoryClient.ReleaseItems(ctx, "refund:"+refund.ID.String(), order.ID, items); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restock refunded items: %w", err))
+		}
+	}
+	if fullyRefunded && markRefunded {
+		refunded := entity.OrderStatusRefunded
+		if _, err := s.orders.UpdateOrder(ctx, orderID, UpdateOrderParams{Status: &refunded, Reason: "order fully refunded"}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to mark order refunded: %w", err))
+		}
+	}
+	if len(errs) > 0 {
+		return refund, fmt.Errorf("refund %s was paid out, but: %w", refund.ID, errors.Join(errs...))
+	}
+	return refund, nil
+}
+
+// settleStaleRefunds settles the refunds of charge that have been pending
+// for refundSettleTime, as their request died before the provider's answer
+// was stored. Each is sent to the provider again under its own ID, so one
+// that was paid out is not paid twice; it is refunded if the provider accepts
+// it and failed otherwise. Whether a stale refund asked for a restock is not
+// recorded, so settling one never restocks.
+func (s *paymentsService) settleStaleRefunds(ctx context.Context, charge *entity.Payment, payments []*entity.Payment) error {
+	staleBefore := s.timeSource().UTC().Add(-refundSettleTime)
+	for _, refund := range payments {
+		if refund.ParentID != charge.ID || refund.Status != entity.PaymentStatusPending || !refund.CreatedAt.Before(staleBefore) {
+			continue
+		}
+		next, reason := entity.PaymentStatusRefunded, ""
+		if err := s.provider.Refund(ctx, charge.ProviderReference, refund.ID, refund.Amount); err != nil {
+			next, reason = entity.PaymentStatusFailed, err.Error()
+		}
+		if _, err := s.transition(ctx, refund.ID, next, reason); err != nil {
+			return fmt.Errorf("failed to settle pending refund %s: %w", refund.ID, err)
+		}
+	}
+	return nil
+}
+
+// RefundOnCancel handles the refund tasks of cancelled orders, giving back
+// their captured charge in full. Orders that were never charged, or whose
+// charge is already refunded, have nothing to give back, so the task is safe
+// to repeat.
+func RefundOnCancel(payments PaymentsService) OrderTaskHandler {
+	return func(ctx context.Context, order *entity.Order, _ entity.OrderTask) error {
+		_, err := payments.RefundOrder(ctx, order.ID, RefundParams{})
+		if errors.Is(err, entity.ErrOrderNotPaid) || errors.Is(err, entity.ErrNothingToRefund) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to refund cancelled order: %w", err)
+		}
+		return nil
+	}
+}
+
+// planRefund works out the lines and amount of a new refund against charge.
+// It also reports whether the refund leaves nothing of the charge to refund.
+func planRefund(order *entity.Order, charge *entity.Payment, refunds []*entity.Payment, requested []entity.RefundLine) ([]entity.RefundLine, money.Money, bool, error) {
+	refundedQuantity := make(map[entity.UUID]int64)
+	remaining := charge.Amount
+	for _, refund := range refunds {
+		if refund.Status == entity.PaymentStatusFailed {
+			continue
+		}
+		var err error
+		if remaining, err = remaining.Sub(refund.Amount); err != nil {
+			return nil, money.Money{}, false, err
+		}
+		for _, line := range refund.Lines {
+			refundedQuantity[line.ProductID] += line.Quantity
+		}
+	}
+	if !remaining.IsPositive() {
+		return nil, money.Money{}, false, entity.ErrNothingToRefund
+	}
+
+	items := make(map[entity.UUID]entity.OrderItem, len(order.Items))
+	for _, item := range order.Items {
+		items[item.ProductID] = item
+	}
+
+	var lines []entity.RefundLine
+	if len(requested) == 0 {
+		for _, item := range order.Items {
+			if left := item.Quantity - refundedQuantity[item.ProductID]; left > 0 {
+				lines = append(lines, entity.RefundLine{ProductID: item.ProductID, Quantity: left})
+			}
+		}
+	} else {
+		index := make(map[entity.UUID]int, len(requested))
+		for _, line := range requested {
+			if line.Quantity <= 0 {
+				return nil, money.Money{}, false, entity.ErrInvalidQuantity
+			}
+			if _, ok := items[line.ProductID]; !ok {
+				return nil, money.Money{}, false, fmt.Errorf("%w: product %s is not in the order", entity.ErrItemNotFound, line.ProductID)
+			}
+			if i, ok := index[line.ProductID]; ok {
+				lines[i].Quantity += line.Quantity
+				continue
+			}
+			index[line.ProductID] = len(lines)
+			lines = append(lines, entity.RefundLine{ProductID: line.ProductID, Quantity: line.Quantity})
+		}
+		for _, line := range lines {
+			if left := items[line.ProductID].Quantity - refundedQuantity[line.ProductID]; line.Quantity > left {
+				return nil, money.Money{}, false, fmt.Errorf("%w: product %s has %d left to refund", entity.ErrInvalidQuantity, line.ProductID, left)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return nil, money.Money{}, false, entity.ErrNothingToRefund
+	}
+
+	// A discount is spread over the lines in proportion to their value, so a
+	// line gives back what was paid for it rather than its list price.
+	subtotal, err := order.Subtotal()
+	if err != nil {
+		return nil, money.Money{}, false, err
+	}
+	paid, err := subtotal.Sub(order.DiscountTotal())
+	if err != nil {
+		return nil, money.Money{}, false, err
+	}
+
+	amount := money.New(0, remaining.Currency)
+	for i := range lines {
+		item := items[lines[i].ProductID]
+		lines[i].Amount = item.ProductPrice.Mul(lines[i].Quantity)
+		if paid != subtotal && subtotal.IsPositive() {
+			lines[i].Amount = lines[i].Amount.MulRate(paid.Amount, subtotal.Amount)
+		}
+		// Tax charged on top of the price is given back with its share of
+		// the item.
+		if tax := order.AddedTax(item.ProductID); tax.IsPositive() {
+			if lines[i].Amount, err = lines[i].Amount.Add(tax.MulRate(lines[i].Quantity, item.Quantity)); err != nil {
+				return nil, money.Money{}, false, err
+			}
+		}
+		if amount, err = amount.Add(lines[i].Amount); err != nil {
+			return nil, money.Money{}, false, err
+		}
+	}
+
+	cmp, err := amount.Compare(remaining)
+	if err != nil {
+		return nil, money.Money{}, false, err
+	}
+	if cmp > 0 {
+		if len(requested) > 0 {
+			return nil, money.Money{}, false, fmt.Errorf("%w: %v requested, %v left", entity.ErrRefundExceedsCaptured, amount, remaining)
+		}
+		// A full refund pays back whatever is left of the charge, split over
+		// the lines in proportion to their value.
+		weights := make([]int64, len(lines))
+		for i, line := range lines {
+			weights[i] = line.Amount.Amount
+		}
+		for i, share := range remaining.Allocate(weights...) {
+			lines[i].Amount = share
+		}
+		amount = remaining
+	}
+	return lines, amount, amount == remaining, nil
+}
+
+func findCapturedCharge(payments []*entity.Payment) *entity.Payment {
+	for _, payment := range payments {
+		if payment.Kind == entity.PaymentKindCharge && payment.Status == entity.PaymentStatusCaptured {
+			return payment
+		}
+	}
+	return nil
+}
+
+// transition moves the stored payment to next. Reaching a status the payment
+// already has is not an error, so concurrent callers settle on the same result.
+func (s *paymentsService) transition(ctx context.Context, id entity.UUID, next entity.PaymentStatus, reason string) (*entity.Payment, error) {
+	var paymentData *entity.Payment
+	err := s.paymentsRepo.UpdatePaymentByID(ctx, id, func(payment *entity.Payment) (bool, error) {
+		if payment.Status == next {
+			return false, nil
+		}
+		if err := payment.TransitionTo(next, reason, s.timeSource().UTC()); err != nil {
+			return false, err
+		}
+		paymentData = payment
+		return true, nil
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return s.paymentsRepo.GetPaymentByID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return paymentData, nil
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// PromotionsService manages coupons. Orders redeem them through
+// OrdersService.CreateOrder.
+type PromotionsService interface {
+	CreateCoupon(ctx context.Context, coupon *entity.Coupon) error
+	GetCoupon(ctx context.Context, code string) (*entity.Coupon, error)
+	ListCoupons(ctx context.Context, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Coupon], error)
+	DeactivateCoupon(ctx context.Context, id entity.UUID) (*entity.Coupon, error)
+}
+
+type promotionsService struct {
+	couponRepo ports.CouponRepository
+	timeSource func() time.Time
+}
+
+func NewPromotionsService(couponRepo ports.CouponRepository, timeSource func() time.Time) PromotionsService {
+	return &promotionsService{
+		couponRepo: couponRepo,
+		timeSource: timeSource,
+	}
+}
+
+func (s *promotionsService) CreateCoupon(ctx context.Context, coupon *entity.Coupon) error {
+	if coupon == nil {
+		return entity.ErrInvalidRequestPayload
+	}
+
+	now := s.timeSource().UTC()
+	coupon.ID = entity.NewUUID()
+	coupon.Code = entity.NormalizeCouponCode(coupon.Code)
+	coupon.Redemptions = 0
+	coupon.Active = true
+	coupon.CreatedAt = now
+	coupon.UpdatedAt = now
+	if err := coupon.Validate(); err != nil {
+		return err
+	}
+	return s.couponRepo.SaveCoupon(ctx, *coupon)
+}
+
+func (s *promotionsService) GetCoupon(ctx context.Context, code string) (*entity.Coupon, error) {
+	return s.couponRepo.GetCouponByCode(ctx, entity.NormalizeCouponCode(code))
+}
+
+func (s *promotionsService) ListCoupons(ctx context.Context, pagination *entity.Pagination) (*entity.PaginationResponse[*entity.Coupon], error) {
+	const op = "promotionsService.ListCoupons"
+
+	total, err := s.couponRepo.GetTotalCouponsCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	totalPages := (total + pagination.PageSize - 1) / pagination.PageSize
+
+	coupons, err := s.couponRepo.GetAllCoupons(ctx, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &entity.PaginationResponse[*entity.Coupon]{
+		CurrentPage: pagination.Page,
+		HasNextPage: pagination.Page < totalPages,
+		PageSize:    pagination.PageSize,
+		TotalPages:  totalPages,
+		Data:        coupons,
+	}, nil
+}
+
+// DeactivateCoupon stops the coupon from applying to new orders. Orders
+// already placed with it keep their discount.
+func (s *promotionsService) DeactivateCoupon(ctx context.Context, id entity.UUID) (*entity.Coupon, error) {
+	var couponData *entity.Coupon
+	err := s.couponRepo.UpdateCouponByID(ctx, id, func(coupon *entity.Coupon) (bool, error) {
+		if !coupon.Active {
+			return false, nil
+		}
+		coupon.Active = false
+		coupon.UpdatedAt = s.timeSource().UTC()
+		couponData = coupon
+		return true, nil
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return s.couponRepo.GetCouponByID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return couponData, nil
+}
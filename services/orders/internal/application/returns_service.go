@@ -0,0 +1,170 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+type ReturnsService interface {
+	RequestReturn(ctx context.Context, orderID entity.UUID, params RequestReturnParams) (*entity.Return, error)
+	ApproveReturn(ctx context.Context, id entity.UUID, reason string) (*entity.Return, error)
+	RejectReturn(ctx context.Context, id entity.UUID, reason string) (*entity.Return, error)
+	ReceiveReturn(ctx context.Context, id entity.UUID, conditions map[entity.UUID]entity.ItemCondition) (*entity.Return, error)
+	RefundReturn(ctx context.Context, id entity.UUID) (*entity.Return, error)
+	GetReturn(ctx context.Context, id entity.UUID) (*entity.Return, error)
+	ListOrderReturns(ctx context.Context, orderID entity.UUID) ([]*entity.Return, error)
+}
+
+// RequestReturnParams lists the order lines to send back. Items only need
+// ProductID, Quantity and Reason.
+type RequestReturnParams struct {
+	Items  []entity.ReturnItem
+	Reason string
+}
+
+type returnsService struct {
+	returnRepo      ports.ReturnRepository
+	orders          OrdersService
+	payments        PaymentsService
+	inventoryClient ports.InventoryService
+	timeSource      func() time.Time
+}
+
+func NewReturnsService(returnRepo ports.ReturnRepository, orders OrdersService, payments PaymentsService, inventoryClient ports.InventoryService, timeSource func() time.Time) ReturnsService {
+	return &returnsService{
+		returnRepo:      returnRepo,
+		orders:          orders,
+		payments:        payments,
+		inventoryClient: inventoryClient,
+		timeSource:      timeSource,
+	}
+}
+
+// RequestReturn opens a return of items of a completed order.
+func (s *returnsService) RequestReturn(ctx context.Context, orderID entity.UUID, params RequestReturnParams) (*entity.Return, error) {
+	cause := entity.TransitionCause{Actor: ActorFromContext(ctx), Reason: params.Reason}
+
+	var r *entity.Return
+	err := s.returnRepo.CreateReturn(ctx, orderID, func(order *entity.Order, returns []*entity.Return) (*entity.Return, error) {
+		var err error
+		r, err = entity.NewReturn(order, returns, params.Items, cause, s.timeSource().UTC())
+		return r, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *returnsService) ApproveReturn(ctx context.Context, id entity.UUID, reason string) (*entity.Return, error) {
+	return s.transition(ctx, id, entity.ReturnStatusApproved, reason)
+}
+
+func (s *returnsService) RejectReturn(ctx context.Context, id entity.UUID, reason string) (*entity.Return, error) {
+	return s.transition(ctx, id, entity.ReturnStatusRejected, reason)
+}
+
+// ReceiveReturn records the condition the items arrived in and puts the
+// resellable ones back in stock, keyed by the return so inventory applies
+// them once. Receiving a received return only retries the restock.
+func (s *returnsService) ReceiveReturn(ctx context.Context, id entity.UUID, conditions map[entity.UUID]entity.ItemCondition) (*entity.Return, error) {
+	cause := entity.TransitionCause{Actor: ActorFromContext(ctx)}
+
+	var r *entity.Return
+	err := s.returnRepo.UpdateReturnByID(ctx, id, func(order *entity.Order, ret *entity.Return) (bool, error) {
+		r = ret
+		if ret.Status == entity.ReturnStatusReceived {
+			return false, nil
+		}
+		if err := ret.Receive(order, conditions, cause, s.timeSource().UTC()); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotUpdated) {
+		return nil, err
+	}
+
+	if items := r.ResellableItems(); len(items) > 0 {
+		if err := s.inventoryClient.ReleaseItems(ctx, "return:"+r.ID.String(), r.OrderID, items); err != nil {
+			return r, fmt.Errorf("return %s is received, but its items were not restocked: %w", id, err)
+		}
+	}
+	return r, nil
+}
+
+// RefundReturn pays back the items of a received return through the order's
+// payments and marks the return refunded. A refund that failed with the
+// provider leaves the return received so it can be tried again.
+func (s *returnsService) RefundReturn(ctx context.Context, id entity.UUID) (*entity.Return, error) {
+	r, err := s.returnRepo.GetReturnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == entity.ReturnStatusRefunded {
+		return r, nil
+	}
+	if r.Status != entity.ReturnStatusReceived {
+		return nil, fmt.Errorf("%w: %s -> %s", entity.ErrInvalidReturnTransition, r.Status, entity.ReturnStatusRefunded)
+	}
+
+	refund, refundErr := s.payments.RefundOrder(ctx, r.OrderID, RefundParams{Lines: r.RefundLines()})
+	if refund == nil || refund.Status != entity.PaymentStatusRefunded {
+		return nil, refundErr
+	}
+
+	cause := entity.TransitionCause{Actor: ActorFromContext(ctx), Reason: "refund " + refund.ID.String()}
+	err = s.returnRepo.UpdateReturnByID(ctx, id, func(order *entity.Order, ret *entity.Return) (bool, error) {
+		if err := ret.TransitionTo(order, entity.ReturnStatusRefunded, cause, s.timeSource().UTC()); err != nil {
+			return false, err
+		}
+		ret.RefundID = refund.ID
+		r = ret
+		return true, nil
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("refund %s was paid out, but return %s was not marked refunded: %w", refund.ID, id, err), refundErr)
+	}
+	if refundErr != nil {
+		// The refund went through; only its follow-ups, such as moving the
+		// order to refunded, failed.
+		return r, refundErr
+	}
+	return r, nil
+}
+
+func (s *returnsService) GetReturn(ctx context.Context, id entity.UUID) (*entity.Return, error) {
+	return s.returnRepo.GetReturnByID(ctx, id)
+}
+
+func (s *returnsService) ListOrderReturns(ctx context.Context, orderID entity.UUID) ([]*entity.Return, error) {
+	if _, err := s.orders.GetOrderByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return s.returnRepo.GetReturnsByOrderID(ctx, orderID)
+}
+
+func (s *returnsService) transition(ctx context.Context, id entity.UUID, next entity.ReturnStatus, reason string) (*entity.Return, error) {
+	cause := entity.TransitionCause{Actor: ActorFromContext(ctx), Reason: reason}
+
+	var r *entity.Return
+	err := s.returnRepo.UpdateReturnByID(ctx, id, func(order *entity.Order, ret *entity.Return) (bool, error) {
+		r = ret
+		if ret.Status == next {
+			return false, nil
+		}
+		if err := ret.TransitionTo(order, next, cause, s.timeSource().UTC()); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotUpdated) {
+		return nil, err
+	}
+	return r, nil
+}
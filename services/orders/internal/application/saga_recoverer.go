@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// sagaRecoveryLock is the lock that lets one replica recover sagas at a time.
+const sagaRecoveryLock = "orders:saga-recovery"
+
+// SagaRecoverer periodically finishes the order sagas a crash left behind.
+// Sagas younger than minAge are left alone, as they may still be running.
+type SagaRecoverer struct {
+	orders   OrdersService
+	locker   ports.Locker
+	minAge   time.Duration
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewSagaRecoverer(orders OrdersService, locker ports.Locker, minAge, interval time.Duration, logger *slog.Logger) *SagaRecoverer {
+	return &SagaRecoverer{
+		orders:   orders,
+		locker:   locker,
+		minAge:   minAge,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run recovers at once and then every interval until ctx is cancelled.
+func (r *SagaRecoverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Recover(ctx); err != nil {
+			r.logger.Error("Failed to recover order sagas", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Recover finishes the unfinished sagas older than minAge. It does nothing
+// while another replica holds the recovery lock.
+func (r *SagaRecoverer) Recover(ctx context.Context) error {
+	release, ok, err := r.locker.TryLock(ctx, sagaRecoveryLock)
+	if err != nil {
+		return fmt.Errorf("failed to take the recovery lock: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+	defer release()
+
+	return r.orders.RecoverSagas(ctx, r.minAge)
+}
@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+type ShipmentsService interface {
+	CreateShipment(ctx context.Context, orderID entity.UUID, params CreateShipmentParams) (*entity.Shipment, error)
+	MarkShipmentDelivered(ctx context.Context, id entity.UUID, deliveredAt time.Time) (*entity.Shipment, error)
+	GetShipment(ctx context.Context, id entity.UUID) (*entity.Shipment, error)
+	ListOrderShipments(ctx context.Context, orderID entity.UUID) ([]*entity.Shipment, error)
+}
+
+// CreateShipmentParams describes a parcel. No items ships everything that
+// has not shipped yet.
+type CreateShipmentParams struct {
+	Carrier        string
+	TrackingNumber string
+	Items          []entity.ShipmentItem
+}
+
+type shipmentsService struct {
+	shipmentRepo ports.ShipmentRepository
+	orders       OrdersService
+	timeSource   func() time.Time
+}
+
+func NewShipmentsService(shipmentRepo ports.ShipmentRepository, orders OrdersService, timeSource func() time.Time) ShipmentsService {
+	return &shipmentsService{
+		shipmentRepo: shipmentRepo,
+		orders:       orders,
+		timeSource:   timeSource,
+	}
+}
+
+// CreateShipment ships items of a processing order.
+func (s *shipmentsService) CreateShipment(ctx context.Context, orderID entity.UUID, params CreateShipmentParams) (*entity.Shipment, error) {
+	var shipment *entity.Shipment
+	err := s.shipmentRepo.CreateShipment(ctx, orderID, func(order *entity.Order, shipments []*entity.Shipment) (*entity.Shipment, error) {
+		var err error
+		shipment, err = entity.NewShipment(order, shipments, params.Carrier, params.TrackingNumber, params.Items, s.timeSource().UTC())
+		return shipment, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+// MarkShipmentDelivered records the delivery, at deliveredAt or now when it
+// is zero, and completes the order once all its items are delivered.
+// Delivering a delivered shipment only retries completing the order.
+func (s *shipmentsService) MarkShipmentDelivered(ctx context.Context, id entity.UUID, deliveredAt time.Time) (*entity.Shipment, error) {
+	now := s.timeSource().UTC()
+	if deliveredAt.IsZero() {
+		deliveredAt = now
+	}
+
+	var shipment *entity.Shipment
+	err := s.shipmentRepo.UpdateShipmentByID(ctx, id, func(sh *entity.Shipment) (bool, error) {
+		shipment = sh
+		return sh.MarkDelivered(deliveredAt.UTC(), now)
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotUpdated) {
+		return nil, err
+	}
+
+	if err := s.completeIfDelivered(ctx, shipment.OrderID); err != nil {
+		return shipment, fmt.Errorf("shipment %s is delivered, but order %s was not completed: %w", id, shipment.OrderID, err)
+	}
+	return shipment, nil
+}
+
+func (s *shipmentsService) completeIfDelivered(ctx context.Context, orderID entity.UUID) error {
+	order, err := s.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order.Status != entity.OrderStatusProcessing {
+		return nil
+	}
+	shipments, err := s.shipmentRepo.GetShipmentsByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if !entity.AllDelivered(order, shipments) {
+		return nil
+	}
+
+	completed := entity.OrderStatusCompleted
+	_, err = s.orders.UpdateOrder(ctx, orderID, UpdateOrderParams{Status: &completed, Reason: "all items delivered"})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		// A concurrent delivery completed it first.
+		return nil
+	}
+	return err
+}
+
+func (s *shipmentsService) GetShipment(ctx context.Context, id entity.UUID) (*entity.Shipment, error) {
+	return s.shipmentRepo.GetShipmentByID(ctx, id)
+}
+
+func (s *shipmentsService) ListOrderShipments(ctx context.Context, orderID entity.UUID) ([]*entity.Shipment, error) {
+	if _, err := s.orders.GetOrderByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return s.shipmentRepo.GetShipmentsByOrderID(ctx, orderID)
+}
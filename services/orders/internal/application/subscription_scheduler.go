@@ -0,0 +1,78 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// subscriptionSchedulerLock is the lock that lets one replica order
+// subscription cycles at a time.
+const subscriptionSchedulerLock = "orders:subscription-scheduler"
+
+// SubscriptionScheduler periodically places the orders of subscriptions
+// whose cycle has come.
+type SubscriptionScheduler struct {
+	subscriptions SubscriptionsService
+	locker        ports.Locker
+	interval      time.Duration
+	batchSize     int
+	logger        *slog.Logger
+}
+
+func NewSubscriptionScheduler(subscriptions SubscriptionsService, locker ports.Locker, interval time.Duration, batchSize int, logger *slog.Logger) *SubscriptionScheduler {
+	return &SubscriptionScheduler{
+		subscriptions: subscriptions,
+		locker:        locker,
+		interval:      interval,
+		batchSize:     batchSize,
+		logger:        logger,
+	}
+}
+
+// Run schedules until ctx is cancelled.
+func (s *SubscriptionScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := s.Schedule(ctx); err != nil {
+			s.logger.Error("Failed to run subscriptions", "error", err)
+		}
+	}
+}
+
+// Schedule runs up to one batch of due subscription cycles and returns their
+// runs. It does nothing while another replica holds the scheduler lock.
+func (s *SubscriptionScheduler) Schedule(ctx context.Context) ([]*entity.SubscriptionRun, error) {
+	release, ok, err := s.locker.TryLock(ctx, subscriptionSchedulerLock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take the scheduler lock: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	defer release()
+
+	runs, err := s.subscriptions.RunDueSubscriptions(ctx, s.batchSize)
+	for _, run := range runs {
+		s.logger.Info("Ran subscription cycle",
+			"subscription_id", run.SubscriptionID,
+			"scheduled_at", run.ScheduledAt,
+			"status", run.Status,
+			"order_id", run.OrderID,
+			"reason", run.Reason,
+		)
+	}
+	return runs, err
+}
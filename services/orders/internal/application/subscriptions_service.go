@@ -0,0 +1,222 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SubscriptionsService interface {
+	CreateSubscription(ctx context.Context, draft entity.Subscription) (*entity.Subscription, error)
+	GetSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error)
+	ListUserSubscriptions(ctx context.Context, userID entity.UUID) ([]*entity.Subscription, error)
+	PauseSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error)
+	ResumeSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error)
+	CancelSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error)
+	ListSubscriptionRuns(ctx context.Context, id entity.UUID) ([]*entity.SubscriptionRun, error)
+	// RunDueSubscriptions orders the cycles due now of up to limit
+	// subscriptions and returns their runs.
+	RunDueSubscriptions(ctx context.Context, limit int) ([]*entity.SubscriptionRun, error)
+}
+
+type subscriptionsService struct {
+	subscriptionRepo ports.SubscriptionRepository
+	orders           OrdersService
+	inventoryClient  ports.InventoryService
+	timeSource       func() time.Time
+}
+
+func NewSubscriptionsService(subscriptionRepo ports.SubscriptionRepository, orders OrdersService, inventoryClient ports.InventoryService, timeSource func() time.Time) SubscriptionsService {
+	return &subscriptionsService{
+		subscriptionRepo: subscriptionRepo,
+		orders:           orders,
+		inventoryClient:  inventoryClient,
+		timeSource:       timeSource,
+	}
+}
+
+// CreateSubscription starts a subscription to products inventory has. Stock
+// is not checked until a cycle comes.
+func (s *subscriptionsService) CreateSubscription(ctx context.Context, draft entity.Subscription) (*entity.Subscription, error) {
+	subscription, err := entity.NewSubscription(draft, s.timeSource().UTC())
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range subscription.Items {
+		if _, err := s.product(ctx, item.ProductID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.subscriptionRepo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *subscriptionsService) GetSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error) {
+	return s.subscriptionRepo.GetSubscriptionByID(ctx, id)
+}
+
+func (s *subscriptionsService) ListUserSubscriptions(ctx context.Context, userID entity.UUID) ([]*entity.Subscription, error) {
+	return s.subscriptionRepo.GetSubscriptionsByUserID(ctx, userID)
+}
+
+func (s *subscriptionsService) PauseSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error) {
+	return s.update(ctx, id, entity.SubscriptionStatusPaused, (*entity.Subscription).Pause)
+}
+
+func (s *subscriptionsService) ResumeSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error) {
+	return s.update(ctx, id, entity.SubscriptionStatusActive, (*entity.Subscription).Resume)
+}
+
+func (s *subscriptionsService) CancelSubscription(ctx context.Context, id entity.UUID) (*entity.Subscription, error) {
+	return s.update(ctx, id, entity.SubscriptionStatusEnded, (*entity.Subscription).Cancel)
+}
+
+func (s *subscriptionsService) ListSubscriptionRuns(ctx context.Context, id entity.UUID) ([]*entity.SubscriptionRun, error) {
+	if _, err := s.subscriptionRepo.GetSubscriptionByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.subscriptionRepo.GetSubscriptionRuns(ctx, id)
+}
+
+// RunDueSubscriptions orders one cycle of each due subscription. A cycle is
+// claimed by moving the subscription past it before the order is placed, so
+// a cycle is ordered at most once: if the order or its run record fails, the
+// cycle is not retried.
+func (s *subscriptionsService) RunDueSubscriptions(ctx context.Context, limit int) ([]*entity.SubscriptionRun, error) {
+	due, err := s.subscriptionRepo.GetDueSubscriptions(ctx, s.timeSource().UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*entity.SubscriptionRun
+	var errs []error
+	for _, subscription := range due {
+		run, err := s.runCycle(ctx, subscription.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", subscription.ID, err))
+		}
+		if run != nil {
+			runs = append(runs, run)
+		}
+	}
+	return runs, errors.Join(errs...)
+}
+
+// runCycle claims the subscription's due cycle and orders it. It returns no
+// run when the cycle is no longer due, such as when another replica claimed
+// it or the subscription was paused.
+func (s *subscriptionsService) runCycle(ctx context.Context, id entity.UUID) (*entity.SubscriptionRun, error) {
+	var subscription *entity.Subscription
+	var scheduled time.Time
+	err := s.subscriptionRepo.UpdateSubscriptionByID(ctx, id, func(sub *entity.Subscription) (bool, error) {
+		now := s.timeSource().UTC()
+		if !sub.Due(now) {
+			return false, nil
+		}
+		var err error
+		if scheduled, err = sub.Advance(now); err != nil {
+			return false, err
+		}
+		subscription = sub
+		return true, nil
+	})
+	if errors.Is(err, entity.ErrNotUpdated) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	run := &entity.SubscriptionRun{
+		ID:             entity.NewUUID(),
+		SubscriptionID: id,
+		ScheduledAt:    scheduled,
+	}
+	s.order(ctx, subscription, run)
+	run.CreatedAt = s.timeSource().UTC()
+	if err := s.subscriptionRepo.SaveSubscriptionRun(ctx, run); err != nil {
+		return run, fmt.Errorf("cycle %s ended %s, but the run was not recorded: %w", scheduled, run.Status, err)
+	}
+	return run, nil
+}
+
+// order places the cycle's order, following the subscription's stock
+// policy, and records the outcome in run.
+func (s *subscriptionsService) order(ctx context.Context, subscription *entity.Subscription, run *entity.SubscriptionRun) {
+	available := make(map[entity.UUID]int64, len(subscription.Items))
+	for _, item := range subscription.Items {
+		product, err := s.product(ctx, item.ProductID)
+		if errors.Is(err, entity.ErrItemNotFound) {
+			continue
+		}
+		if err != nil {
+			run.Status, run.Reason = entity.SubscriptionRunFailed, err.Error()
+			return
+		}
+		available[item.ProductID] = product.Quantity
+	}
+
+	items, shortfall := subscription.PlanItems(available)
+	if len(items) == 0 {
+		run.Status, run.Reason = entity.SubscriptionRunSkipped, shortfall
+		return
+	}
+
+	order := &entity.Order{
+		UserID:   subscription.UserID,
+		UserName: subscription.UserName,
+		Items:    items,
+	}
+	err := s.orders.CreateOrder(WithActor(ctx, "subscription:"+subscription.ID.String()), order)
+	switch {
+	case errors.Is(err, entity.ErrInsufficientQuantity), errors.Is(err, entity.ErrItemNotFound):
+		// The stock went between the check and the order.
+		run.Status, run.Reason = entity.SubscriptionRunSkipped, err.Error()
+	case err != nil && !errors.Is(err, entity.ErrOrderPlaced):
+		run.Status, run.Reason = entity.SubscriptionRunFailed, err.Error()
+	case shortfall != "":
+		run.OrderID, run.Status, run.Reason = order.ID, entity.SubscriptionRunPartial, shortfall
+	default:
+		run.OrderID, run.Status = order.ID, entity.SubscriptionRunOrdered
+	}
+}
+
+// update applies fn unless the subscription is already in target.
+func (s *subscriptionsService) update(ctx context.Context, id entity.UUID, target entity.SubscriptionStatus, fn func(*entity.Subscription, time.Time) error) (*entity.Subscription, error) {
+	var subscription *entity.Subscription
+	err := s.subscriptionRepo.UpdateSubscriptionByID(ctx, id, func(sub *entity.Subscription) (bool, error) {
+		subscription = sub
+		if sub.Status == target {
+			return false, nil
+		}
+		if err := fn(sub, s.timeSource().UTC()); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotUpdated) {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// product looks the product up in inventory. Its Quantity is the stock
+// available to order.
+func (s *subscriptionsService) product(ctx context.Context, productID entity.UUID) (*entity.OrderItem, error) {
+	product, err := s.inventoryClient.GetProduct(ctx, productID)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return nil, entity.ErrItemNotFound
+		}
+		return nil, err
+	}
+	return product, nil
+}
@@ -0,0 +1,151 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+)
+
+// unpaidOrderSweepLock is the lock that lets one replica sweep at a time.
+const unpaidOrderSweepLock = "orders:unpaid-order-sweep"
+
+// UnpaidOrderSweeper periodically cancels orders left pending for longer than
+// a timeout, which returns their stock. An order with a payment authorized or
+// captured is left alone, as it is about to move on. In dry-run mode the
+// orders are only logged.
+type UnpaidOrderSweeper struct {
+	orders     OrdersService
+	payments   PaymentsService
+	locker     ports.Locker
+	timeout    time.Duration
+	interval   time.Duration
+	batchSize  int
+	dryRun     bool
+	timeSource func() time.Time
+	logger     *slog.Logger
+}
+
+func NewUnpaidOrderSweeper(orders OrdersService, payments PaymentsService, locker ports.Locker, timeout, interval time.Duration, batchSize int, dryRun bool, timeSource func() time.Time, logger *slog.Logger) *UnpaidOrderSweeper {
+	return &UnpaidOrderSweeper{
+		orders:     orders,
+		payments:   payments,
+		locker:     locker,
+		timeout:    timeout,
+		interval:   interval,
+		batchSize:  batchSize,
+		dryRun:     dryRun,
+		timeSource: timeSource,
+		logger:     logger,
+	}
+}
+
+// Run sweeps until ctx is cancelled.
+func (s *UnpaidOrderSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cancelled, err := s.Sweep(ctx)
+		if err != nil {
+			s.logger.Error("Failed to cancel unpaid orders", "error", err)
+		}
+		if cancelled > 0 {
+			s.logger.Info("Cancelled unpaid orders", "count", cancelled, "dry_run", s.dryRun)
+		}
+	}
+}
+
+// Sweep cancels up to one batch of the oldest unpaid orders and reports how
+// many it cancelled, or would have in dry-run mode. It does nothing while
+// another replica holds the sweep lock. Paid orders are left out of the
+// listing, so they never crowd unpaid ones out of the batch; an order paid
+// or cancelled since it was listed is skipped.
+func (s *UnpaidOrderSweeper) Sweep(ctx context.Context) (int, error) {
+	release, ok, err := s.locker.TryLock(ctx, unpaidOrderSweepLock)
+	if err != nil {
+		return 0, fmt.Errorf("failed to take the sweep lock: %w", err)
+	}
+	if !ok {
+		return 0, nil
+	}
+	defer release()
+
+	cutoff := s.timeSource().UTC().Add(-s.timeout)
+	page, err := s.orders.GetPaginatedOrders(ctx, entity.OrderFilter{
+		Statuses:  []entity.OrderStatus{entity.OrderStatusPending},
+		CreatedTo: cutoff,
+		Unpaid:    true,
+	}, entity.NewPagination(1, int64(s.batchSize), entity.SortByCreatedAt))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unpaid orders: %w", err)
+	}
+
+	reason := fmt.Sprintf("not paid within %s", s.timeout)
+	pending := entity.OrderStatusPending
+	cancelled := entity.OrderStatusCancelled
+	count := 0
+	var errs []error
+	for _, order := range page.Data {
+		paid, err := s.paid(ctx, order.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("order %s: %w", order.ID, err))
+			continue
+		}
+		if paid {
+			continue
+		}
+
+		if s.dryRun {
+			s.logger.Info("Would cancel unpaid order", "id", order.ID, "created_at", order.CreatedAt)
+			count++
+			continue
+		}
+
+		_, err = s.orders.UpdateOrder(WithActor(ctx, entity.ActorSystem), order.ID, UpdateOrderParams{
+			Status:         &cancelled,
+			ExpectedStatus: &pending,
+			Reason:         reason,
+		})
+		if errors.Is(err, entity.ErrStatusChanged) || errors.Is(err, entity.ErrOrderNotFound) {
+			continue
+		}
+		if err != nil {
+			// The order may be cancelled with its stock return still
+			// pending; the order task runner retries it.
+			errs = append(errs, fmt.Errorf("order %s: %w", order.ID, err))
+			continue
+		}
+		s.logger.Info("Cancelled unpaid order", "id", order.ID, "created_at", order.CreatedAt)
+		count++
+	}
+	return count, errors.Join(errs...)
+}
+
+// paid reports whether the order has a charge that is authorized or
+// captured.
+func (s *UnpaidOrderSweeper) paid(ctx context.Context, orderID entity.UUID) (bool, error) {
+	payments, err := s.payments.ListOrderPayments(ctx, orderID)
+	if err != nil {
+		return false, err
+	}
+	for _, payment := range payments {
+		if payment.Kind != entity.PaymentKindCharge {
+			continue
+		}
+		if payment.Status == entity.PaymentStatusAuthorized || payment.Status == entity.PaymentStatusCaptured {
+			return true, nil
+		}
+	}
+	return false, nil
+}
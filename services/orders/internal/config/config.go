@@ -4,15 +4,27 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
-	Environment string
-	Version     string
-	Server      Server
-	Clients     map[string]Server
-	DB          DataBase
+	Environment  string
+	Version      string
+	Server       Server
+	Clients      map[string]Server
+	DB           DataBase
+	Reservation  Reservation
+	Idempotency  Idempotency
+	Events       Events
+	Watch        Watch
+	Tax          Tax
+	Cart         Cart
+	UnpaidOrder  UnpaidOrder
+	Subscription Subscription
+	OrderTask    OrderTask
+	Saga         Saga
 }
 
 type Server struct {
@@ -21,6 +33,71 @@ type Server struct {
 	GRPCPort string
 }
 
+type Reservation struct {
+	BatchSize     int
+	FlushInterval time.Duration
+	Timeout       time.Duration
+}
+
+type Idempotency struct {
+	// Window is how long a response is replayed for a retried key.
+	Window time.Duration
+}
+
+type Events struct {
+	// Publisher is "memory" or "jsonl"; the latter appends to File.
+	Publisher     string
+	File          string
+	RelayInterval time.Duration
+	BatchSize     int
+}
+
+type Watch struct {
+	// PollInterval is how often an idle order watch checks for new events.
+	PollInterval time.Duration
+}
+
+type Tax struct {
+	// RulesFile is the JSON file of tax rates; without one nothing is taxed.
+	RulesFile string
+}
+
+type Cart struct {
+	// TTL is how long a cart lives after its last change.
+	TTL           time.Duration
+	PurgeInterval time.Duration
+}
+
+// UnpaidOrder configures the sweep that cancels orders left pending. A zero
+// Timeout turns the sweep off; with DryRun it only logs what it would cancel.
+type UnpaidOrder struct {
+	Timeout       time.Duration
+	SweepInterval time.Duration
+	BatchSize     int
+	DryRun        bool
+}
+
+// Subscription configures the scheduler that places subscription orders.
+type Subscription struct {
+	SchedulerInterval time.Duration
+	BatchSize         int
+}
+
+// OrderTask configures the runner that retries the tasks order changes
+// leave, such as returning the stock of a cancelled order.
+type OrderTask struct {
+	RunInterval time.Duration
+	BatchSize   int
+}
+
+// Saga configures the recovery of order sagas a crash left unfinished. Only
+// sagas idle for RecoveryAge are recovered, so it must be longer than placing
+// an order takes.
+type Saga struct {
+	RecoveryAge      time.Duration
+	RecoveryInterval time.Duration
+}
+
 type DataBase struct {
 	DBUser     string
 	DBPassword string
@@ -59,6 +136,48 @@ func NewConfig(filename string) Config {
 			DBPort:     getEnv("DB_PORT", "5432"),
 			DBName:     getEnv("DB_NAME", "orders_db"),
 		},
+		Reservation: Reservation{
+			BatchSize:     getEnvInt("RESERVATION_BATCH_SIZE", 100),
+			FlushInterval: getEnvDuration("RESERVATION_FLUSH_INTERVAL", 100*time.Millisecond),
+			Timeout:       getEnvDuration("RESERVATION_TIMEOUT", 5*time.Second),
+		},
+		Idempotency: Idempotency{
+			Window: getEnvDuration("IDEMPOTENCY_WINDOW", 24*time.Hour),
+		},
+		Events: Events{
+			Publisher:     getEnv("EVENTS_PUBLISHER", "memory"),
+			File:          getEnv("EVENTS_FILE", "events.jsonl"),
+			RelayInterval: getEnvDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+			BatchSize:     getEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+		Watch: Watch{
+			PollInterval: getEnvDuration("WATCH_POLL_INTERVAL", 500*time.Millisecond),
+		},
+		Tax: Tax{
+			RulesFile: getEnv("TAX_RULES_FILE", ""),
+		},
+		Cart: Cart{
+			TTL:           getEnvDuration("CART_TTL", 7*24*time.Hour),
+			PurgeInterval: getEnvDuration("CART_PURGE_INTERVAL", time.Hour),
+		},
+		UnpaidOrder: UnpaidOrder{
+			Timeout:       getEnvDuration("UNPAID_ORDER_TIMEOUT", 24*time.Hour),
+			SweepInterval: getEnvDuration("UNPAID_ORDER_SWEEP_INTERVAL", 5*time.Minute),
+			BatchSize:     getEnvInt("UNPAID_ORDER_SWEEP_BATCH_SIZE", 100),
+			DryRun:        getEnvBool("UNPAID_ORDER_SWEEP_DRY_RUN", false),
+		},
+		Subscription: Subscription{
+			SchedulerInterval: getEnvDuration("SUBSCRIPTION_SCHEDULER_INTERVAL", time.Minute),
+			BatchSize:         getEnvInt("SUBSCRIPTION_BATCH_SIZE", 50),
+		},
+		OrderTask: OrderTask{
+			RunInterval: getEnvDuration("ORDER_TASK_INTERVAL", 30*time.Second),
+			BatchSize:   getEnvInt("ORDER_TASK_BATCH_SIZE", 50),
+		},
+		Saga: Saga{
+			RecoveryAge:      getEnvDuration("SAGA_RECOVERY_AGE", 5*time.Minute),
+			RecoveryInterval: getEnvDuration("SAGA_RECOVERY_INTERVAL", time.Minute),
+		},
 	}
 }
 
@@ -95,3 +214,30 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	if value, ok := os.LookupEnv(key); ok {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if value, ok := os.LookupEnv(key); ok {
+		if parsed, err := time.ParseDuration(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	if value, ok := os.LookupEnv(key); ok {
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
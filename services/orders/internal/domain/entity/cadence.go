@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cadence is how often a subscription orders: every Interval, counted from
+// its start, or at the minutes matching Cron. Exactly one of them is set.
+type Cadence struct {
+	Interval time.Duration
+	// Cron has five fields: minute, hour, day of month, month and day of
+	// week (0 or 7 is Sunday). Each is *, a value, a range a-b or a list of
+	// those, optionally with a /step. It is evaluated in UTC.
+	Cron string
+}
+
+// MinCadenceInterval keeps a subscription from ordering more than once a
+// minute, which is as often as a cron cadence can fire.
+const MinCadenceInterval = time.Minute
+
+var ErrInvalidCadence = fmt.Errorf("invalid cadence")
+
+func (c Cadence) Validate() error {
+	switch {
+	case c.Interval != 0 && c.Cron != "":
+		return fmt.Errorf("%w: set either an interval or a cron expression", ErrInvalidCadence)
+	case c.Cron != "":
+		_, err := ParseCron(c.Cron)
+		return err
+	case c.Interval < MinCadenceInterval:
+		return fmt.Errorf("%w: interval must be at least %s", ErrInvalidCadence, MinCadenceInterval)
+	}
+	return nil
+}
+
+// First is the first cycle at or after start.
+func (c Cadence) First(start time.Time) time.Time {
+	if c.Cron == "" {
+		return start
+	}
+	schedule, err := ParseCron(c.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return schedule.Next(start.Add(-time.Nanosecond))
+}
+
+// Next is the first cycle after both prev, itself a cycle, and now. An
+// interval keeps counting from prev, so the cycles do not drift. The zero
+// time means there is none.
+func (c Cadence) Next(prev, now time.Time) time.Time {
+	if c.Cron == "" {
+		if c.Interval <= 0 {
+			return time.Time{}
+		}
+		next := prev.Add(c.Interval)
+		if !next.After(now) {
+			missed := now.Sub(prev) / c.Interval
+			next = prev.Add((missed + 1) * c.Interval)
+		}
+		return next
+	}
+
+	schedule, err := ParseCron(c.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	if now.After(prev) {
+		prev = now
+	}
+	return schedule.Next(prev)
+}
+
+// CronSchedule is a parsed cron expression. Each field is a bit set of the
+// values it matches.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// A day matches when either day field does, unless one of them is *.
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+func ParseCron(expr string) (*CronSchedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("%w: cron expression %q needs %d fields", ErrInvalidCadence, expr, len(cronFields))
+	}
+
+	var sets [5]uint64
+	for i, part := range parts {
+		set, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+	// Sunday is both 0 and 7.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	schedule := &CronSchedule{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     sets[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}
+	if schedule.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("%w: cron expression %q never fires", ErrInvalidCadence, expr)
+	}
+	return schedule, nil
+}
+
+func parseCronField(s string, field cronField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(s, ",") {
+		rng, step := item, 1
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("%w: bad step in %s field %q", ErrInvalidCadence, field.name, s)
+			}
+			rng, step = item[:i], n
+		}
+
+		lo, hi := field.min, field.max
+		if rng != "*" {
+			var err error
+			bounds := strings.SplitN(rng, "-", 2)
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("%w: bad value in %s field %q", ErrInvalidCadence, field.name, s)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("%w: bad value in %s field %q", ErrInvalidCadence, field.name, s)
+				}
+			}
+			if lo < field.min || hi > field.max || lo > hi {
+				return 0, fmt.Errorf("%w: %s field %q is outside %d-%d", ErrInvalidCadence, field.name, s, field.min, field.max)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// Next is the first minute strictly after t that the schedule matches, or
+// the zero time if there is none within five years.
+func (c *CronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *CronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
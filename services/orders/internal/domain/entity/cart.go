@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+// Cart is a user's basket before checkout; each user has one. Only products
+// and quantities are stored. Names, prices and stock are looked up in
+// inventory whenever the cart is read, so it always shows what checking out
+// would charge. A cart that is not changed for its TTL expires and reads as
+// empty.
+type Cart struct {
+	UserID    UUID
+	Items     []CartItem
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type CartItem struct {
+	ProductID UUID
+	Quantity  int64
+	AddedAt   time.Time
+	// The fields below come from inventory when the cart is priced and are
+	// not stored. Missing means inventory no longer has the product.
+	ProductName       string
+	UnitPrice         money.Money
+	AvailableQuantity int64
+	Missing           bool
+}
+
+var (
+	ErrCartNotFound     = fmt.Errorf("cart not found")
+	ErrCartEmpty        = fmt.Errorf("cart is empty")
+	ErrCartItemNotFound = fmt.Errorf("product is not in the cart")
+)
+
+// InStock reports whether inventory can supply the item's quantity.
+func (i *CartItem) InStock() bool {
+	return !i.Missing && i.AvailableQuantity >= i.Quantity
+}
+
+// Expired reports whether the cart outlived its TTL at now.
+func (c *Cart) Expired(now time.Time) bool {
+	return !c.ExpiresAt.IsZero() && !now.Before(c.ExpiresAt)
+}
+
+// Renew starts the cart afresh if it is new or expired, and pushes its
+// expiry ttl past now.
+func (c *Cart) Renew(ttl time.Duration, now time.Time) {
+	if c.CreatedAt.IsZero() || c.Expired(now) {
+		c.Items = nil
+		c.CreatedAt = now
+	}
+	c.UpdatedAt = now
+	c.ExpiresAt = now.Add(ttl)
+}
+
+// Item returns the cart's item for productID, if it has one.
+func (c *Cart) Item(productID UUID) (*CartItem, bool) {
+	for i := range c.Items {
+		if c.Items[i].ProductID == productID {
+			return &c.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// AddItem adds quantity of the product, on top of what the cart holds.
+func (c *Cart) AddItem(productID UUID, quantity int64, now time.Time) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+	if item, ok := c.Item(productID); ok {
+		item.Quantity += quantity
+		return nil
+	}
+	c.Items = append(c.Items, CartItem{ProductID: productID, Quantity: quantity, AddedAt: now})
+	return nil
+}
+
+// SetItemQuantity replaces the quantity of a product in the cart. Zero
+// removes it.
+func (c *Cart) SetItemQuantity(productID UUID, quantity int64) error {
+	if quantity < 0 {
+		return ErrInvalidQuantity
+	}
+	if quantity == 0 {
+		return c.RemoveItem(productID)
+	}
+	item, ok := c.Item(productID)
+	if !ok {
+		return ErrCartItemNotFound
+	}
+	item.Quantity = quantity
+	return nil
+}
+
+func (c *Cart) RemoveItem(productID UUID) error {
+	for i := range c.Items {
+		if c.Items[i].ProductID == productID {
+			c.Items = append(c.Items[:i], c.Items[i+1:]...)
+			return nil
+		}
+	}
+	return ErrCartItemNotFound
+}
+
+// RemoveOrdered takes the order's items out of the cart. Quantities added
+// since the order was placed stay.
+func (c *Cart) RemoveOrdered(order *Order) {
+	ordered := make(map[UUID]int64, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.ProductID] += item.Quantity
+	}
+	items := c.Items[:0]
+	for _, item := range c.Items {
+		item.Quantity -= ordered[item.ProductID]
+		if item.Quantity > 0 {
+			items = append(items, item)
+		}
+	}
+	c.Items = items
+}
+
+// Subtotal is the value of the items inventory still has, whether or not
+// there is enough of them. Every item must be priced in the same currency.
+func (c *Cart) Subtotal() (money.Money, error) {
+	var subtotal money.Money
+	for _, item := range c.Items {
+		if item.Missing {
+			continue
+		}
+		line := item.UnitPrice.Mul(item.Quantity)
+		if subtotal.Currency == "" {
+			subtotal = line
+			continue
+		}
+		var err error
+		if subtotal, err = subtotal.Add(line); err != nil {
+			return money.Money{}, err
+		}
+	}
+	if subtotal.Currency == "" {
+		subtotal = money.New(0, DefaultCurrency)
+	}
+	return subtotal, nil
+}
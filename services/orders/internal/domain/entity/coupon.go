@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+type CouponKind string
+
+const (
+	// CouponPercentage takes PercentOff basis points off the subtotal.
+	CouponPercentage CouponKind = "percentage"
+	// CouponFixedAmount takes AmountOff off the subtotal, never more than
+	// the subtotal itself.
+	CouponFixedAmount CouponKind = "fixed_amount"
+	// CouponFreeShipping waives the shipping charge and leaves the subtotal
+	// as it is.
+	CouponFreeShipping CouponKind = "free_shipping"
+)
+
+// Coupon is a discount code. Zero limits, a zero minimum order value and zero
+// validity bounds do not restrict it.
+type Coupon struct {
+	ID   UUID
+	Code string
+	Kind CouponKind
+	// PercentOff is in basis points: 1250 is 12.5%.
+	PercentOff    int64
+	AmountOff     money.Money
+	MinOrderValue money.Money
+	// ValidFrom is inclusive and ValidUntil exclusive.
+	ValidFrom  time.Time
+	ValidUntil time.Time
+	// MaxRedemptions caps the orders placed with the coupon, and
+	// MaxRedemptionsPerUser the orders each user places with it. A
+	// redemption is final, even if the order is cancelled later.
+	MaxRedemptions        int64
+	MaxRedemptionsPerUser int64
+	Redemptions           int64
+	Active                bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// OrderDiscount records a coupon applied to an order as it was at the time,
+// so the order's total can be reproduced after the coupon changes. Amount is
+// what the coupon took off; AmountOff and MinOrderValue are its terms, kept
+// to apply it again when the order's items change.
+type OrderDiscount struct {
+	CouponID      UUID
+	Code          string
+	Kind          CouponKind
+	PercentOff    int64
+	AmountOff     money.Money
+	MinOrderValue money.Money
+	Amount        money.Money
+	FreeShipping  bool
+	CreatedAt     time.Time
+}
+
+var (
+	ErrCouponNotFound      = fmt.Errorf("coupon not found")
+	ErrCouponAlreadyExists = fmt.Errorf("coupon code already exists")
+	ErrInvalidCoupon       = fmt.Errorf("invalid coupon")
+	ErrCouponNotApplicable = fmt.Errorf("coupon does not apply to this order")
+	ErrCouponExhausted     = fmt.Errorf("coupon has no redemptions left")
+)
+
+// NormalizeCouponCode makes codes case-insensitive.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (c *Coupon) Validate() error {
+	if c.Code == "" {
+		return fmt.Errorf("%w: code cannot be empty", ErrInvalidCoupon)
+	}
+	switch c.Kind {
+	case CouponPercentage:
+		if c.PercentOff <= 0 || c.PercentOff > 10000 {
+			return fmt.Errorf("%w: percent off must be between 1 and 10000 basis points", ErrInvalidCoupon)
+		}
+	case CouponFixedAmount:
+		if !c.AmountOff.IsPositive() || c.AmountOff.Currency == "" {
+			return fmt.Errorf("%w: amount off must be positive and have a currency", ErrInvalidCoupon)
+		}
+	case CouponFreeShipping:
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidCoupon, c.Kind)
+	}
+	if c.MinOrderValue.IsNegative() {
+		return fmt.Errorf("%w: minimum order value cannot be negative", ErrInvalidCoupon)
+	}
+	if c.MinOrderValue.IsPositive() && c.MinOrderValue.Currency == "" {
+		return fmt.Errorf("%w: minimum order value needs a currency", ErrInvalidCoupon)
+	}
+	if c.Currency() != "" && c.MinOrderValue.IsPositive() && c.MinOrderValue.Currency != c.Currency() {
+		return fmt.Errorf("%w: amount off and minimum order value must share a currency", ErrInvalidCoupon)
+	}
+	if !c.ValidFrom.IsZero() && !c.ValidUntil.IsZero() && !c.ValidFrom.Before(c.ValidUntil) {
+		return fmt.Errorf("%w: valid_from must be before valid_until", ErrInvalidCoupon)
+	}
+	if c.MaxRedemptions < 0 || c.MaxRedemptionsPerUser < 0 {
+		return fmt.Errorf("%w: redemption limits cannot be negative", ErrInvalidCoupon)
+	}
+	return nil
+}
+
+// Currency is the currency the coupon's amounts are in, or empty when it has
+// none.
+func (c *Coupon) Currency() string {
+	if c.Kind == CouponFixedAmount {
+		return c.AmountOff.Currency
+	}
+	if c.MinOrderValue.IsPositive() {
+		return c.MinOrderValue.Currency
+	}
+	return ""
+}
+
+// CheckUsage fails with ErrCouponExhausted when one more redemption, by a
+// user who has redeemed the coupon byUser times, would exceed a limit.
+func (c *Coupon) CheckUsage(byUser int64) error {
+	if c.MaxRedemptions > 0 && c.Redemptions >= c.MaxRedemptions {
+		return fmt.Errorf("%w: %s", ErrCouponExhausted, c.Code)
+	}
+	if c.MaxRedemptionsPerUser > 0 && byUser >= c.MaxRedemptionsPerUser {
+		return fmt.Errorf("%w: %s was already used %d times by this user", ErrCouponExhausted, c.Code, byUser)
+	}
+	return nil
+}
+
+// Apply works out the discount the coupon gives on subtotal at now, or fails
+// with ErrCouponNotApplicable. Redemption limits are checked separately.
+func (c *Coupon) Apply(subtotal money.Money, now time.Time) (OrderDiscount, error) {
+	if !c.Active {
+		return OrderDiscount{}, fmt.Errorf("%w: %s is inactive", ErrCouponNotApplicable, c.Code)
+	}
+	if !c.ValidFrom.IsZero() && now.Before(c.ValidFrom) {
+		return OrderDiscount{}, fmt.Errorf("%w: %s is not valid yet", ErrCouponNotApplicable, c.Code)
+	}
+	if !c.ValidUntil.IsZero() && !now.Before(c.ValidUntil) {
+		return OrderDiscount{}, fmt.Errorf("%w: %s has expired", ErrCouponNotApplicable, c.Code)
+	}
+	if c.MinOrderValue.IsPositive() {
+		cmp, err := subtotal.Compare(c.MinOrderValue)
+		if err != nil {
+			return OrderDiscount{}, fmt.Errorf("%w: %s is only valid in %s", ErrCouponNotApplicable, c.Code, c.MinOrderValue.Currency)
+		}
+		if cmp < 0 {
+			return OrderDiscount{}, fmt.Errorf("%w: %s needs an order of at least %s", ErrCouponNotApplicable, c.Code, c.MinOrderValue)
+		}
+	}
+
+	discount := OrderDiscount{
+		CouponID:      c.ID,
+		Code:          c.Code,
+		Kind:          c.Kind,
+		PercentOff:    c.PercentOff,
+		AmountOff:     c.AmountOff,
+		MinOrderValue: c.MinOrderValue,
+		Amount:        money.New(0, subtotal.Currency),
+		CreatedAt:     now,
+	}
+	switch c.Kind {
+	case CouponPercentage:
+		discount.Amount = subtotal.MulRate(c.PercentOff, 10000)
+	case CouponFixedAmount:
+		cmp, err := c.AmountOff.Compare(subtotal)
+		if err != nil {
+			return OrderDiscount{}, fmt.Errorf("%w: %s is only valid in %s", ErrCouponNotApplicable, c.Code, c.AmountOff.Currency)
+		}
+		discount.Amount = c.AmountOff
+		if cmp > 0 {
+			discount.Amount = subtotal
+		}
+	case CouponFreeShipping:
+		discount.FreeShipping = true
+	}
+	return discount, nil
+}
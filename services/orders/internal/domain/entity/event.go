@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Event is a domain event recorded in the outbox in the same transaction as
+// the change it describes. Delivery is at least once, so consumers should
+// deduplicate by ID.
+type Event struct {
+	ID            UUID
+	AggregateType string
+	AggregateID   string
+	Type          string
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+}
+
+const (
+	AggregateOrder = "order"
+
+	EventOrderCreated       = "order.created"
+	EventOrderUpdated       = "order.updated"
+	EventOrderStatusChanged = "order.status_changed"
+	EventOrderDeleted       = "order.deleted"
+
+	// Payment events are recorded against their order, so an order's events
+	// tell its whole story in one sequence.
+	EventPaymentCreated = "payment.created"
+	EventPaymentUpdated = "payment.updated"
+
+	EventShipmentCreated = "shipment.created"
+	EventShipmentUpdated = "shipment.updated"
+
+	EventReturnCreated = "return.created"
+	EventReturnUpdated = "return.updated"
+
+	// EventOrderSnapshot is not stored; watchers start with it when they do
+	// not resume from an earlier event.
+	EventOrderSnapshot = "order.snapshot"
+)
+
+var ErrInvalidResumeToken = fmt.Errorf("invalid resume token")
+
+// OrderEvent is an event of one order as watchers see it. Order is set for
+// created, updated and snapshot events, FromStatus and ToStatus for status
+// changes, Payment for payment events, Shipment for shipment events and
+// Return for return events.
+type OrderEvent struct {
+	// Seq is the event's position in the outbox. Positions grow in commit
+	// order for the events of one order.
+	Seq        int64
+	ID         UUID
+	OrderID    UUID
+	Type       string
+	Order      *Order
+	FromStatus OrderStatus
+	ToStatus   OrderStatus
+	Payment    *Payment
+	Shipment   *Shipment
+	Return     *Return
+	OccurredAt time.Time
+}
+
+// ResumeToken identifies the event in a watch stream. Watching again with it
+// continues right after the event.
+func (e *OrderEvent) ResumeToken() string {
+	return strconv.FormatInt(e.Seq, 10)
+}
+
+func ParseResumeToken(token string) (int64, error) {
+	seq, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, ErrInvalidResumeToken
+	}
+	return seq, nil
+}
+
+// NewEvent encodes payload as JSON and stamps the event with a fresh ID.
+func NewEvent(aggregateType, aggregateID, eventType string, payload any, occurredAt time.Time) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+	return Event{
+		ID:            NewUUID(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Type:          eventType,
+		Payload:       data,
+		OccurredAt:    occurredAt.UTC(),
+	}, nil
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+// Invoice is the bill of a completed order. Its lines, discounts, tax and
+// totals are copied from the order when it is issued and never change.
+// Numbers run per calendar year of issue with no gaps: INV-2024-000001 is
+// the first invoice issued in 2024.
+type Invoice struct {
+	ID       UUID
+	Number   string
+	Year     int
+	Sequence int64
+	OrderID  UUID
+	UserID   UUID
+	UserName string
+	// BillingAddress is the order's billing address, or its shipping
+	// address when it has none.
+	BillingAddress *Address
+	Lines          []InvoiceLine
+	Discounts      []InvoiceDiscount
+	Subtotal       money.Money
+	DiscountTotal  money.Money
+	TaxTotal       money.Money
+	Total          money.Money
+	IssuedAt       time.Time
+}
+
+type InvoiceLine struct {
+	ProductID   UUID
+	ProductName string
+	Quantity    int64
+	UnitPrice   money.Money
+	// Amount is UnitPrice times Quantity, before discounts.
+	Amount money.Money
+	// TaxRate is in thousandths of a percent, as TaxLine.Rate. Tax that is
+	// TaxInclusive is part of Amount.
+	TaxRate      int64
+	TaxAmount    money.Money
+	TaxInclusive bool
+}
+
+type InvoiceDiscount struct {
+	Code   string
+	Amount money.Money
+}
+
+var (
+	ErrInvoiceNotFound     = fmt.Errorf("invoice not found")
+	ErrOrderNotInvoiceable = fmt.Errorf("order cannot be invoiced in its current status")
+)
+
+// InvoiceNumber formats the number of the sequence-th invoice of year.
+func InvoiceNumber(year int, sequence int64) string {
+	return fmt.Sprintf("INV-%d-%06d", year, sequence)
+}
+
+// NewInvoice snapshots a completed order as an invoice issued at now. It has
+// no number yet; AssignNumber gives it one when it is stored.
+func NewInvoice(order *Order, now time.Time) (*Invoice, error) {
+	if order.Status != OrderStatusCompleted {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotInvoiceable, order.Status)
+	}
+	subtotal, err := order.Subtotal()
+	if err != nil {
+		return nil, err
+	}
+
+	inv := &Invoice{
+		ID:             NewUUID(),
+		Year:           now.Year(),
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		UserName:       order.UserName,
+		BillingAddress: order.BillingAddress,
+		Subtotal:       subtotal,
+		DiscountTotal:  order.DiscountTotal(),
+		TaxTotal:       order.TaxTotal(),
+		Total:          order.TotalAmount,
+		IssuedAt:       now,
+	}
+	if inv.BillingAddress == nil {
+		inv.BillingAddress = order.ShippingAddress
+	}
+
+	currency := order.TotalAmount.Currency
+	for _, item := range order.Items {
+		line := InvoiceLine{
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.ProductPrice,
+			Amount:      item.ProductPrice.Mul(item.Quantity),
+			TaxAmount:   money.New(0, currency),
+		}
+		for _, tax := range order.TaxLines {
+			if tax.ProductID == item.ProductID {
+				line.TaxRate = tax.Rate
+				line.TaxAmount = tax.Amount
+				line.TaxInclusive = tax.Inclusive
+			}
+		}
+		inv.Lines = append(inv.Lines, line)
+	}
+	for _, d := range order.Discounts {
+		inv.Discounts = append(inv.Discounts, InvoiceDiscount{Code: d.Code, Amount: d.Amount})
+	}
+	return inv, nil
+}
+
+// AssignNumber numbers the invoice as the sequence-th of its year.
+func (i *Invoice) AssignNumber(sequence int64) {
+	i.Sequence = sequence
+	i.Number = InvoiceNumber(i.Year, sequence)
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+var (
+	ErrOrderNotEditable  = fmt.Errorf("order items can no longer be edited")
+	ErrOrderEditConflict = fmt.Errorf("order items changed during the edit")
+)
+
+// ItemChange is how an edit changes the quantity of one product on an
+// order. A product added by the edit has From zero, a removed one To zero.
+type ItemChange struct {
+	ProductID UUID
+	From      int64
+	To        int64
+}
+
+// Delta is the stock the change takes from inventory; it is negative when
+// the change gives stock back.
+func (c ItemChange) Delta() int64 {
+	return c.To - c.From
+}
+
+// Editable reports whether the order's items may still change, which is
+// until the order completes.
+func (o *Order) Editable() bool {
+	return o.Status == OrderStatusPending || o.Status == OrderStatusProcessing
+}
+
+// DiffItems lists the quantity changes that turn the items from into to,
+// in the order the products appear in from and then in to. Products whose
+// quantity stays the same are left out.
+func DiffItems(from, to []OrderItem) []ItemChange {
+	quantities := make(map[UUID]int64, len(to))
+	for _, item := range to {
+		quantities[item.ProductID] += item.Quantity
+	}
+
+	var changes []ItemChange
+	seen := make(map[UUID]bool, len(from))
+	for _, item := range from {
+		if seen[item.ProductID] {
+			continue
+		}
+		seen[item.ProductID] = true
+		if quantities[item.ProductID] != item.Quantity {
+			changes = append(changes, ItemChange{ProductID: item.ProductID, From: item.Quantity, To: quantities[item.ProductID]})
+		}
+	}
+	for _, item := range to {
+		if seen[item.ProductID] {
+			continue
+		}
+		seen[item.ProductID] = true
+		changes = append(changes, ItemChange{ProductID: item.ProductID, To: quantities[item.ProductID]})
+	}
+	return changes
+}
+
+// StockDeltas splits changes into the stock to reserve for the order and
+// the stock to give back.
+func StockDeltas(changes []ItemChange) (reserve, release []OrderItem) {
+	for _, change := range changes {
+		switch delta := change.Delta(); {
+		case delta > 0:
+			reserve = append(reserve, OrderItem{ProductID: change.ProductID, Quantity: delta})
+		case delta < 0:
+			release = append(release, OrderItem{ProductID: change.ProductID, Quantity: -delta})
+		}
+	}
+	return reserve, release
+}
+
+// EditItems replaces the order's items, counts the edit in EditVersion and
+// appends it to its status history, with the status left as it is. Totals are not touched; the
+// caller reprices the order afterwards.
+func (o *Order) EditItems(items []OrderItem, changes []ItemChange, cause TransitionCause, now time.Time) error {
+	if !o.Editable() {
+		return fmt.Errorf("%w: order is %s", ErrOrderNotEditable, o.Status)
+	}
+
+	reason := cause.Reason
+	if reason == "" {
+		reason = "items edited"
+	}
+	parts := make([]string, 0, len(changes))
+	for _, change := range changes {
+		parts = append(parts, fmt.Sprintf("%s: %d -> %d", change.ProductID, change.From, change.To))
+	}
+
+	o.Items = items
+	o.EditVersion++
+	o.UpdatedAt = now
+	o.StatusHistory = append(o.StatusHistory, StatusTransition{
+		OrderID: o.ID,
+		From:    o.Status,
+		To:      o.Status,
+		At:      now,
+		Actor:   cause.Actor,
+		Reason:  fmt.Sprintf("%s (%s)", reason, strings.Join(parts, "; ")),
+	})
+	return nil
+}
+
+// Reprice applies the coupon again, on the terms the order was placed with,
+// to a new subtotal. It fails with ErrCouponNotApplicable when the subtotal
+// falls below the coupon's minimum order value.
+func (d *OrderDiscount) Reprice(subtotal money.Money) error {
+	coupon := Coupon{
+		ID:            d.CouponID,
+		Code:          d.Code,
+		Kind:          d.Kind,
+		PercentOff:    d.PercentOff,
+		AmountOff:     d.AmountOff,
+		MinOrderValue: d.MinOrderValue,
+		Active:        true,
+	}
+	// The coupon was valid when the order was placed, and stays valid for it.
+	repriced, err := coupon.Apply(subtotal, d.CreatedAt)
+	if err != nil {
+		return err
+	}
+	*d = repriced
+	return nil
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+// OrderFilter narrows a list of orders. Zero fields do not restrict it; the
+// rest must all match.
+type OrderFilter struct {
+	UserID   UUID
+	Statuses []OrderStatus
+	// CreatedFrom is inclusive and CreatedTo exclusive.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// MinTotal and MaxTotal are inclusive and only match orders in their
+	// currency.
+	MinTotal  *money.Money
+	MaxTotal  *money.Money
+	ProductID UUID
+	// Unpaid only matches orders without a charge that is authorized or
+	// captured.
+	Unpaid bool
+}
+
+var ErrInvalidFilter = fmt.Errorf("invalid order filter")
+
+func (f *OrderFilter) Validate() error {
+	for _, s := range f.Statuses {
+		if _, err := ParseOrderStatus(string(s)); err != nil {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidFilter, s)
+		}
+	}
+	if !f.CreatedFrom.IsZero() && !f.CreatedTo.IsZero() && !f.CreatedFrom.Before(f.CreatedTo) {
+		return fmt.Errorf("%w: created_from must be before created_to", ErrInvalidFilter)
+	}
+	if f.MinTotal != nil && f.MaxTotal != nil {
+		cmp, err := f.MinTotal.Compare(*f.MaxTotal)
+		if err != nil {
+			return fmt.Errorf("%w: min_total and max_total must be in the same currency", ErrInvalidFilter)
+		}
+		if cmp > 0 {
+			return fmt.Errorf("%w: min_total cannot exceed max_total", ErrInvalidFilter)
+		}
+	}
+	return nil
+}
+
+// TotalCurrency is the currency the total range applies to, or "" when the
+// filter has no total range.
+func (f *OrderFilter) TotalCurrency() string {
+	if f.MinTotal != nil {
+		return f.MinTotal.Currency
+	}
+	if f.MaxTotal != nil {
+		return f.MaxTotal.Currency
+	}
+	return ""
+}
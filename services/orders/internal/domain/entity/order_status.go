@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidStatus           = fmt.Errorf("invalid order status")
+	ErrInvalidStatusTransition = fmt.Errorf("invalid order status transition")
+	ErrStatusChanged           = fmt.Errorf("order status changed")
+)
+
+// orderTransitions lists the statuses an order may move to from each status.
+// Cancelled and refunded orders are final.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:    {OrderStatusProcessing, OrderStatusCancelled},
+	OrderStatusProcessing: {OrderStatusCompleted, OrderStatusCancelled},
+	OrderStatusCompleted:  {OrderStatusRefunded},
+}
+
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	status := OrderStatus(s)
+	switch status {
+	case OrderStatusPending, OrderStatusProcessing, OrderStatusCompleted, OrderStatusCancelled, OrderStatusRefunded:
+		return status, nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s OrderStatus) IsFinal() bool {
+	return len(orderTransitions[s]) == 0
+}
+
+// ActorSystem is the actor of status changes made without a caller, such as
+// those of background jobs.
+const ActorSystem = "system"
+
+// StatusTransition records a single status change of an order. The creation
+// of an order is recorded as a transition from the empty status. Changes of
+// the order's returns are recorded too, with ReturnID and ReturnStatus set
+// and the order's status left as it was.
+type StatusTransition struct {
+	OrderID      UUID
+	From         OrderStatus
+	To           OrderStatus
+	At           time.Time
+	Actor        string
+	Reason       string
+	ReturnID     UUID
+	ReturnStatus ReturnStatus
+}
+
+// TransitionCause says who asked for a status change and why.
+type TransitionCause struct {
+	Actor  string
+	Reason string
+}
+
+// TransitionTo moves the order to the next status and appends the change to
+// its status history, or fails with ErrInvalidStatusTransition when the move
+// is not allowed.
+func (o *Order) TransitionTo(next OrderStatus, cause TransitionCause, now time.Time) (StatusTransition, error) {
+	if !o.Status.CanTransitionTo(next) {
+		return StatusTransition{}, fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, o.Status, next)
+	}
+
+	transition := StatusTransition{
+		OrderID: o.ID,
+		From:    o.Status,
+		To:      next,
+		At:      now,
+		Actor:   cause.Actor,
+		Reason:  cause.Reason,
+	}
+	o.Status = next
+	o.UpdatedAt = now
+	o.StatusHistory = append(o.StatusHistory, transition)
+	return transition, nil
+}
+
+// TransitionHook is a side effect of a status change. Hooks run after the
+// change is persisted, so they see the order in its new status.
+type TransitionHook func(ctx context.Context, order *Order, transition StatusTransition) error
+
+// OrderStateMachine validates status changes and dispatches their hooks.
+type OrderStateMachine struct {
+	onEnter map[OrderStatus][]TransitionHook
+	onAny   []TransitionHook
+	tasks   map[OrderStatus][]OrderTaskKind
+}
+
+func NewOrderStateMachine() *OrderStateMachine {
+	return &OrderStateMachine{
+		onEnter: make(map[OrderStatus][]TransitionHook),
+		tasks:   make(map[OrderStatus][]OrderTaskKind),
+	}
+}
+
+// OnEnter registers a hook that runs whenever an order enters status.
+func (m *OrderStateMachine) OnEnter(status OrderStatus, hook TransitionHook) {
+	m.onEnter[status] = append(m.onEnter[status], hook)
+}
+
+// OnEnterTask makes every order entering status get a task of kind, stored
+// with the status change. Unlike a hook, the task is not lost when the
+// process dies before running it.
+func (m *OrderStateMachine) OnEnterTask(status OrderStatus, kind OrderTaskKind) {
+	m.tasks[status] = append(m.tasks[status], kind)
+}
+
+// OnTransition registers a hook that runs on every status change.
+func (m *OrderStateMachine) OnTransition(hook TransitionHook) {
+	m.onAny = append(m.onAny, hook)
+}
+
+// Transition moves the order to next and appends the tasks registered for
+// next to it.
+func (m *OrderStateMachine) Transition(order *Order, next OrderStatus, cause TransitionCause, now time.Time) (StatusTransition, error) {
+	transition, err := order.TransitionTo(next, cause, now)
+	if err != nil {
+		return StatusTransition{}, err
+	}
+	for _, kind := range m.tasks[next] {
+		order.Tasks = append(order.Tasks, NewOrderTask(order.ID, kind, "", now))
+	}
+	return transition, nil
+}
+
+// RunHooks runs every hook registered for the transition, in registration
+// order. A failing hook does not stop the others; their errors are joined.
+func (m *OrderStateMachine) RunHooks(ctx context.Context, order *Order, transition StatusTransition) error {
+	var errs []error
+	for _, hook := range m.onEnter[transition.To] {
+		if err := hook(ctx, order, transition); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, hook := range m.onAny {
+		if err := hook(ctx, order, transition); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
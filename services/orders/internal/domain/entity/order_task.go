@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OrderTask is work an order change leaves to be done elsewhere, such as
+// giving back the stock of a cancelled order. It is stored in the transaction
+// that makes the change, so the work is not lost if the process dies before
+// doing it, and it is retried until it succeeds.
+type OrderTask struct {
+	// ID is derived from the order and the change, so a change stored twice
+	// still has one task.
+	ID      string
+	OrderID UUID
+	Kind    OrderTaskKind
+	// Ref tells tasks of one kind and order apart, such as the edit a
+	// release_stock task gives stock back for.
+	Ref string
+	// Items is the stock a release_stock task gives back.
+	Items     []OrderItem
+	Status    OrderTaskStatus
+	Attempts  int
+	LastError string
+	// RunAt is when the task is next due.
+	RunAt     time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type OrderTaskKind string
+
+const (
+	// OrderTaskReturnStock gives the stock of a cancelled order back.
+	OrderTaskReturnStock OrderTaskKind = "return_stock"
+	// OrderTaskRefund pays back the captured charge of a cancelled order.
+	OrderTaskRefund OrderTaskKind = "refund"
+	// OrderTaskReleaseStock gives back the stock an item edit took off the
+	// order. Its Ref is the order's EditVersion after the edit.
+	OrderTaskReleaseStock OrderTaskKind = "release_stock"
+	// OrderTaskIssueInvoice invoices a completed order.
+	OrderTaskIssueInvoice OrderTaskKind = "issue_invoice"
+)
+
+type OrderTaskStatus string
+
+const (
+	OrderTaskPending OrderTaskStatus = "pending"
+	OrderTaskDone    OrderTaskStatus = "done"
+	// OrderTaskFailed tasks failed in a way retrying cannot fix.
+	OrderTaskFailed OrderTaskStatus = "failed"
+)
+
+// OrderTaskFilter selects tasks to run. Zero fields are ignored.
+type OrderTaskFilter struct {
+	OrderID UUID
+	// DueBy selects tasks due at that time.
+	DueBy time.Time
+	Limit int
+}
+
+var (
+	// ErrTaskNotRetryable marks task errors that running the task again
+	// cannot fix.
+	ErrTaskNotRetryable = fmt.Errorf("order task cannot succeed")
+)
+
+// NewOrderTask returns a pending task of the order, due at once.
+func NewOrderTask(orderID UUID, kind OrderTaskKind, ref string, now time.Time) OrderTask {
+	id := string(kind) + ":" + orderID.String()
+	if ref != "" {
+		id += ":" + ref
+	}
+	return OrderTask{
+		ID:        id,
+		OrderID:   orderID,
+		Kind:      kind,
+		Ref:       ref,
+		Status:    OrderTaskPending,
+		RunAt:     now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Fail records an attempt that failed with err. A task that can still
+// succeed is due again after retryIn.
+func (t *OrderTask) Fail(err error, retryIn time.Duration, now time.Time) {
+	t.Attempts++
+	t.LastError = err.Error()
+	t.UpdatedAt = now
+	if errors.Is(err, ErrTaskNotRetryable) {
+		t.Status = OrderTaskFailed
+		return
+	}
+	t.RunAt = now.Add(retryIn)
+}
+
+// Complete records a successful attempt.
+func (t *OrderTask) Complete(now time.Time) {
+	t.Attempts++
+	t.LastError = ""
+	t.Status = OrderTaskDone
+	t.UpdatedAt = now
+}
@@ -2,29 +2,120 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/ExonegeS/money"
 	"github.com/google/uuid"
 )
 
+// DefaultCurrency prices products that inventory reports without a currency.
+const DefaultCurrency = "USD"
+
 type Order struct {
 	ID          UUID
 	UserID      UUID
 	UserName    string
-	TotalAmount float64
+	TotalAmount money.Money
 	Status      OrderStatus
 	Items       []OrderItem
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// StatusHistory lists the order's status changes, oldest first. It is
+	// only loaded when asked for; transitions appended to it are stored
+	// with the order.
+	StatusHistory []StatusTransition
+	// CouponCode is the code the order is placed with. What it took off is
+	// kept in Discounts; TotalAmount is the subtotal less those.
+	CouponCode string
+	Discounts  []OrderDiscount
+	// TaxRegion is where the order is taxed, such as "US-CA". Tax that is
+	// not included in the prices is part of TotalAmount.
+	TaxRegion string
+	TaxLines  []TaxLine
+	// Addresses are set when the order is placed. Without a TaxRegion the
+	// order is taxed where it ships.
+	ShippingAddress *Address
+	BillingAddress  *Address
+	// EditVersion counts the item edits the order has had, so the work each
+	// edit leaves can be told apart.
+	EditVersion int
+	// Tasks appended to the order are stored with it, as work its change
+	// leaves to be done. They are never loaded with the order.
+	Tasks []OrderTask
+}
+
+// Subtotal is the value of the order's items before discounts.
+func (o *Order) Subtotal() (money.Money, error) {
+	subtotal := money.New(0, o.TotalAmount.Currency)
+	for _, item := range o.Items {
+		var err error
+		if subtotal, err = subtotal.Add(item.ProductPrice.Mul(item.Quantity)); err != nil {
+			return money.Money{}, err
+		}
+	}
+	return subtotal, nil
+}
+
+// DiscountTotal is what the order's discounts took off its subtotal.
+func (o *Order) DiscountTotal() money.Money {
+	total := money.New(0, o.TotalAmount.Currency)
+	for _, d := range o.Discounts {
+		if sum, err := total.Add(d.Amount); err == nil {
+			total = sum
+		}
+	}
+	return total
+}
+
+// TaxTotal is the tax on the order, whether or not its prices include it.
+func (o *Order) TaxTotal() money.Money {
+	total := money.New(0, o.TotalAmount.Currency)
+	for _, line := range o.TaxLines {
+		if sum, err := total.Add(line.Amount); err == nil {
+			total = sum
+		}
+	}
+	return total
+}
+
+// AddedTax is the tax charged on top of the item prices for productID.
+func (o *Order) AddedTax(productID UUID) money.Money {
+	total := money.New(0, o.TotalAmount.Currency)
+	for _, line := range o.TaxLines {
+		if line.ProductID != productID || line.Inclusive {
+			continue
+		}
+		if sum, err := total.Add(line.Amount); err == nil {
+			total = sum
+		}
+	}
+	return total
+}
+
+// FreeShipping reports whether a discount waives the order's shipping.
+func (o *Order) FreeShipping() bool {
+	for _, d := range o.Discounts {
+		if d.FreeShipping {
+			return true
+		}
+	}
+	return false
 }
 
 type OrderItem struct {
 	ProductID    UUID
 	ProductName  string
-	ProductPrice float64
+	ProductPrice money.Money
 	Quantity     int64
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	// ExpectedPrice is the unit price the client was quoted, if it sent
+	// one. It is checked when the stock is reserved and not stored.
+	ExpectedPrice *money.Money
+	// TaxClass comes from the product's category in inventory. It is only
+	// known while the order is placed and kept on its tax lines.
+	TaxClass string
 }
 
 type OrderStatus string
@@ -52,8 +143,43 @@ var (
 	ErrNotImplemented        = fmt.Errorf("not implemented")
 	ErrNotUpdated            = fmt.Errorf("not updated")
 	ErrOrderNotFound         = fmt.Errorf("order not found")
+	ErrOrderNotDeletable     = fmt.Errorf("only cancelled orders without payments can be deleted")
 	ErrItemNotFound          = fmt.Errorf("item not found")
 	ErrInvalidUUID           = fmt.Errorf("invalid UUID")
 	ErrInvalidQuantity       = fmt.Errorf("invalid quantity")
 	ErrInsufficientQuantity  = fmt.Errorf("insufficient stored items")
+	ErrReservationNotFound   = fmt.Errorf("stock reservation not found")
+	ErrReservationExpired    = fmt.Errorf("stock reservation expired")
+	ErrReleaseExceedsTaken   = fmt.Errorf("release exceeds the stock taken for the order")
+	ErrPriceMismatch         = fmt.Errorf("price changed since the order was quoted")
+
+	// ErrOrderPlaced marks CreateOrder errors that came after the order was
+	// stored. The order stands; saga recovery finishes what failed.
+	ErrOrderPlaced = fmt.Errorf("order was placed")
 )
+
+// PriceMismatch is an order line whose product no longer has the expected
+// price.
+type PriceMismatch struct {
+	ProductID UUID
+	Expected  money.Money
+	Current   money.Money
+}
+
+// PriceMismatchError lists the lines inventory refused to reserve because
+// their prices changed. It unwraps to ErrPriceMismatch.
+type PriceMismatchError struct {
+	Mismatches []PriceMismatch
+}
+
+func (e *PriceMismatchError) Error() string {
+	parts := make([]string, 0, len(e.Mismatches))
+	for _, m := range e.Mismatches {
+		parts = append(parts, fmt.Sprintf("product %s: expected %s, now %s", m.ProductID, m.Expected, m.Current))
+	}
+	return fmt.Sprintf("%v: %s", ErrPriceMismatch, strings.Join(parts, "; "))
+}
+
+func (e *PriceMismatchError) Unwrap() error {
+	return ErrPriceMismatch
+}
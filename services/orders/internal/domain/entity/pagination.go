@@ -12,20 +12,20 @@ type SortOption int
 const (
 	SortByUnknown SortOption = iota
 	SortByID
-	SortByPrice
-	SortByQuantity
 	SortByName
 	SortByCreatedAt
 	SortByUpdatedAt
+	SortByTotalAmount
+	SortByStatus
 )
 
 var validSortOptions = map[string]SortOption{
-	"id":         SortByID,
-	"price":      SortByPrice,
-	"quantity":   SortByQuantity,
-	"name":       SortByName,
-	"created_at": SortByCreatedAt,
-	"updated_at": SortByUpdatedAt,
+	"id":           SortByID,
+	"name":         SortByName,
+	"created_at":   SortByCreatedAt,
+	"updated_at":   SortByUpdatedAt,
+	"total_amount": SortByTotalAmount,
+	"status":       SortByStatus,
 }
 
 func ParseSortOption(s string) (SortOption, error) {
@@ -42,16 +42,16 @@ func (s SortOption) ColumnName() string {
 	switch s {
 	case SortByID:
 		return "id"
-	case SortByPrice:
-		return "price"
-	case SortByQuantity:
-		return "stock_quantity"
 	case SortByName:
-		return "name"
+		return "user_name"
 	case SortByCreatedAt:
 		return "created_at"
 	case SortByUpdatedAt:
 		return "updated_at"
+	case SortByTotalAmount:
+		return "total_amount"
+	case SortByStatus:
+		return "status"
 	default:
 		return ""
 	}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ExonegeS/money"
+)
+
+// Payment is one attempt to charge an order. It starts as an intent with the
+// provider, is authorized and then captured; only a captured payment moves
+// the order forward. Refunds are payments of kind PaymentKindRefund that
+// point at the captured charge through ParentID.
+type Payment struct {
+	ID                UUID
+	OrderID           UUID
+	Kind              PaymentKind
+	ParentID          UUID
+	Lines             []RefundLine
+	Amount            money.Money
+	Method            string
+	Status            PaymentStatus
+	ProviderReference string
+	FailureReason     string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// RefundLine is the part of a refund that belongs to one order line.
+type RefundLine struct {
+	ProductID UUID
+	Quantity  int64
+	Amount    money.Money
+}
+
+type PaymentKind string
+
+const (
+	PaymentKindCharge PaymentKind = "charge"
+	PaymentKindRefund PaymentKind = "refund"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending    PaymentStatus = "pending"
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	PaymentStatusCaptured   PaymentStatus = "captured"
+	PaymentStatusFailed     PaymentStatus = "failed"
+	PaymentStatusRefunded   PaymentStatus = "refunded"
+)
+
+var paymentTransitions = map[PaymentKind]map[PaymentStatus][]PaymentStatus{
+	PaymentKindCharge: {
+		PaymentStatusPending:    {PaymentStatusAuthorized, PaymentStatusFailed},
+		PaymentStatusAuthorized: {PaymentStatusCaptured, PaymentStatusFailed},
+	},
+	PaymentKindRefund: {
+		PaymentStatusPending: {PaymentStatusRefunded, PaymentStatusFailed},
+	},
+}
+
+var (
+	ErrPaymentNotFound          = fmt.Errorf("payment not found")
+	ErrPaymentDeclined          = fmt.Errorf("payment declined")
+	ErrInvalidPaymentTransition = fmt.Errorf("invalid payment status transition")
+	ErrOrderNotPayable          = fmt.Errorf("order cannot be paid in its current status")
+	ErrOrderAlreadyPaid         = fmt.Errorf("order is already paid")
+	ErrOrderNotPaid             = fmt.Errorf("order has no captured payment")
+	ErrOrderNotRefundable       = fmt.Errorf("order cannot be refunded in its current status")
+	ErrRefundExceedsCaptured    = fmt.Errorf("refund exceeds the captured amount")
+	ErrNothingToRefund          = fmt.Errorf("nothing left to refund")
+)
+
+// IsActive reports whether the payment may still be captured or already was.
+func (p *Payment) IsActive() bool {
+	return p.Status != PaymentStatusFailed
+}
+
+// TransitionTo moves the payment to next, recording reason if it failed.
+func (p *Payment) TransitionTo(next PaymentStatus, reason string, now time.Time) error {
+	allowed := false
+	for _, status := range paymentTransitions[p.Kind][p.Status] {
+		if status == next {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidPaymentTransition, p.Status, next)
+	}
+
+	p.Status = next
+	p.FailureReason = reason
+	p.UpdatedAt = now
+	return nil
+}
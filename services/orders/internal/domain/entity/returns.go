@@ -0,0 +1,218 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Return is a customer's request to send back items of a completed order.
+// It is requested, approved, received and then refunded; it may be rejected
+// until it is received, as receiving it puts its items back in stock. Each
+// change is recorded in the order's
+// status history.
+type Return struct {
+	ID       UUID
+	OrderID  UUID
+	Status   ReturnStatus
+	Items    []ReturnItem
+	RefundID UUID
+	// Reason is why the return was last moved, such as why it was rejected.
+	Reason    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ReturnItem is the part of a return that belongs to one order line.
+// Condition is known once the return is received.
+type ReturnItem struct {
+	ProductID UUID
+	Quantity  int64
+	Reason    string
+	Condition ItemCondition
+}
+
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "requested"
+	ReturnStatusApproved  ReturnStatus = "approved"
+	ReturnStatusReceived  ReturnStatus = "received"
+	ReturnStatusRefunded  ReturnStatus = "refunded"
+	ReturnStatusRejected  ReturnStatus = "rejected"
+)
+
+// ItemCondition is the state a returned item arrives in. Only resellable
+// items go back to inventory.
+type ItemCondition string
+
+const (
+	ItemConditionResellable ItemCondition = "resellable"
+	ItemConditionDamaged    ItemCondition = "damaged"
+)
+
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected},
+	ReturnStatusApproved:  {ReturnStatusReceived, ReturnStatusRejected},
+	ReturnStatusReceived:  {ReturnStatusRefunded},
+}
+
+var (
+	ErrReturnNotFound          = fmt.Errorf("return not found")
+	ErrInvalidReturn           = fmt.Errorf("invalid return")
+	ErrInvalidReturnTransition = fmt.Errorf("invalid return status transition")
+	ErrOrderNotReturnable      = fmt.Errorf("order cannot be returned in its current status")
+)
+
+func ParseItemCondition(s string) (ItemCondition, error) {
+	condition := ItemCondition(strings.ToLower(strings.TrimSpace(s)))
+	switch condition {
+	case ItemConditionResellable, ItemConditionDamaged:
+		return condition, nil
+	default:
+		return "", fmt.Errorf("%w: unknown item condition %q", ErrInvalidReturn, s)
+	}
+}
+
+// NewReturn requests the return of items of order that none of its other
+// returns, except rejected ones, already takes back. The request is recorded
+// in the order's status history.
+func NewReturn(order *Order, returns []*Return, items []ReturnItem, cause TransitionCause, now time.Time) (*Return, error) {
+	if order.Status != OrderStatusCompleted {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotReturnable, order.Status)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no items to return", ErrInvalidReturn)
+	}
+
+	left := ReturnableQuantities(order, returns)
+	r := &Return{
+		ID:        NewUUID(),
+		OrderID:   order.ID,
+		Status:    ReturnStatusRequested,
+		Reason:    cause.Reason,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	seen := make(map[UUID]bool, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+		if seen[item.ProductID] {
+			return nil, fmt.Errorf("%w: product %s is listed twice", ErrInvalidReturn, item.ProductID)
+		}
+		seen[item.ProductID] = true
+		if item.Quantity > left[item.ProductID] {
+			return nil, fmt.Errorf("%w: product %s has %d left to return", ErrInvalidReturn, item.ProductID, left[item.ProductID])
+		}
+		r.Items = append(r.Items, ReturnItem{ProductID: item.ProductID, Quantity: item.Quantity, Reason: strings.TrimSpace(item.Reason)})
+	}
+
+	order.StatusHistory = append(order.StatusHistory, r.historyEntry(order, cause, now))
+	return r, nil
+}
+
+// ReturnableQuantities maps each product of order to the quantity that none
+// of returns takes back. Rejected returns take nothing back.
+func ReturnableQuantities(order *Order, returns []*Return) map[UUID]int64 {
+	left := make(map[UUID]int64, len(order.Items))
+	for _, item := range order.Items {
+		left[item.ProductID] += item.Quantity
+	}
+	for _, r := range returns {
+		if r.Status == ReturnStatusRejected {
+			continue
+		}
+		for _, item := range r.Items {
+			left[item.ProductID] -= item.Quantity
+		}
+	}
+	return left
+}
+
+// TransitionTo moves the return to next and records the change in the
+// status history of order, the order it belongs to.
+func (r *Return) TransitionTo(order *Order, next ReturnStatus, cause TransitionCause, now time.Time) error {
+	allowed := false
+	for _, status := range returnTransitions[r.Status] {
+		if status == next {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidReturnTransition, r.Status, next)
+	}
+
+	r.Status = next
+	r.Reason = cause.Reason
+	r.UpdatedAt = now
+	order.StatusHistory = append(order.StatusHistory, r.historyEntry(order, cause, now))
+	return nil
+}
+
+// Receive records the condition each item arrived in and moves the return to
+// received. Every item needs a condition.
+func (r *Return) Receive(order *Order, conditions map[UUID]ItemCondition, cause TransitionCause, now time.Time) error {
+	if r.Status != ReturnStatusApproved {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidReturnTransition, r.Status, ReturnStatusReceived)
+	}
+	for id := range conditions {
+		if !r.hasItem(id) {
+			return fmt.Errorf("%w: product %s is not in the return", ErrInvalidReturn, id)
+		}
+	}
+	for i, item := range r.Items {
+		condition, ok := conditions[item.ProductID]
+		if !ok {
+			return fmt.Errorf("%w: no condition for product %s", ErrInvalidReturn, item.ProductID)
+		}
+		r.Items[i].Condition = condition
+	}
+	return r.TransitionTo(order, ReturnStatusReceived, cause, now)
+}
+
+// ResellableItems lists the received items that can go back to inventory.
+func (r *Return) ResellableItems() []OrderItem {
+	var items []OrderItem
+	for _, item := range r.Items {
+		if item.Condition == ItemConditionResellable {
+			items = append(items, OrderItem{ProductID: item.ProductID, Quantity: item.Quantity})
+		}
+	}
+	return items
+}
+
+// RefundLines lists what the refund of the return pays back.
+func (r *Return) RefundLines() []RefundLine {
+	lines := make([]RefundLine, 0, len(r.Items))
+	for _, item := range r.Items {
+		lines = append(lines, RefundLine{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+	return lines
+}
+
+func (r *Return) hasItem(productID UUID) bool {
+	for _, item := range r.Items {
+		if item.ProductID == productID {
+			return true
+		}
+	}
+	return false
+}
+
+// historyEntry records the return's current status in the order's history.
+// The order keeps its own status.
+func (r *Return) historyEntry(order *Order, cause TransitionCause, now time.Time) StatusTransition {
+	return StatusTransition{
+		OrderID:      order.ID,
+		From:         order.Status,
+		To:           order.Status,
+		At:           now,
+		Actor:        cause.Actor,
+		Reason:       cause.Reason,
+		ReturnID:     r.ID,
+		ReturnStatus: r.Status,
+	}
+}
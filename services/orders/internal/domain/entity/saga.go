@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrderSaga is the durable log of the stock reservations made while an
+// order is being created. It is written before every remote call, so an
+// interrupted CreateOrder can be compensated when the service restarts.
+type OrderSaga struct {
+	OrderID   UUID
+	Status    SagaStatus
+	Steps     []SagaStep
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SagaStep struct {
+	ProductID UUID
+	Quantity  int64
+	Status    SagaStepStatus
+	UpdatedAt time.Time
+}
+
+type SagaStatus string
+
+const (
+	SagaStatusStarted      SagaStatus = "started"
+	SagaStatusCompleted    SagaStatus = "completed"
+	SagaStatusCompensating SagaStatus = "compensating"
+	SagaStatusCompensated  SagaStatus = "compensated"
+)
+
+type SagaStepStatus string
+
+const (
+	// SagaStepPending means the reservation was requested but its outcome
+	// has not been recorded yet.
+	SagaStepPending  SagaStepStatus = "pending"
+	SagaStepReserved SagaStepStatus = "reserved"
+	SagaStepReleased SagaStepStatus = "released"
+	SagaStepFailed   SagaStepStatus = "failed"
+)
+
+var (
+	ErrSagaNotFound = fmt.Errorf("saga not found")
+)
+
+func NewOrderSaga(order *Order, now time.Time) *OrderSaga {
+	saga := &OrderSaga{
+		OrderID:   order.ID,
+		Status:    SagaStatusStarted,
+		Steps:     make([]SagaStep, 0, len(order.Items)),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, item := range order.Items {
+		saga.Steps = append(saga.Steps, SagaStep{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Status:    SagaStepPending,
+			UpdatedAt: now,
+		})
+	}
+	return saga
+}
+
+func (s *OrderSaga) IsFinished() bool {
+	return s.Status == SagaStatusCompleted || s.Status == SagaStatusCompensated
+}
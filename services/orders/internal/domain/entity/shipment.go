@@ -0,0 +1,172 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Address is where an order ships or is billed to. Country is an ISO 3166-1
+// alpha-2 code and Region the state or province within it.
+type Address struct {
+	Name       string
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	Country    string
+	Phone      string
+}
+
+// Shipment is a parcel of some of an order's items. It is shipped when it is
+// created, and the order completes once all its items are delivered.
+type Shipment struct {
+	ID             UUID
+	OrderID        UUID
+	Carrier        string
+	TrackingNumber string
+	Status         ShipmentStatus
+	Items          []ShipmentItem
+	ShippedAt      time.Time
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type ShipmentItem struct {
+	ProductID UUID
+	Quantity  int64
+}
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusShipped   ShipmentStatus = "shipped"
+	ShipmentStatusDelivered ShipmentStatus = "delivered"
+)
+
+var (
+	ErrInvalidAddress    = fmt.Errorf("invalid address")
+	ErrShipmentNotFound  = fmt.Errorf("shipment not found")
+	ErrInvalidShipment   = fmt.Errorf("invalid shipment")
+	ErrOrderNotShippable = fmt.Errorf("order cannot be shipped in its current status")
+)
+
+func (a *Address) Validate() error {
+	if strings.TrimSpace(a.Line1) == "" || strings.TrimSpace(a.City) == "" {
+		return fmt.Errorf("%w: line1 and city cannot be empty", ErrInvalidAddress)
+	}
+	if len(a.Country) != 2 {
+		return fmt.Errorf("%w: country must be a two-letter code", ErrInvalidAddress)
+	}
+	return nil
+}
+
+// TaxRegion is the region the address is taxed in, such as "US-CA", or just
+// the country when the address has no region.
+func (a *Address) TaxRegion() string {
+	country := strings.ToUpper(a.Country)
+	if a.Region == "" {
+		return country
+	}
+	return country + "-" + strings.ToUpper(a.Region)
+}
+
+// NewShipment ships items of order that none of shipments holds yet. Items
+// for the same product are merged; no items ships everything left.
+func NewShipment(order *Order, shipments []*Shipment, carrier, trackingNumber string, items []ShipmentItem, now time.Time) (*Shipment, error) {
+	if order.Status != OrderStatusProcessing {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotShippable, order.Status)
+	}
+	if strings.TrimSpace(carrier) == "" || strings.TrimSpace(trackingNumber) == "" {
+		return nil, fmt.Errorf("%w: carrier and tracking number cannot be empty", ErrInvalidShipment)
+	}
+
+	left := UnshippedQuantities(order, shipments)
+	if len(items) == 0 {
+		for _, item := range order.Items {
+			if left[item.ProductID] > 0 {
+				items = append(items, ShipmentItem{ProductID: item.ProductID, Quantity: left[item.ProductID]})
+			}
+		}
+		if len(items) == 0 {
+			return nil, fmt.Errorf("%w: nothing left to ship", ErrInvalidShipment)
+		}
+	}
+
+	shipment := &Shipment{
+		ID:             NewUUID(),
+		OrderID:        order.ID,
+		Carrier:        strings.TrimSpace(carrier),
+		TrackingNumber: strings.TrimSpace(trackingNumber),
+		Status:         ShipmentStatusShipped,
+		ShippedAt:      now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	index := make(map[UUID]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+		if i, ok := index[item.ProductID]; ok {
+			shipment.Items[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.ProductID] = len(shipment.Items)
+		shipment.Items = append(shipment.Items, ShipmentItem{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+	for _, item := range shipment.Items {
+		if item.Quantity > left[item.ProductID] {
+			return nil, fmt.Errorf("%w: product %s has %d left to ship", ErrInvalidShipment, item.ProductID, left[item.ProductID])
+		}
+	}
+	return shipment, nil
+}
+
+// UnshippedQuantities maps each product of order to the quantity none of
+// shipments holds.
+func UnshippedQuantities(order *Order, shipments []*Shipment) map[UUID]int64 {
+	left := make(map[UUID]int64, len(order.Items))
+	for _, item := range order.Items {
+		left[item.ProductID] += item.Quantity
+	}
+	for _, shipment := range shipments {
+		for _, item := range shipment.Items {
+			left[item.ProductID] -= item.Quantity
+		}
+	}
+	return left
+}
+
+// AllDelivered reports whether shipments delivered every item of order.
+func AllDelivered(order *Order, shipments []*Shipment) bool {
+	var delivered []*Shipment
+	for _, shipment := range shipments {
+		if shipment.Status == ShipmentStatusDelivered {
+			delivered = append(delivered, shipment)
+		}
+	}
+	for _, left := range UnshippedQuantities(order, delivered) {
+		if left > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MarkDelivered records the delivery at at. It reports false when the
+// shipment was already delivered.
+func (s *Shipment) MarkDelivered(at, now time.Time) (bool, error) {
+	if s.Status == ShipmentStatusDelivered {
+		return false, nil
+	}
+	if at.Before(s.ShippedAt) {
+		return false, fmt.Errorf("%w: delivered before it was shipped", ErrInvalidShipment)
+	}
+	s.Status = ShipmentStatusDelivered
+	s.DeliveredAt = at
+	s.UpdatedAt = now
+	return true, nil
+}
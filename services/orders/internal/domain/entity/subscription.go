@@ -0,0 +1,222 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Subscription orders the same items for a user on a cadence, from StartAt
+// until EndAt, if set. NextRunAt is the cycle the scheduler orders next; a
+// paused subscription keeps it but is not ordered for. When a cycle comes
+// the scheduler places a regular order, or, if inventory is short, follows
+// StockPolicy.
+type Subscription struct {
+	ID          UUID
+	UserID      UUID
+	UserName    string
+	Items       []SubscriptionItem
+	Cadence     Cadence
+	StockPolicy StockPolicy
+	Status      SubscriptionStatus
+	StartAt     time.Time
+	EndAt       time.Time
+	NextRunAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SubscriptionItem struct {
+	ProductID UUID
+	Quantity  int64
+}
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusActive SubscriptionStatus = "active"
+	SubscriptionStatusPaused SubscriptionStatus = "paused"
+	SubscriptionStatusEnded  SubscriptionStatus = "ended"
+)
+
+// StockPolicy is what a cycle does when inventory cannot supply every item
+// in full: skip orders nothing until the next cycle, partial orders what is
+// in stock.
+type StockPolicy string
+
+const (
+	StockPolicySkip    StockPolicy = "skip"
+	StockPolicyPartial StockPolicy = "partial"
+)
+
+// SubscriptionRun is the outcome of one cycle of a subscription. OrderID is
+// set when an order was placed.
+type SubscriptionRun struct {
+	ID             UUID
+	SubscriptionID UUID
+	ScheduledAt    time.Time
+	OrderID        UUID
+	Status         SubscriptionRunStatus
+	Reason         string
+	CreatedAt      time.Time
+}
+
+type SubscriptionRunStatus string
+
+const (
+	SubscriptionRunOrdered SubscriptionRunStatus = "ordered"
+	SubscriptionRunPartial SubscriptionRunStatus = "partial"
+	SubscriptionRunSkipped SubscriptionRunStatus = "skipped"
+	SubscriptionRunFailed  SubscriptionRunStatus = "failed"
+)
+
+var (
+	ErrSubscriptionNotFound          = fmt.Errorf("subscription not found")
+	ErrInvalidSubscription           = fmt.Errorf("invalid subscription")
+	ErrInvalidSubscriptionTransition = fmt.Errorf("invalid subscription status transition")
+)
+
+func ParseStockPolicy(s string) (StockPolicy, error) {
+	policy := StockPolicy(strings.ToLower(strings.TrimSpace(s)))
+	switch policy {
+	case "":
+		return StockPolicySkip, nil
+	case StockPolicySkip, StockPolicyPartial:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: unknown stock policy %q", ErrInvalidSubscription, s)
+	}
+}
+
+// NewSubscription starts a subscription from draft, which needs the user,
+// items, cadence and dates. It starts now if draft has no StartAt, and its
+// first cycle is the first one at or after both.
+func NewSubscription(draft Subscription, now time.Time) (*Subscription, error) {
+	draft.UserName = strings.TrimSpace(draft.UserName)
+	if draft.UserName == "" {
+		return nil, fmt.Errorf("%w: no user name", ErrInvalidSubscription)
+	}
+	if len(draft.Items) == 0 {
+		return nil, fmt.Errorf("%w: no items", ErrInvalidSubscription)
+	}
+	seen := make(map[UUID]bool, len(draft.Items))
+	for _, item := range draft.Items {
+		if item.Quantity <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+		if seen[item.ProductID] {
+			return nil, fmt.Errorf("%w: product %s is listed twice", ErrInvalidSubscription, item.ProductID)
+		}
+		seen[item.ProductID] = true
+	}
+	if err := draft.Cadence.Validate(); err != nil {
+		return nil, err
+	}
+	if draft.StockPolicy == "" {
+		draft.StockPolicy = StockPolicySkip
+	}
+
+	s := draft
+	s.ID = NewUUID()
+	s.Status = SubscriptionStatusActive
+	if s.StartAt.IsZero() {
+		s.StartAt = now
+	}
+	s.NextRunAt = s.Cadence.First(s.StartAt)
+	if s.NextRunAt.Before(now) {
+		s.NextRunAt = s.Cadence.Next(s.NextRunAt, now)
+	}
+	if !s.EndAt.IsZero() && !s.NextRunAt.Before(s.EndAt) {
+		return nil, fmt.Errorf("%w: it ends before its first cycle", ErrInvalidSubscription)
+	}
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	return &s, nil
+}
+
+// Due reports whether the subscription has a cycle to order at now.
+func (s *Subscription) Due(now time.Time) bool {
+	return s.Status == SubscriptionStatusActive && !s.NextRunAt.After(now)
+}
+
+// Advance moves the subscription past its due cycle and returns that
+// cycle. Cycles missed in the meantime, such as while the scheduler was
+// down, are skipped rather than ordered all at once. The subscription ends
+// when no cycle is left before EndAt.
+func (s *Subscription) Advance(now time.Time) (time.Time, error) {
+	if !s.Due(now) {
+		return time.Time{}, fmt.Errorf("%w: subscription is %s and next runs at %s", ErrInvalidSubscriptionTransition, s.Status, s.NextRunAt)
+	}
+	scheduled := s.NextRunAt
+	s.NextRunAt = s.Cadence.Next(scheduled, now)
+	s.endIfOver()
+	s.UpdatedAt = now
+	return scheduled, nil
+}
+
+func (s *Subscription) Pause(now time.Time) error {
+	if s.Status != SubscriptionStatusActive {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidSubscriptionTransition, s.Status, SubscriptionStatusPaused)
+	}
+	s.Status = SubscriptionStatusPaused
+	s.UpdatedAt = now
+	return nil
+}
+
+// Resume picks a paused subscription up at its next cycle after now; the
+// cycles it missed while paused are not ordered.
+func (s *Subscription) Resume(now time.Time) error {
+	if s.Status != SubscriptionStatusPaused {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidSubscriptionTransition, s.Status, SubscriptionStatusActive)
+	}
+	s.Status = SubscriptionStatusActive
+	if s.NextRunAt.Before(now) {
+		s.NextRunAt = s.Cadence.Next(s.NextRunAt, now)
+	}
+	s.endIfOver()
+	s.UpdatedAt = now
+	return nil
+}
+
+// Cancel ends the subscription now.
+func (s *Subscription) Cancel(now time.Time) error {
+	if s.Status == SubscriptionStatusEnded {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidSubscriptionTransition, s.Status, SubscriptionStatusEnded)
+	}
+	s.Status = SubscriptionStatusEnded
+	if s.EndAt.IsZero() || s.EndAt.After(now) {
+		s.EndAt = now
+	}
+	s.UpdatedAt = now
+	return nil
+}
+
+// PlanItems is what a cycle orders, given the stock available of each
+// product. When inventory is short of an item, the skip policy orders
+// nothing and the partial policy orders what is in stock; shortfall then
+// says what was missing. No items means the cycle is skipped.
+func (s *Subscription) PlanItems(available map[UUID]int64) (items []OrderItem, shortfall string) {
+	var short []string
+	for _, item := range s.Items {
+		quantity := min(item.Quantity, max(available[item.ProductID], 0))
+		if quantity < item.Quantity {
+			short = append(short, fmt.Sprintf("%s: %d of %d in stock", item.ProductID, quantity, item.Quantity))
+		}
+		if quantity > 0 {
+			items = append(items, OrderItem{ProductID: item.ProductID, Quantity: quantity})
+		}
+	}
+	if len(short) == 0 {
+		return items, ""
+	}
+	if s.StockPolicy != StockPolicyPartial {
+		items = nil
+	}
+	return items, strings.Join(short, "; ")
+}
+
+func (s *Subscription) endIfOver() {
+	if s.NextRunAt.IsZero() || (!s.EndAt.IsZero() && !s.NextRunAt.Before(s.EndAt)) {
+		s.Status = SubscriptionStatusEnded
+	}
+}
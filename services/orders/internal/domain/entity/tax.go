@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/ExonegeS/money"
+)
+
+// StandardTaxClass is the tax class of products whose category has none.
+const StandardTaxClass = "standard"
+
+// TaxRateScale is the denominator of TaxLine.Rate.
+const TaxRateScale = 100000
+
+// TaxLine is the tax on one order item, worked out when the order is placed
+// and kept as it was, like its discounts.
+type TaxLine struct {
+	ProductID UUID
+	TaxClass  string
+	Region    string
+	// Rate is in thousandths of a percent: 7250 is 7.25%.
+	Rate int64
+	// Taxable is what the item sold for after discounts. When Inclusive,
+	// Amount is part of it; otherwise Amount is charged on top.
+	Taxable   money.Money
+	Amount    money.Money
+	Inclusive bool
+}
+
+var ErrInvalidTaxRules = fmt.Errorf("invalid tax rules")
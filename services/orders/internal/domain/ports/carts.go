@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type CartRepository interface {
+	// GetCart fails with ErrCartNotFound when the user has no cart. Expired
+	// carts are returned as they are.
+	GetCart(ctx context.Context, userID entity.UUID) (*entity.Cart, error)
+	// UpdateCart locks the user's cart, or hands updateFn an empty one with
+	// no CreatedAt when there is none, and stores what updateFn leaves.
+	UpdateCart(ctx context.Context, userID entity.UUID, updateFn func(*entity.Cart) (bool, error)) error
+	// DeleteExpiredCarts removes the carts that expired by now and reports
+	// how many there were.
+	DeleteExpiredCarts(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+// CouponRepository stores coupons. Redemptions are recorded by
+// OrdersRepository.SaveOrder, in the transaction that saves the order.
+type CouponRepository interface {
+	SaveCoupon(ctx context.Context, coupon entity.Coupon) error
+	GetCouponByID(ctx context.Context, id entity.UUID) (*entity.Coupon, error)
+	GetCouponByCode(ctx context.Context, code string) (*entity.Coupon, error)
+	UpdateCouponByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Coupon) (bool, error)) error
+	GetTotalCouponsCount(ctx context.Context) (int64, error)
+	GetAllCoupons(ctx context.Context, pagination *entity.Pagination) ([]*entity.Coupon, error)
+	// CountUserRedemptions counts the orders userID placed with the coupon.
+	CountUserRedemptions(ctx context.Context, couponID, userID entity.UUID) (int64, error)
+}
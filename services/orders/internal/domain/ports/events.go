@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+// EventPublisher delivers outbox events to their consumers. Publish may be
+// called again for an event it already delivered.
+type EventPublisher interface {
+	Publish(ctx context.Context, event entity.Event) error
+}
+
+type OutboxRepository interface {
+	// PublishOutboxEvents locks up to limit unpublished events, oldest first,
+	// and hands them to publishFn. publishFn returns how many of them it
+	// delivered, in order; those are marked published even when it also
+	// returns an error, and the rest stay in the outbox for the next call.
+	PublishOutboxEvents(ctx context.Context, limit int, publishFn func([]entity.Event) (int, error)) (int, error)
+}
+
+type OrderEventsRepository interface {
+	// ListOrderEvents returns up to limit events of the order recorded after
+	// position afterSeq, oldest first, whether they were published or not.
+	ListOrderEvents(ctx context.Context, orderID entity.UUID, afterSeq int64, limit int) ([]*entity.OrderEvent, error)
+	// GetOrderSnapshot returns the order together with the position of its
+	// newest event, or 0 when it has none, both read at the same point in
+	// time.
+	GetOrderSnapshot(ctx context.Context, orderID entity.UUID) (*entity.Order, int64, error)
+}
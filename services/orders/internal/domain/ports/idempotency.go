@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type IdempotencyRepository interface {
+	// ClaimIdempotencyKey stores record unless a live record already holds
+	// its scope and key, in which case that record is returned instead. An
+	// expired record is replaced.
+	ClaimIdempotencyKey(ctx context.Context, record entity.IdempotencyRecord) (*entity.IdempotencyRecord, error)
+	// RenewIdempotencyKey moves the expiry of a claim that has not completed
+	// to expiresAt.
+	RenewIdempotencyKey(ctx context.Context, scope, key string, expiresAt time.Time) error
+	CompleteIdempotencyKey(ctx context.Context, scope, key string, response []byte, expiresAt time.Time) error
+	// ReleaseIdempotencyKey drops a claim that has not completed, so the
+	// request can be retried under the same key.
+	ReleaseIdempotencyKey(ctx context.Context, scope, key string) error
+}
@@ -8,5 +8,10 @@ import (
 
 type InventoryService interface {
 	GetProduct(ctx context.Context, productID entity.UUID) (*entity.OrderItem, error)
-	ReserveItem(ctx context.Context, productID entity.UUID, quantity int64) (bool, error)
+	ReserveItems(ctx context.Context, orderID entity.UUID, items []entity.OrderItem) error
+	ConfirmReservation(ctx context.Context, orderID entity.UUID) error
+	ReleaseReservation(ctx context.Context, orderID entity.UUID) error
+	// ReleaseItems returns stock taken for the order. Inventory applies each
+	// releaseID once, so the call can be retried.
+	ReleaseItems(ctx context.Context, releaseID string, orderID entity.UUID, items []entity.OrderItem) error
 }
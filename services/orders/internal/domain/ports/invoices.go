@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type InvoiceRepository interface {
+	// IssueInvoice locks the order and, unless it already has an invoice,
+	// stores the one issueFn makes from it under the next number of the
+	// invoice's year. The number is taken in the same transaction, so an
+	// invoice that is not stored leaves no gap. It returns the order's
+	// invoice either way.
+	IssueInvoice(ctx context.Context, orderID entity.UUID, issueFn func(*entity.Order) (*entity.Invoice, error)) (*entity.Invoice, error)
+	GetInvoiceByID(ctx context.Context, id entity.UUID) (*entity.Invoice, error)
+	GetInvoiceByOrderID(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error)
+}
+
+// InvoiceRenderer renders an invoice as a document for people to read.
+type InvoiceRenderer interface {
+	RenderHTML(invoice *entity.Invoice) (string, error)
+	RenderText(invoice *entity.Invoice) (string, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// Locker hands out locks shared by every replica of the service.
+type Locker interface {
+	// TryLock takes the lock named key without waiting. ok is false when
+	// someone else holds it; otherwise release must be called once done.
+	TryLock(ctx context.Context, key string) (release func(), ok bool, err error)
+}
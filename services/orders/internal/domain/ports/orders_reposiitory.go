@@ -11,6 +11,18 @@ type OrdersRepository interface {
 	SaveOrder(ctx context.Context, item entity.Order) error
 	UpdateOrderByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Order) (bool, error)) error
 	DeleteOrderByID(ctx context.Context, id entity.UUID) error
-	GetTotalOrdersCount(ctx context.Context) (int64, error)
-	GetAllOrders(ctx context.Context, pagination *entity.Pagination) ([]*entity.Order, error)
+	// GetOrderStatusHistory lists the status changes of an order, oldest
+	// first, or fails with entity.ErrOrderNotFound.
+	GetOrderStatusHistory(ctx context.Context, id entity.UUID) ([]entity.StatusTransition, error)
+	GetTotalOrdersCount(ctx context.Context, filter entity.OrderFilter) (int64, error)
+	GetAllOrders(ctx context.Context, filter entity.OrderFilter, pagination *entity.Pagination) ([]*entity.Order, error)
+
+	// GetOrderTasks lists the pending tasks matching filter, those due
+	// first. Tasks are stored by UpdateOrderByID from the order's Tasks.
+	GetOrderTasks(ctx context.Context, filter entity.OrderTaskFilter) ([]*entity.OrderTask, error)
+	// RunOrderTask locks the pending task with id and hands it to runFn with
+	// its order, then stores the task as runFn left it. It returns
+	// entity.ErrNotUpdated when the task is no longer pending or is being
+	// run by another caller.
+	RunOrderTask(ctx context.Context, id string, runFn func(*entity.Order, *entity.OrderTask)) error
 }
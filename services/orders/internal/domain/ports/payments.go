@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+// PaymentProvider talks to the payment processor. Calls are keyed by the
+// provider reference returned from CreateIntent and must be safe to retry.
+type PaymentProvider interface {
+	CreateIntent(ctx context.Context, payment entity.Payment) (reference string, err error)
+	// Authorize returns an error wrapping entity.ErrPaymentDeclined when the
+	// processor refuses the payment.
+	Authorize(ctx context.Context, reference string) error
+	Capture(ctx context.Context, reference string, amount money.Money) error
+	// Refund returns part of a captured payment. refundID identifies the
+	// refund, so retrying it never pays out twice.
+	Refund(ctx context.Context, reference string, refundID entity.UUID, amount money.Money) error
+}
+
+type PaymentsRepository interface {
+	SavePayment(ctx context.Context, payment entity.Payment) error
+	GetPaymentByID(ctx context.Context, id entity.UUID) (*entity.Payment, error)
+	GetPaymentsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Payment, error)
+	UpdatePaymentByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Payment) (bool, error)) error
+	// CreateRefund locks the charge, hands it to createFn together with its
+	// refunds so far and stores the refund createFn returns. Concurrent refunds
+	// of one charge are serialized.
+	CreateRefund(ctx context.Context, chargeID entity.UUID, createFn func(charge *entity.Payment, refunds []*entity.Payment) (*entity.Payment, error)) error
+}
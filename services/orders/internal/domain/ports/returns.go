@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type ReturnRepository interface {
+	// CreateReturn locks the order, hands it to createFn together with its
+	// returns so far and stores the return createFn gives back along with
+	// the order's new status history.
+	CreateReturn(ctx context.Context, orderID entity.UUID, createFn func(order *entity.Order, returns []*entity.Return) (*entity.Return, error)) error
+	GetReturnByID(ctx context.Context, id entity.UUID) (*entity.Return, error)
+	GetReturnsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Return, error)
+	// UpdateReturnByID locks the return and its order and stores the changes
+	// updateFn makes to the return and to the order's status history.
+	UpdateReturnByID(ctx context.Context, id entity.UUID, updateFn func(order *entity.Order, r *entity.Return) (bool, error)) error
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type OrderSagaRepository interface {
+	CreateSaga(ctx context.Context, saga entity.OrderSaga) error
+	UpdateSagaStatus(ctx context.Context, orderID entity.UUID, status entity.SagaStatus, reason string) error
+	UpdateSagaStep(ctx context.Context, orderID entity.UUID, step entity.SagaStep) error
+	// GetUnfinishedSagas lists the sagas still started or compensating that
+	// were last updated before updatedBefore, oldest first.
+	GetUnfinishedSagas(ctx context.Context, updatedBefore time.Time) ([]*entity.OrderSaga, error)
+}
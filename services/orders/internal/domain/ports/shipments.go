@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type ShipmentRepository interface {
+	// CreateShipment locks the order, hands it to createFn together with its
+	// shipments so far and stores the shipment createFn returns. Concurrent
+	// shipments of one order are serialized, so an item is never shipped
+	// twice.
+	CreateShipment(ctx context.Context, orderID entity.UUID, createFn func(order *entity.Order, shipments []*entity.Shipment) (*entity.Shipment, error)) error
+	GetShipmentByID(ctx context.Context, id entity.UUID) (*entity.Shipment, error)
+	GetShipmentsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Shipment, error)
+	UpdateShipmentByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Shipment) (bool, error)) error
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type SubscriptionRepository interface {
+	CreateSubscription(ctx context.Context, subscription *entity.Subscription) error
+	GetSubscriptionByID(ctx context.Context, id entity.UUID) (*entity.Subscription, error)
+	GetSubscriptionsByUserID(ctx context.Context, userID entity.UUID) ([]*entity.Subscription, error)
+	// GetDueSubscriptions lists up to limit active subscriptions with a
+	// cycle due by now, the most overdue first.
+	GetDueSubscriptions(ctx context.Context, now time.Time, limit int) ([]*entity.Subscription, error)
+	// UpdateSubscriptionByID locks the subscription and stores the changes
+	// updateFn makes to it.
+	UpdateSubscriptionByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Subscription) (bool, error)) error
+	SaveSubscriptionRun(ctx context.Context, run *entity.SubscriptionRun) error
+	// GetSubscriptionRuns lists the subscription's runs, newest first.
+	GetSubscriptionRuns(ctx context.Context, subscriptionID entity.UUID) ([]*entity.SubscriptionRun, error)
+}
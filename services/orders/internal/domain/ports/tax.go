@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+// TaxCalculator works out the tax on a new order from its items, discounts
+// and TaxRegion. An order that is not taxed gets no lines.
+type TaxCalculator interface {
+	Calculate(ctx context.Context, order *entity.Order) ([]entity.TaxLine, error)
+}
@@ -0,0 +1,226 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockCartRepository struct {
+	carts *fakeStore[entity.Cart]
+}
+
+func newMockCartRepository() *mockCartRepository {
+	return &mockCartRepository{carts: newFakeStore[entity.Cart](entity.ErrCartNotFound)}
+}
+
+func (m *mockCartRepository) GetCart(ctx context.Context, userID entity.UUID) (*entity.Cart, error) {
+	cart, err := m.carts.get(userID)
+	if err != nil {
+		return nil, err
+	}
+	cart.Items = slices.Clone(cart.Items)
+	return cart, nil
+}
+func (m *mockCartRepository) UpdateCart(ctx context.Context, userID entity.UUID, updateFn func(*entity.Cart) (bool, error)) error {
+	cart, err := m.GetCart(ctx, userID)
+	if errors.Is(err, entity.ErrCartNotFound) {
+		cart = &entity.Cart{UserID: userID}
+	}
+	updated, err := updateFn(cart)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return entity.ErrNotUpdated
+	}
+	m.carts.put(userID, *cart)
+	return nil
+}
+func (m *mockCartRepository) DeleteExpiredCarts(ctx context.Context, now time.Time) (int64, error) {
+	var deleted int64
+	for userID, cart := range m.carts.items {
+		if cart.Expired(now) {
+			delete(m.carts.items, userID)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+const cartTTL = 24 * time.Hour
+
+func newCartsFixture(stock map[entity.UUID]int64) (*testEnv, *mockCartRepository, application.CartsService) {
+	env := newTestEnv(stock)
+	cartRepo := newMockCartRepository()
+	return env, cartRepo, application.NewCartsService(cartRepo, env.orders, env.inventory, cartTTL, env.timeSource)
+}
+
+func TestCart_AddUpdateRemove(t *testing.T) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	_, _, service := newCartsFixture(map[entity.UUID]int64{p1: 5, p2: 1})
+	ctx := context.Background()
+	userID := entity.NewUUID()
+
+	if _, err := service.AddCartItem(ctx, userID, p1, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	cart, err := service.AddCartItem(ctx, userID, p1, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cart.Items) != 1 || cart.Items[0].Quantity != 3 {
+		t.Fatalf("expected adding a product twice to sum its quantity, got %+v", cart.Items)
+	}
+	if cart.Items[0].UnitPrice.Amount != 1000 || cart.Items[0].AvailableQuantity != 5 {
+		t.Errorf("expected the item priced from inventory, got %+v", cart.Items[0])
+	}
+
+	if _, err := service.AddCartItem(ctx, userID, p2, 2); !errors.Is(err, entity.ErrInsufficientQuantity) {
+		t.Errorf("expected ErrInsufficientQuantity, got %v", err)
+	}
+	if _, err := service.AddCartItem(ctx, userID, entity.NewUUID(), 1); !errors.Is(err, entity.ErrItemNotFound) {
+		t.Errorf("expected ErrItemNotFound for an unknown product, got %v", err)
+	}
+	if _, err := service.UpdateCartItem(ctx, userID, p2, 1); !errors.Is(err, entity.ErrCartItemNotFound) {
+		t.Errorf("expected ErrCartItemNotFound, got %v", err)
+	}
+
+	if _, err := service.AddCartItem(ctx, userID, p2, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	cart, err = service.UpdateCartItem(ctx, userID, p1, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cart.Items) != 1 || cart.Items[0].ProductID != p2 {
+		t.Fatalf("expected a zero quantity to remove the item, got %+v", cart.Items)
+	}
+	subtotal, err := cart.Subtotal()
+	if err != nil || subtotal.Amount != 1000 {
+		t.Errorf("expected a subtotal of 1000, got %v (%v)", subtotal, err)
+	}
+
+	cart, err = service.RemoveCartItem(ctx, userID, p2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cart.Items) != 0 {
+		t.Errorf("expected an empty cart, got %+v", cart.Items)
+	}
+}
+
+func TestCart_ExpiresAfterTTL(t *testing.T) {
+	p1 := entity.NewUUID()
+	env, cartRepo, service := newCartsFixture(map[entity.UUID]int64{p1: 5})
+	ctx := context.Background()
+	userID := entity.NewUUID()
+
+	if _, err := service.AddCartItem(ctx, userID, p1, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	env.now = env.now.Add(cartTTL - time.Minute)
+	if _, err := service.AddCartItem(ctx, userID, p1, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	env.now = env.now.Add(cartTTL - time.Minute)
+	cart, err := service.GetCart(ctx, userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cart.Items) != 1 || cart.Items[0].Quantity != 3 {
+		t.Fatalf("expected changing the cart to renew its TTL, got %+v", cart.Items)
+	}
+
+	env.now = env.now.Add(time.Minute)
+	cart, err = service.GetCart(ctx, userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cart.Items) != 0 {
+		t.Errorf("expected an expired cart to read as empty, got %+v", cart.Items)
+	}
+
+	cart, err = service.AddCartItem(ctx, userID, p1, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cart.Items) != 1 || cart.Items[0].Quantity != 1 {
+		t.Errorf("expected an expired cart to start afresh, got %+v", cart.Items)
+	}
+
+	env.now = env.now.Add(cartTTL)
+	deleted, err := service.PurgeExpiredCarts(ctx)
+	if err != nil || deleted != 1 || len(cartRepo.carts.items) != 0 {
+		t.Errorf("expected the expired cart to be purged, got %d (%v)", deleted, err)
+	}
+}
+
+func TestCheckout_PlacesOrderAndEmptiesCart(t *testing.T) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	env, cartRepo, service := newCartsFixture(map[entity.UUID]int64{p1: 5, p2: 5})
+	ctx := context.Background()
+	userID := entity.NewUUID()
+
+	if _, err := service.Checkout(ctx, userID, &entity.Order{UserName: "John"}); !errors.Is(err, entity.ErrCartEmpty) {
+		t.Errorf("expected ErrCartEmpty without a cart, got %v", err)
+	}
+
+	if _, err := service.AddCartItem(ctx, userID, p1, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AddCartItem(ctx, userID, p2, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	order, err := service.Checkout(ctx, userID, &entity.Order{UserName: "John"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := env.ordersRepo.orders[order.ID]; !ok {
+		t.Fatal("expected the order to be created")
+	}
+	if order.UserID != userID || len(order.Items) != 2 || order.TotalAmount.Amount != 3000 {
+		t.Errorf("expected the order to hold the cart's items, got %+v", order)
+	}
+
+	if items := cartRepo.carts.items[userID].Items; len(items) != 0 {
+		t.Errorf("expected checkout to empty the cart, got %+v", items)
+	}
+	if _, err := service.Checkout(ctx, userID, &entity.Order{UserName: "John"}); !errors.Is(err, entity.ErrCartEmpty) {
+		t.Errorf("expected ErrCartEmpty after checkout, got %v", err)
+	}
+}
+
+func TestCheckout_KeepsCartWhenOrderFails(t *testing.T) {
+	p1 := entity.NewUUID()
+	env, cartRepo, service := newCartsFixture(map[entity.UUID]int64{p1: 5})
+	ctx := context.Background()
+	userID := entity.NewUUID()
+
+	if _, err := service.AddCartItem(ctx, userID, p1, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	env.now = env.now.Add(cartTTL)
+
+	if _, err := service.Checkout(ctx, userID, &entity.Order{UserName: "John"}); !errors.Is(err, entity.ErrCartEmpty) {
+		t.Errorf("expected ErrCartEmpty for an expired cart, got %v", err)
+	}
+	if len(env.ordersRepo.orders) != 0 {
+		t.Error("expected no order for an expired cart")
+	}
+
+	env.now = env.now.Add(-time.Hour)
+	if _, err := service.Checkout(ctx, userID, &entity.Order{UserName: "John", CouponCode: "MISSING"}); !errors.Is(err, entity.ErrCouponNotFound) {
+		t.Fatalf("expected ErrCouponNotFound, got %v", err)
+	}
+	if items := cartRepo.carts.items[userID].Items; len(items) != 1 {
+		t.Errorf("expected a failed checkout to keep the cart, got %+v", items)
+	}
+}
@@ -0,0 +1,251 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+// mockCouponRepository counts a user's redemptions from the orders saved in
+// orders, the way the database joins order_discounts to orders.
+type mockCouponRepository struct {
+	coupons *fakeStore[entity.Coupon]
+	orders  *mockOrdersRepository
+}
+
+func newMockCouponRepository() *mockCouponRepository {
+	return &mockCouponRepository{coupons: newFakeStore[entity.Coupon](entity.ErrCouponNotFound)}
+}
+
+func (m *mockCouponRepository) SaveCoupon(ctx context.Context, coupon entity.Coupon) error {
+	if _, err := m.GetCouponByCode(ctx, coupon.Code); err == nil {
+		return entity.ErrCouponAlreadyExists
+	}
+	m.coupons.put(coupon.ID, coupon)
+	return nil
+}
+func (m *mockCouponRepository) GetCouponByID(ctx context.Context, id entity.UUID) (*entity.Coupon, error) {
+	return m.coupons.get(id)
+}
+func (m *mockCouponRepository) GetCouponByCode(ctx context.Context, code string) (*entity.Coupon, error) {
+	found := m.coupons.find(func(coupon *entity.Coupon) bool { return coupon.Code == code })
+	if len(found) == 0 {
+		return nil, entity.ErrCouponNotFound
+	}
+	return found[0], nil
+}
+func (m *mockCouponRepository) UpdateCouponByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Coupon) (bool, error)) error {
+	return m.coupons.update(id, updateFn)
+}
+func (m *mockCouponRepository) GetTotalCouponsCount(ctx context.Context) (int64, error) {
+	return int64(len(m.coupons.items)), nil
+}
+func (m *mockCouponRepository) GetAllCoupons(ctx context.Context, pagination *entity.Pagination) ([]*entity.Coupon, error) {
+	return m.coupons.find(func(*entity.Coupon) bool { return true }), nil
+}
+func (m *mockCouponRepository) CountUserRedemptions(ctx context.Context, couponID, userID entity.UUID) (int64, error) {
+	if m.orders == nil {
+		return 0, nil
+	}
+	var count int64
+	for _, order := range m.orders.orders {
+		for _, d := range order.Discounts {
+			if d.CouponID == couponID && order.UserID == userID {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func TestCoupon_Apply(t *testing.T) {
+	now := fixedTime()
+	subtotal := money.New(5000, "USD")
+
+	tests := []struct {
+		name         string
+		coupon       entity.Coupon
+		wantAmount   money.Money
+		wantShipping bool
+		wantErr      error
+	}{
+		{
+			name:       "percentage",
+			coupon:     entity.Coupon{Kind: entity.CouponPercentage, PercentOff: 1250},
+			wantAmount: money.New(625, "USD"),
+		},
+		{
+			name:       "fixed amount",
+			coupon:     entity.Coupon{Kind: entity.CouponFixedAmount, AmountOff: money.New(700, "USD")},
+			wantAmount: money.New(700, "USD"),
+		},
+		{
+			name:       "fixed amount never exceeds the subtotal",
+			coupon:     entity.Coupon{Kind: entity.CouponFixedAmount, AmountOff: money.New(9000, "USD")},
+			wantAmount: subtotal,
+		},
+		{
+			name:         "free shipping",
+			coupon:       entity.Coupon{Kind: entity.CouponFreeShipping},
+			wantAmount:   money.New(0, "USD"),
+			wantShipping: true,
+		},
+		{
+			name:       "minimum order value met",
+			coupon:     entity.Coupon{Kind: entity.CouponPercentage, PercentOff: 1000, MinOrderValue: money.New(5000, "USD")},
+			wantAmount: money.New(500, "USD"),
+		},
+		{
+			name:    "minimum order value not met",
+			coupon:  entity.Coupon{Kind: entity.CouponPercentage, PercentOff: 1000, MinOrderValue: money.New(5001, "USD")},
+			wantErr: entity.ErrCouponNotApplicable,
+		},
+		{
+			name:    "other currency",
+			coupon:  entity.Coupon{Kind: entity.CouponFixedAmount, AmountOff: money.New(700, "EUR")},
+			wantErr: entity.ErrCouponNotApplicable,
+		},
+		{
+			name:    "not valid yet",
+			coupon:  entity.Coupon{Kind: entity.CouponFreeShipping, ValidFrom: now.Add(time.Second)},
+			wantErr: entity.ErrCouponNotApplicable,
+		},
+		{
+			name:    "expired",
+			coupon:  entity.Coupon{Kind: entity.CouponFreeShipping, ValidUntil: now},
+			wantErr: entity.ErrCouponNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.coupon.Code = "TEST"
+			tt.coupon.Active = true
+			discount, err := tt.coupon.Apply(subtotal, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if discount.Amount != tt.wantAmount || discount.FreeShipping != tt.wantShipping {
+				t.Errorf("expected %v off (free shipping %v), got %v (%v)", tt.wantAmount, tt.wantShipping, discount.Amount, discount.FreeShipping)
+			}
+		})
+	}
+
+	inactive := entity.Coupon{Code: "OFF", Kind: entity.CouponFreeShipping}
+	if _, err := inactive.Apply(subtotal, now); !errors.Is(err, entity.ErrCouponNotApplicable) {
+		t.Errorf("expected an inactive coupon not to apply, got %v", err)
+	}
+}
+
+func TestCoupon_CheckUsage(t *testing.T) {
+	coupon := entity.Coupon{Code: "LIMITED", MaxRedemptions: 10, MaxRedemptionsPerUser: 2, Redemptions: 9}
+	if err := coupon.CheckUsage(1); err != nil {
+		t.Errorf("expected a redemption to be left, got %v", err)
+	}
+	if err := coupon.CheckUsage(2); !errors.Is(err, entity.ErrCouponExhausted) {
+		t.Errorf("expected the per-user limit to be reached, got %v", err)
+	}
+	coupon.Redemptions = 10
+	if err := coupon.CheckUsage(0); !errors.Is(err, entity.ErrCouponExhausted) {
+		t.Errorf("expected the global limit to be reached, got %v", err)
+	}
+}
+
+func TestCreateOrder_AppliesCoupon(t *testing.T) {
+	p1 := entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{p1: 5})
+	env.addCoupon(entity.Coupon{Code: "SAVE10", Kind: entity.CouponPercentage, PercentOff: 1000})
+	service := env.orders
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 3})
+	order.CouponCode = " save10 "
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if order.TotalAmount != money.New(2700, "USD") {
+		t.Errorf("expected 3000 less 10%%, got %v", order.TotalAmount)
+	}
+	if len(order.Discounts) != 1 || order.Discounts[0].Code != "SAVE10" || order.Discounts[0].Amount != money.New(300, "USD") {
+		t.Fatalf("expected the discount to be recorded, got %+v", order.Discounts)
+	}
+	subtotal, err := order.Subtotal()
+	if err != nil || subtotal != money.New(3000, "USD") {
+		t.Errorf("expected a subtotal of 3000, got %v (%v)", subtotal, err)
+	}
+}
+
+func TestCreateOrder_CouponPerUserLimit(t *testing.T) {
+	p1 := entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{p1: 5})
+	env.addCoupon(entity.Coupon{Code: "ONCE", Kind: entity.CouponFixedAmount, AmountOff: money.New(100, "USD"), MaxRedemptionsPerUser: 1})
+	service, inventory := env.orders, env.inventory
+	ctx := context.Background()
+
+	first := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	first.CouponCode = "ONCE"
+	if err := service.CreateOrder(ctx, first); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	second := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	second.UserID = first.UserID
+	second.CouponCode = "ONCE"
+	if err := service.CreateOrder(ctx, second); !errors.Is(err, entity.ErrCouponExhausted) {
+		t.Fatalf("expected ErrCouponExhausted, got %v", err)
+	}
+	if inventory.stock[p1] != 4 {
+		t.Errorf("expected only the first order to take stock, %d left", inventory.stock[p1])
+	}
+
+	other := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	other.CouponCode = "ONCE"
+	if err := service.CreateOrder(ctx, other); err != nil {
+		t.Errorf("expected another user to redeem the coupon, got %v", err)
+	}
+
+	unknown := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	unknown.CouponCode = "NOPE"
+	if err := service.CreateOrder(ctx, unknown); !errors.Is(err, entity.ErrCouponNotFound) {
+		t.Errorf("expected ErrCouponNotFound, got %v", err)
+	}
+}
+
+func TestRefundOrder_PaysBackDiscountedPrice(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusPending)
+	ctx := context.Background()
+	order.Discounts = []entity.OrderDiscount{{Code: "FIFTH", Kind: entity.CouponPercentage, PercentOff: 2000, Amount: money.New(500, "USD")}}
+	order.TotalAmount = money.New(2000, "USD")
+
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	order.Status = entity.OrderStatusCompleted
+
+	p2 := order.Items[1].ProductID
+	refund, err := service.RefundOrder(ctx, order.ID, application.RefundParams{Lines: []entity.RefundLine{{ProductID: p2, Quantity: 1}}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if refund.Amount != money.New(400, "USD") {
+		t.Errorf("expected 500 less 20%%, got %v", refund.Amount)
+	}
+}
@@ -0,0 +1,104 @@
+package unit
+
+import (
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+// fakeStore keeps the entities of a fake repository by id. Like a database,
+// it hands out copies and keeps an update only when updateFn succeeds.
+type fakeStore[T any] struct {
+	items    map[entity.UUID]*T
+	notFound error
+}
+
+func newFakeStore[T any](notFound error) *fakeStore[T] {
+	return &fakeStore[T]{items: make(map[entity.UUID]*T), notFound: notFound}
+}
+
+func (s *fakeStore[T]) get(id entity.UUID) (*T, error) {
+	item, ok := s.items[id]
+	if !ok {
+		return nil, s.notFound
+	}
+	copied := *item
+	return &copied, nil
+}
+
+func (s *fakeStore[T]) put(id entity.UUID, item T) {
+	s.items[id] = &item
+}
+
+// find returns copies of the entities match accepts, in no particular order.
+func (s *fakeStore[T]) find(match func(*T) bool) []*T {
+	var found []*T
+	for _, item := range s.items {
+		if match(item) {
+			copied := *item
+			found = append(found, &copied)
+		}
+	}
+	return found
+}
+
+func (s *fakeStore[T]) update(id entity.UUID, updateFn func(*T) (bool, error)) error {
+	item, err := s.get(id)
+	if err != nil {
+		return err
+	}
+	updated, err := updateFn(item)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return entity.ErrNotUpdated
+	}
+	s.items[id] = item
+	return nil
+}
+
+// testEnv is an orders service on fake repositories and inventory, for the
+// tests of the services built on it. Every service of the env reads the time
+// from now.
+type testEnv struct {
+	ordersRepo *mockOrdersRepository
+	couponRepo *mockCouponRepository
+	inventory  *mockInventoryClient
+	orders     application.OrdersService
+	now        time.Time
+}
+
+func newTestEnv(stock map[entity.UUID]int64) *testEnv {
+	e := &testEnv{
+		ordersRepo: newMockOrdersRepository(),
+		couponRepo: newMockCouponRepository(),
+		inventory:  newMockInventoryClient(stock),
+		now:        fixedTime(),
+	}
+	e.couponRepo.orders = e.ordersRepo
+	e.orders = application.NewOrdersService(e.ordersRepo, newMockSagaRepository(), e.couponRepo, nil, e.inventory, nil, e.timeSource)
+	return e
+}
+
+func (e *testEnv) timeSource() time.Time {
+	return e.now
+}
+
+// addOrder stores an order with items in status, as if placed earlier.
+func (e *testEnv) addOrder(status entity.OrderStatus, items ...entity.OrderItem) *entity.Order {
+	order := newOrder(items...)
+	order.ID = entity.NewUUID()
+	order.Status = status
+	e.ordersRepo.orders[order.ID] = order
+	return order
+}
+
+// addCoupon stores coupon as an active coupon.
+func (e *testEnv) addCoupon(coupon entity.Coupon) *entity.Coupon {
+	coupon.ID = entity.NewUUID()
+	coupon.Active = true
+	e.couponRepo.coupons.put(coupon.ID, coupon)
+	return &coupon
+}
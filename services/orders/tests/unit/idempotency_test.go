@@ -0,0 +1,192 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strconv"
+	"testing"
+	"time"
+
+	ordersgrpc "github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/inbound/grpc"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type mockIdempotencyRepository struct {
+	records map[string]*entity.IdempotencyRecord
+}
+
+func newMockIdempotencyRepository() *mockIdempotencyRepository {
+	return &mockIdempotencyRepository{records: make(map[string]*entity.IdempotencyRecord)}
+}
+
+func (m *mockIdempotencyRepository) ClaimIdempotencyKey(ctx context.Context, record entity.IdempotencyRecord) (*entity.IdempotencyRecord, error) {
+	id := record.Scope + "|" + record.Key
+	if existing, ok := m.records[id]; ok && existing.IsLive(record.CreatedAt) {
+		copied := *existing
+		return &copied, nil
+	}
+	m.records[id] = &record
+	return nil, nil
+}
+func (m *mockIdempotencyRepository) RenewIdempotencyKey(ctx context.Context, scope, key string, expiresAt time.Time) error {
+	if record, ok := m.records[scope+"|"+key]; ok && !record.Completed {
+		record.ExpiresAt = expiresAt
+	}
+	return nil
+}
+func (m *mockIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, scope, key string, response []byte, expiresAt time.Time) error {
+	record := m.records[scope+"|"+key]
+	record.Completed = true
+	record.Response = response
+	record.ExpiresAt = expiresAt
+	return nil
+}
+func (m *mockIdempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, scope, key string) error {
+	if record, ok := m.records[scope+"|"+key]; ok && !record.Completed {
+		delete(m.records, scope+"|"+key)
+	}
+	return nil
+}
+
+// createOrderCall runs CreateOrder requests through the idempotency
+// interceptor. The handler numbers the orders it creates.
+type createOrderCall struct {
+	now         time.Time
+	calls       int
+	fail        bool
+	interceptor grpc.UnaryServerInterceptor
+	// failAfterSave makes the handler create the order and fail anyway.
+	failAfterSave bool
+}
+
+func newCreateOrderCall(window time.Duration) *createOrderCall {
+	c := &createOrderCall{now: fixedTime()}
+	service := application.NewIdempotencyService(newMockIdempotencyRepository(), window, func() time.Time { return c.now })
+	c.interceptor = ordersgrpc.NewIdempotencyInterceptor(service, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return c
+}
+
+func (c *createOrderCall) do(key string, req *ordersgrpc.CreateOrderRequest) (*ordersgrpc.OrderResponse, error) {
+	ctx := context.Background()
+	if key != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ordersgrpc.IdempotencyKeyHeader, key))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: ordersgrpc.OrdersService_CreateOrder_FullMethodName}
+	resp, err := c.interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		if c.fail {
+			return nil, status.Error(codes.Unavailable, "inventory unavailable")
+		}
+		c.calls++
+		resp := &ordersgrpc.OrderResponse{Order: &ordersgrpc.Order{Id: strconv.Itoa(c.calls)}}
+		if c.failAfterSave {
+			return resp, status.Error(codes.Internal, "order was placed, but not every step finished")
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ordersgrpc.OrderResponse), nil
+}
+
+func TestIdempotency_ReplaysOriginalResponse(t *testing.T) {
+	call := newCreateOrderCall(time.Hour)
+	req := &ordersgrpc.CreateOrderRequest{UserId: "u1", Name: "Ann", Items: []*ordersgrpc.OrderItemCreate{{ProductId: "p1", Quantity: 2}}}
+
+	first, err := call.do("key-1", req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	again, err := call.do("key-1", req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if call.calls != 1 || again.GetOrder().GetId() != first.GetOrder().GetId() {
+		t.Errorf("expected the first order to be replayed, got %d calls and order %s", call.calls, again.GetOrder().GetId())
+	}
+
+	if _, err := call.do("", req); err != nil || call.calls != 2 {
+		t.Errorf("expected a request without a key to run, got %d calls (%v)", call.calls, err)
+	}
+
+	call.now = call.now.Add(2 * time.Hour)
+	if _, err := call.do("key-1", req); err != nil || call.calls != 3 {
+		t.Errorf("expected an expired key to run again, got %d calls (%v)", call.calls, err)
+	}
+}
+
+func TestIdempotency_RejectsKeyReusedForOtherRequest(t *testing.T) {
+	call := newCreateOrderCall(time.Hour)
+
+	if _, err := call.do("key-1", &ordersgrpc.CreateOrderRequest{UserId: "u1", Name: "Ann"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err := call.do("key-1", &ordersgrpc.CreateOrderRequest{UserId: "u1", Name: "Bob"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists, got %v", err)
+	}
+}
+
+func TestIdempotency_FailedRequestCanBeRetried(t *testing.T) {
+	call := newCreateOrderCall(time.Hour)
+	req := &ordersgrpc.CreateOrderRequest{UserId: "u1", Name: "Ann", IdempotencyKey: "key-1"}
+
+	call.fail = true
+	if _, err := call.do("", req); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected the handler's error, got %v", err)
+	}
+
+	call.fail = false
+	resp, err := call.do("", req)
+	if err != nil {
+		t.Fatalf("expected the retry to run, got %v", err)
+	}
+	if call.calls != 1 || resp.GetOrder().GetId() != "1" {
+		t.Errorf("expected one order from the retry, got %d calls", call.calls)
+	}
+}
+
+func TestIdempotency_FailureAfterChangeIsReplayed(t *testing.T) {
+	call := newCreateOrderCall(time.Hour)
+	req := &ordersgrpc.CreateOrderRequest{UserId: "u1", Name: "Ann", IdempotencyKey: "key-1"}
+
+	call.failAfterSave = true
+	if _, err := call.do("", req); status.Code(err) != codes.Internal {
+		t.Fatalf("expected the handler's error, got %v", err)
+	}
+
+	call.failAfterSave = false
+	resp, err := call.do("", req)
+	if err != nil {
+		t.Fatalf("expected the retry to be replayed, got %v", err)
+	}
+	if call.calls != 1 || resp.GetOrder().GetId() != "1" {
+		t.Errorf("expected the order from the first call, got %d calls and order %s", call.calls, resp.GetOrder().GetId())
+	}
+}
+
+func TestIdempotencyService_InProgressKey(t *testing.T) {
+	service := application.NewIdempotencyService(newMockIdempotencyRepository(), time.Hour, fixedTime)
+	ctx := context.Background()
+
+	_, _, err := service.Execute(ctx, "scope", "key-1", "fp", func(ctx context.Context) ([]byte, error) {
+		_, _, err := service.Execute(ctx, "scope", "key-1", "fp", func(ctx context.Context) ([]byte, error) {
+			t.Fatal("expected the nested call not to run")
+			return nil, nil
+		})
+		if !errors.Is(err, entity.ErrIdempotencyKeyInProgress) {
+			t.Errorf("expected ErrIdempotencyKeyInProgress, got %v", err)
+		}
+		return []byte("done"), nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
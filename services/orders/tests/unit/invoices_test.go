@@ -0,0 +1,239 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/invoices"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+type mockInvoiceRepository struct {
+	orders    *mockOrdersRepository
+	invoices  map[entity.UUID]*entity.Invoice
+	sequences map[int]int64
+	// issueFailures makes the next IssueInvoice calls fail.
+	issueFailures int
+}
+
+func newMockInvoiceRepository(orders *mockOrdersRepository) *mockInvoiceRepository {
+	return &mockInvoiceRepository{
+		orders:    orders,
+		invoices:  make(map[entity.UUID]*entity.Invoice),
+		sequences: make(map[int]int64),
+	}
+}
+
+func (m *mockInvoiceRepository) IssueInvoice(ctx context.Context, orderID entity.UUID, issueFn func(*entity.Order) (*entity.Invoice, error)) (*entity.Invoice, error) {
+	if m.issueFailures > 0 {
+		m.issueFailures--
+		return nil, errors.New("database unavailable")
+	}
+	order, err := m.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice, err := m.GetInvoiceByOrderID(ctx, orderID); err == nil {
+		return invoice, nil
+	}
+	invoice, err := issueFn(order)
+	if err != nil {
+		return nil, err
+	}
+	m.sequences[invoice.Year]++
+	invoice.AssignNumber(m.sequences[invoice.Year])
+	m.invoices[invoice.ID] = invoice
+	return invoice, nil
+}
+func (m *mockInvoiceRepository) GetInvoiceByID(ctx context.Context, id entity.UUID) (*entity.Invoice, error) {
+	invoice, ok := m.invoices[id]
+	if !ok {
+		return nil, entity.ErrInvoiceNotFound
+	}
+	return invoice, nil
+}
+func (m *mockInvoiceRepository) GetInvoiceByOrderID(ctx context.Context, orderID entity.UUID) (*entity.Invoice, error) {
+	for _, invoice := range m.invoices {
+		if invoice.OrderID == orderID {
+			return invoice, nil
+		}
+	}
+	return nil, entity.ErrInvoiceNotFound
+}
+
+func newInvoiceableOrder(ordersRepo *mockOrdersRepository) *entity.Order {
+	productID := entity.NewUUID()
+	order := newOrder(entity.OrderItem{
+		ProductID:    productID,
+		ProductName:  "Mug <Large>",
+		ProductPrice: money.New(1000, "USD"),
+		Quantity:     3,
+	})
+	order.ID = entity.NewUUID()
+	order.Status = entity.OrderStatusCompleted
+	order.ShippingAddress = &entity.Address{Line1: "1 Main St", City: "Los Angeles", Region: "CA", PostalCode: "90001", Country: "US"}
+	order.Discounts = []entity.OrderDiscount{{Code: "SAVE10", Amount: money.New(300, "USD")}}
+	order.TaxLines = []entity.TaxLine{{ProductID: productID, Rate: 7250, Amount: money.New(196, "USD")}}
+	order.TotalAmount = money.New(2896, "USD")
+	ordersRepo.orders[order.ID] = order
+	return order
+}
+
+func TestIssueInvoice_SnapshotsOrder(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	renderer, err := invoices.NewTemplateRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := application.NewInvoicesService(newMockInvoiceRepository(ordersRepo), renderer, fixedTime)
+	order := newInvoiceableOrder(ordersRepo)
+
+	invoice, err := service.IssueInvoice(context.Background(), order.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if invoice.Number != "INV-2024-000001" {
+		t.Errorf("expected the first number of 2024, got %q", invoice.Number)
+	}
+	if invoice.Subtotal != money.New(3000, "USD") || invoice.DiscountTotal != money.New(300, "USD") ||
+		invoice.TaxTotal != money.New(196, "USD") || invoice.Total != money.New(2896, "USD") {
+		t.Errorf("expected the order's totals, got %+v", invoice)
+	}
+	if len(invoice.Lines) != 1 || invoice.Lines[0].Amount != money.New(3000, "USD") || invoice.Lines[0].TaxRate != 7250 {
+		t.Errorf("expected one line with its tax, got %+v", invoice.Lines)
+	}
+	if invoice.BillingAddress == nil || invoice.BillingAddress.Line1 != "1 Main St" {
+		t.Errorf("expected the shipping address to be billed, got %+v", invoice.BillingAddress)
+	}
+
+	// Later changes to the order do not reach its invoice.
+	order.Items[0].ProductName = "Renamed"
+	again, err := service.GetOrderInvoice(context.Background(), order.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if again.ID != invoice.ID || again.Lines[0].ProductName != "Mug <Large>" {
+		t.Errorf("expected the issued invoice back, got %+v", again)
+	}
+}
+
+func TestIssueInvoice_GaplessPerYear(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	now := fixedTime()
+	service := application.NewInvoicesService(newMockInvoiceRepository(ordersRepo), nil, func() time.Time { return now })
+
+	issue := func() string {
+		t.Helper()
+		invoice, err := service.IssueInvoice(context.Background(), newInvoiceableOrder(ordersRepo).ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return invoice.Number
+	}
+
+	first, second := issue(), issue()
+	if first != "INV-2024-000001" || second != "INV-2024-000002" {
+		t.Errorf("expected consecutive numbers, got %q and %q", first, second)
+	}
+	now = now.AddDate(1, 0, 0)
+	if next := issue(); next != "INV-2025-000001" {
+		t.Errorf("expected numbering to restart in 2025, got %q", next)
+	}
+}
+
+func TestIssueInvoice_OrderNotCompleted(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	invoiceRepo := newMockInvoiceRepository(ordersRepo)
+	service := application.NewInvoicesService(invoiceRepo, nil, fixedTime)
+	order := newInvoiceableOrder(ordersRepo)
+	order.Status = entity.OrderStatusProcessing
+
+	if _, err := service.IssueInvoice(context.Background(), order.ID); !errors.Is(err, entity.ErrOrderNotInvoiceable) {
+		t.Fatalf("expected ErrOrderNotInvoiceable, got %v", err)
+	}
+	if invoiceRepo.sequences[2024] != 0 {
+		t.Errorf("expected no number to be used, got %d", invoiceRepo.sequences[2024])
+	}
+}
+
+func TestIssueOnComplete_RetriedUntilIssued(t *testing.T) {
+	env := newTestEnv(nil)
+	invoiceRepo := newMockInvoiceRepository(env.ordersRepo)
+	invoicesService := application.NewInvoicesService(invoiceRepo, nil, env.timeSource)
+	stateMachine := entity.NewOrderStateMachine()
+	env.orders = application.NewOrdersService(env.ordersRepo, newMockSagaRepository(), env.couponRepo, nil, env.inventory, stateMachine, env.timeSource)
+	stateMachine.OnEnterTask(entity.OrderStatusCompleted, entity.OrderTaskIssueInvoice)
+	env.orders.HandleTask(entity.OrderTaskIssueInvoice, application.IssueOnComplete(invoicesService))
+
+	order := newInvoiceableOrder(env.ordersRepo)
+	order.Status = entity.OrderStatusProcessing
+	ctx := context.Background()
+
+	invoiceRepo.issueFailures = 1
+	completed := entity.OrderStatusCompleted
+	if _, err := env.orders.UpdateOrder(ctx, order.ID, application.UpdateOrderParams{Status: &completed}); err == nil {
+		t.Fatal("expected the failed invoice to be reported")
+	}
+	if len(invoiceRepo.invoices) != 0 {
+		t.Fatalf("expected no invoice yet, got %d", len(invoiceRepo.invoices))
+	}
+
+	env.now = env.now.Add(time.Minute)
+	if ran, err := env.orders.RunDueTasks(ctx, 10); err != nil || ran != 1 {
+		t.Fatalf("expected the invoice task to be retried, ran %d: %v", ran, err)
+	}
+	if _, err := invoicesService.GetOrderInvoice(ctx, order.ID); err != nil {
+		t.Errorf("expected the retry to invoice the order, got %v", err)
+	}
+	if ran, _ := env.orders.RunDueTasks(ctx, 10); ran != 0 || len(invoiceRepo.invoices) != 1 {
+		t.Errorf("expected the order to be invoiced once, ran %d more, %d invoices", ran, len(invoiceRepo.invoices))
+	}
+}
+
+func TestGetOrderInvoice_DoesNotIssue(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	invoiceRepo := newMockInvoiceRepository(ordersRepo)
+	service := application.NewInvoicesService(invoiceRepo, nil, fixedTime)
+	order := newInvoiceableOrder(ordersRepo)
+
+	if _, err := service.GetOrderInvoice(context.Background(), order.ID); !errors.Is(err, entity.ErrInvoiceNotFound) {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+	if len(invoiceRepo.invoices) != 0 || invoiceRepo.sequences[2024] != 0 {
+		t.Errorf("expected nothing to be issued, got %d invoices", len(invoiceRepo.invoices))
+	}
+}
+
+func TestRenderInvoice(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	renderer, err := invoices.NewTemplateRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := application.NewInvoicesService(newMockInvoiceRepository(ordersRepo), renderer, fixedTime)
+	invoice, err := service.IssueInvoice(context.Background(), newInvoiceableOrder(ordersRepo).ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	rendered, err := service.RenderInvoice(invoice)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for name, doc := range map[string]string{"html": rendered.HTML, "text": rendered.Text} {
+		if !strings.Contains(doc, "INV-2024-000001") || !strings.Contains(doc, invoice.Total.String()) || !strings.Contains(doc, "7.25%") {
+			t.Errorf("expected the %s invoice to show its number, total and tax rate, got:\n%s", name, doc)
+		}
+	}
+	if !strings.Contains(rendered.HTML, "Mug &lt;Large&gt;") {
+		t.Errorf("expected product names to be escaped in html, got:\n%s", rendered.HTML)
+	}
+	if !strings.Contains(rendered.Text, "Mug <Large>") {
+		t.Errorf("expected product names verbatim in text, got:\n%s", rendered.Text)
+	}
+}
@@ -0,0 +1,244 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+// racingOrdersRepository runs race on the stored order just before each
+// update, as a concurrent change would.
+type racingOrdersRepository struct {
+	*mockOrdersRepository
+	race func(*entity.Order)
+}
+
+func (r *racingOrdersRepository) UpdateOrderByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Order) (bool, error)) error {
+	if order, ok := r.orders[id]; ok && r.race != nil {
+		r.race(order)
+	}
+	return r.mockOrdersRepository.UpdateOrderByID(ctx, id, updateFn)
+}
+
+func newEditFixture(t *testing.T) (*racingOrdersRepository, *mockInventoryClient, application.OrdersService, *entity.Order, [3]entity.UUID) {
+	t.Helper()
+	products := [3]entity.UUID{entity.NewUUID(), entity.NewUUID(), entity.NewUUID()}
+	inventory := newMockInventoryClient(map[entity.UUID]int64{products[0]: 5, products[1]: 5, products[2]: 5})
+	ordersRepo := &racingOrdersRepository{mockOrdersRepository: newMockOrdersRepository()}
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, nil, fixedTime)
+
+	order := newOrder(
+		entity.OrderItem{ProductID: products[0], Quantity: 2},
+		entity.OrderItem{ProductID: products[1], Quantity: 1},
+	)
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return ordersRepo, inventory, service, order, products
+}
+
+func TestEditOrderItems_ReservesAndReleasesDelta(t *testing.T) {
+	_, inventory, service, order, p := newEditFixture(t)
+	ctx := application.WithActor(context.Background(), "customer-1")
+
+	edited, err := service.EditOrderItems(ctx, order.ID, application.EditOrderItemsParams{
+		Items:  []entity.OrderItem{{ProductID: p[0], Quantity: 3}, {ProductID: p[2], Quantity: 1}},
+		Reason: "changed my mind",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if inventory.stock[p[0]] != 2 || inventory.stock[p[1]] != 5 || inventory.stock[p[2]] != 4 {
+		t.Errorf("expected only the difference to move, got stock %v", inventory.stock)
+	}
+	if inventory.confirmed[p[0]] != 3 || inventory.confirmed[p[1]] != 0 || inventory.confirmed[p[2]] != 1 {
+		t.Errorf("expected the added stock to be confirmed, got %v", inventory.confirmed)
+	}
+	if len(inventory.holds) != 0 {
+		t.Errorf("expected no stock left held, got %v", inventory.holds)
+	}
+
+	if len(edited.Items) != 2 || edited.TotalAmount != money.New(4000, "USD") {
+		t.Errorf("expected the new items and total, got %+v (%v)", edited.Items, edited.TotalAmount)
+	}
+	if edited.Status != entity.OrderStatusPending {
+		t.Errorf("expected the status to stay pending, got %s", edited.Status)
+	}
+
+	last := edited.StatusHistory[len(edited.StatusHistory)-1]
+	if last.From != entity.OrderStatusPending || last.To != entity.OrderStatusPending || last.Actor != "customer-1" {
+		t.Errorf("unexpected history entry: %+v", last)
+	}
+	if !strings.HasPrefix(last.Reason, "changed my mind") || !strings.Contains(last.Reason, p[1].String()+": 1 -> 0") {
+		t.Errorf("expected the changes in the history entry, got %q", last.Reason)
+	}
+}
+
+func TestEditOrderItems_RejectedByInventoryChangesNothing(t *testing.T) {
+	_, inventory, service, order, p := newEditFixture(t)
+
+	_, err := service.EditOrderItems(context.Background(), order.ID, application.EditOrderItemsParams{
+		Items: []entity.OrderItem{{ProductID: p[0], Quantity: 10}, {ProductID: p[1], Quantity: 1}},
+	})
+	if !errors.Is(err, entity.ErrInsufficientQuantity) {
+		t.Fatalf("expected ErrInsufficientQuantity, got %v", err)
+	}
+
+	stored, _ := service.GetOrderByID(context.Background(), order.ID)
+	if len(stored.Items) != 2 || stored.Items[0].Quantity != 2 || stored.TotalAmount != money.New(3000, "USD") {
+		t.Errorf("expected the order to stay as it was, got %+v", stored)
+	}
+	if inventory.stock[p[0]] != 3 || inventory.stock[p[1]] != 4 {
+		t.Errorf("expected the stock to stay as it was, got %v", inventory.stock)
+	}
+}
+
+func TestEditOrderItems_RollsBackOnConcurrentChange(t *testing.T) {
+	ordersRepo, inventory, service, order, p := newEditFixture(t)
+	ordersRepo.race = func(order *entity.Order) {
+		order.Items[0].Quantity = 4
+	}
+
+	_, err := service.EditOrderItems(context.Background(), order.ID, application.EditOrderItemsParams{
+		Items: []entity.OrderItem{{ProductID: p[0], Quantity: 3}, {ProductID: p[1], Quantity: 1}},
+	})
+	if !errors.Is(err, entity.ErrOrderEditConflict) {
+		t.Fatalf("expected ErrOrderEditConflict, got %v", err)
+	}
+	if inventory.stock[p[0]] != 3 || inventory.reserved[p[0]] != 0 {
+		t.Errorf("expected the stock held for the edit to be released, got stock %v, reserved %v", inventory.stock, inventory.reserved)
+	}
+}
+
+func TestEditOrderItems_RetriesReleaseUnderTheEditsKey(t *testing.T) {
+	p1 := entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{p1: 5})
+	ctx := context.Background()
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 4})
+	if err := env.orders.CreateOrder(ctx, order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	edit := func(quantity int64) error {
+		_, err := env.orders.EditOrderItems(ctx, order.ID, application.EditOrderItemsParams{
+			Items: []entity.OrderItem{{ProductID: p1, Quantity: quantity}},
+		})
+		return err
+	}
+
+	env.inventory.releaseFailures = 1
+	if err := edit(3); err == nil {
+		t.Fatal("expected the failed release to be reported")
+	}
+	if env.ordersRepo.orders[order.ID].Items[0].Quantity != 3 {
+		t.Fatalf("expected the edit to be stored, got %+v", env.ordersRepo.orders[order.ID].Items)
+	}
+	first := env.ordersRepo.tasks["release_stock:"+order.ID.String()+":1"]
+	if first == nil || first.Status != entity.OrderTaskPending || first.Items[0].Quantity != 1 {
+		t.Fatalf("expected a pending release of one unit, got %+v", first)
+	}
+
+	if err := edit(1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	env.now = env.now.Add(time.Minute)
+	if _, err := env.orders.RunDueTasks(ctx, 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := env.orders.RunDueTasks(ctx, 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if env.inventory.stock[p1] != 4 {
+		t.Errorf("expected both edits' stock back, got %d", env.inventory.stock[p1])
+	}
+	for _, releaseID := range []string{"edit:" + order.ID.String() + ":1", "edit:" + order.ID.String() + ":2"} {
+		if got := env.inventory.releases[releaseID]; got != 1 {
+			t.Errorf("expected %s to be released once, got %d", releaseID, got)
+		}
+	}
+}
+
+func TestEditOrderItems_RequiresEditableOrder(t *testing.T) {
+	ordersRepo, _, service, order, p := newEditFixture(t)
+	ordersRepo.orders[order.ID].Status = entity.OrderStatusCompleted
+
+	_, err := service.EditOrderItems(context.Background(), order.ID, application.EditOrderItemsParams{
+		Items: []entity.OrderItem{{ProductID: p[0], Quantity: 1}},
+	})
+	if !errors.Is(err, entity.ErrOrderNotEditable) {
+		t.Errorf("expected ErrOrderNotEditable, got %v", err)
+	}
+}
+
+func TestEditOrderItems_RepricesDiscount(t *testing.T) {
+	tests := []struct {
+		name         string
+		coupon       entity.Coupon
+		quantity     int64
+		wantErr      error
+		wantTotal    money.Money
+		wantDiscount money.Money
+	}{
+		{
+			name:         "percentage follows the subtotal",
+			coupon:       entity.Coupon{Code: "SAVE10", Kind: entity.CouponPercentage, PercentOff: 1000},
+			quantity:     5,
+			wantTotal:    money.New(4500, "USD"),
+			wantDiscount: money.New(500, "USD"),
+		},
+		{
+			name:         "capped fixed amount grows back with the subtotal",
+			coupon:       entity.Coupon{Code: "SAVE10", Kind: entity.CouponFixedAmount, AmountOff: money.New(4000, "USD")},
+			quantity:     5,
+			wantTotal:    money.New(1000, "USD"),
+			wantDiscount: money.New(4000, "USD"),
+		},
+		{
+			name:     "subtotal below the minimum order value",
+			coupon:   entity.Coupon{Code: "SAVE10", Kind: entity.CouponPercentage, PercentOff: 1000, MinOrderValue: money.New(3000, "USD")},
+			quantity: 2,
+			wantErr:  entity.ErrCouponNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := entity.NewUUID()
+			env := newTestEnv(map[entity.UUID]int64{p1: 10})
+			env.addCoupon(tt.coupon)
+			service, inventory := env.orders, env.inventory
+
+			order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 3})
+			order.CouponCode = "SAVE10"
+			if err := service.CreateOrder(context.Background(), order); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			edited, err := service.EditOrderItems(context.Background(), order.ID, application.EditOrderItemsParams{
+				Items: []entity.OrderItem{{ProductID: p1, Quantity: tt.quantity}},
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if inventory.stock[p1] != 7 {
+					t.Errorf("expected the stock to stay with the order as it was, got %d", inventory.stock[p1])
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if edited.TotalAmount != tt.wantTotal || edited.Discounts[0].Amount != tt.wantDiscount {
+				t.Errorf("expected %v with %v off, got %v with %+v", tt.wantTotal, tt.wantDiscount, edited.TotalAmount, edited.Discounts)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/money"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+func TestOrderFilter_Validate(t *testing.T) {
+	usd := func(amount int64) *money.Money {
+		m := money.New(amount, "USD")
+		return &m
+	}
+	eur := money.New(100, "EUR")
+	from := fixedTime()
+
+	tests := []struct {
+		name   string
+		filter entity.OrderFilter
+		valid  bool
+	}{
+		{"empty", entity.OrderFilter{}, true},
+		{"known statuses", entity.OrderFilter{Statuses: []entity.OrderStatus{entity.OrderStatusPending, entity.OrderStatusCompleted}}, true},
+		{"unknown status", entity.OrderFilter{Statuses: []entity.OrderStatus{"shipped"}}, false},
+		{"date range", entity.OrderFilter{CreatedFrom: from, CreatedTo: from.Add(time.Hour)}, true},
+		{"open date range", entity.OrderFilter{CreatedFrom: from}, true},
+		{"empty date range", entity.OrderFilter{CreatedFrom: from, CreatedTo: from}, false},
+		{"total range", entity.OrderFilter{MinTotal: usd(100), MaxTotal: usd(100)}, true},
+		{"inverted total range", entity.OrderFilter{MinTotal: usd(200), MaxTotal: usd(100)}, false},
+		{"mixed currencies", entity.OrderFilter{MinTotal: usd(100), MaxTotal: &eur}, false},
+	}
+	for _, tt := range tests {
+		err := tt.filter.Validate()
+		if tt.valid && err != nil {
+			t.Errorf("%s: expected no error, got %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, entity.ErrInvalidFilter) {
+			t.Errorf("%s: expected ErrInvalidFilter, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestGetPaginatedOrders_RejectsInvalidFilter(t *testing.T) {
+	service := application.NewOrdersService(newMockOrdersRepository(), newMockSagaRepository(), newMockCouponRepository(), nil, newMockInventoryClient(nil), entity.NewOrderStateMachine(), fixedTime)
+
+	filter := entity.OrderFilter{Statuses: []entity.OrderStatus{"shipped"}}
+	_, err := service.GetPaginatedOrders(context.Background(), filter, &entity.Pagination{Page: 1, PageSize: 10})
+	if !errors.Is(err, entity.ErrInvalidFilter) {
+		t.Fatalf("expected ErrInvalidFilter, got %v", err)
+	}
+}
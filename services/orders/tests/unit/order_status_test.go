@@ -0,0 +1,175 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ordersgrpc "github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/inbound/grpc"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderStatus_Transitions(t *testing.T) {
+	tests := []struct {
+		from, to entity.OrderStatus
+		allowed  bool
+	}{
+		{entity.OrderStatusPending, entity.OrderStatusProcessing, true},
+		{entity.OrderStatusPending, entity.OrderStatusCancelled, true},
+		{entity.OrderStatusProcessing, entity.OrderStatusCompleted, true},
+		{entity.OrderStatusProcessing, entity.OrderStatusCancelled, true},
+		{entity.OrderStatusCompleted, entity.OrderStatusRefunded, true},
+		{entity.OrderStatusCompleted, entity.OrderStatusPending, false},
+		{entity.OrderStatusPending, entity.OrderStatusCompleted, false},
+		{entity.OrderStatusCancelled, entity.OrderStatusProcessing, false},
+		{entity.OrderStatusRefunded, entity.OrderStatusCompleted, false},
+	}
+	for _, tt := range tests {
+		order := &entity.Order{ID: entity.NewUUID(), Status: tt.from}
+		_, err := order.TransitionTo(tt.to, entity.TransitionCause{}, fixedTime())
+		if tt.allowed && err != nil {
+			t.Errorf("%s -> %s: expected no error, got %v", tt.from, tt.to, err)
+		}
+		if !tt.allowed {
+			if !errors.Is(err, entity.ErrInvalidStatusTransition) {
+				t.Errorf("%s -> %s: expected ErrInvalidStatusTransition, got %v", tt.from, tt.to, err)
+			}
+			if order.Status != tt.from {
+				t.Errorf("%s -> %s: rejected transition changed status to %s", tt.from, tt.to, order.Status)
+			}
+		}
+	}
+}
+
+func TestUpdateOrder_RunsHooksAfterTransition(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := &entity.Order{ID: entity.NewUUID(), UserName: "Test User", Status: entity.OrderStatusPending}
+	ordersRepo.orders[order.ID] = order
+
+	var entered, all []entity.StatusTransition
+	stateMachine := entity.NewOrderStateMachine()
+	stateMachine.OnEnter(entity.OrderStatusProcessing, func(ctx context.Context, o *entity.Order, tr entity.StatusTransition) error {
+		entered = append(entered, tr)
+		return nil
+	})
+	stateMachine.OnTransition(func(ctx context.Context, o *entity.Order, tr entity.StatusTransition) error {
+		all = append(all, tr)
+		return nil
+	})
+
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, newMockInventoryClient(nil), stateMachine, fixedTime)
+	processing := entity.OrderStatusProcessing
+	updated, err := service.UpdateOrder(context.Background(), order.ID, application.UpdateOrderParams{Status: &processing})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.Status != entity.OrderStatusProcessing {
+		t.Errorf("expected processing, got %s", updated.Status)
+	}
+	if len(entered) != 1 || len(all) != 1 {
+		t.Fatalf("expected each hook to run once, got enter=%d any=%d", len(entered), len(all))
+	}
+	if entered[0].From != entity.OrderStatusPending || entered[0].To != entity.OrderStatusProcessing {
+		t.Errorf("unexpected transition: %+v", entered[0])
+	}
+}
+
+func TestUpdateOrder_RejectsIllegalTransition(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := &entity.Order{ID: entity.NewUUID(), Status: entity.OrderStatusCompleted}
+	ordersRepo.orders[order.ID] = order
+
+	hooked := false
+	stateMachine := entity.NewOrderStateMachine()
+	stateMachine.OnTransition(func(ctx context.Context, o *entity.Order, tr entity.StatusTransition) error {
+		hooked = true
+		return nil
+	})
+
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, newMockInventoryClient(nil), stateMachine, fixedTime)
+	pending := entity.OrderStatusPending
+	_, err := service.UpdateOrder(context.Background(), order.ID, application.UpdateOrderParams{Status: &pending})
+	if !errors.Is(err, entity.ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+	if order.Status != entity.OrderStatusCompleted {
+		t.Errorf("expected status to stay completed, got %s", order.Status)
+	}
+	if hooked {
+		t.Error("expected no hooks to run for a rejected transition")
+	}
+}
+
+func TestOrderHistory_RecordsActorAndReason(t *testing.T) {
+	p1 := entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, newMockInventoryClient(map[entity.UUID]int64{p1: 5}), nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	ctx := application.WithActor(context.Background(), "support-agent-7")
+	if _, err := service.CancelOrder(ctx, order.ID, "customer changed their mind"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	history, err := service.GetOrderHistory(context.Background(), order.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 history entries, got %d", len(history))
+	}
+
+	created := history[0]
+	if created.From != "" || created.To != entity.OrderStatusPending || created.Actor != entity.ActorSystem {
+		t.Errorf("unexpected creation entry: %+v", created)
+	}
+	cancelled := history[1]
+	if cancelled.From != entity.OrderStatusPending || cancelled.To != entity.OrderStatusCancelled {
+		t.Errorf("unexpected cancel entry: %+v", cancelled)
+	}
+	if cancelled.Actor != "support-agent-7" || cancelled.Reason != "customer changed their mind" {
+		t.Errorf("expected actor and reason to be recorded, got %q, %q", cancelled.Actor, cancelled.Reason)
+	}
+}
+
+func TestOrderHistory_RejectedTransitionIsNotRecorded(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := &entity.Order{ID: entity.NewUUID(), Status: entity.OrderStatusCompleted}
+	ordersRepo.orders[order.ID] = order
+
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, newMockInventoryClient(nil), nil, fixedTime)
+	pending := entity.OrderStatusPending
+	service.UpdateOrder(context.Background(), order.ID, application.UpdateOrderParams{Status: &pending, Reason: "reopen"})
+
+	if len(order.StatusHistory) != 0 {
+		t.Errorf("expected no history for a rejected transition, got %+v", order.StatusHistory)
+	}
+}
+
+func TestValidateUpdateOrderRequest_OnlyCancels(t *testing.T) {
+	tests := []struct {
+		status  ordersgrpc.OrderStatus
+		allowed bool
+	}{
+		{ordersgrpc.OrderStatus_ORDER_STATUS_CANCELLED, true},
+		{ordersgrpc.OrderStatus_ORDER_STATUS_PROCESSING, false},
+		{ordersgrpc.OrderStatus_ORDER_STATUS_COMPLETED, false},
+		{ordersgrpc.OrderStatus_ORDER_STATUS_REFUNDED, false},
+	}
+	for _, tt := range tests {
+		err := ordersgrpc.ValidateUpdateOrderRequest(&ordersgrpc.UpdateOrderRequest{Id: entity.NewUUID().String(), Status: &tt.status})
+		if tt.allowed && err != nil {
+			t.Errorf("%s: expected no error, got %v", tt.status, err)
+		}
+		if !tt.allowed && status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", tt.status, err)
+		}
+	}
+}
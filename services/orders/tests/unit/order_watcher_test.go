@@ -0,0 +1,207 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockOrderEventsRepository struct {
+	orders  *mockOrdersRepository
+	events  []*entity.OrderEvent
+	lastSeq int64
+}
+
+func newMockOrderEventsRepository(orders *mockOrdersRepository) *mockOrderEventsRepository {
+	return &mockOrderEventsRepository{orders: orders}
+}
+
+// reserve hands out the next seq to an event that is not committed yet.
+func (m *mockOrderEventsRepository) reserve(orderID entity.UUID, eventType string) *entity.OrderEvent {
+	m.lastSeq++
+	return &entity.OrderEvent{
+		Seq:     m.lastSeq,
+		ID:      entity.NewUUID(),
+		OrderID: orderID,
+		Type:    eventType,
+	}
+}
+
+func (m *mockOrderEventsRepository) commit(event *entity.OrderEvent) {
+	m.events = append(m.events, event)
+	sort.Slice(m.events, func(i, j int) bool { return m.events[i].Seq < m.events[j].Seq })
+}
+
+func (m *mockOrderEventsRepository) append(orderID entity.UUID, eventType string) *entity.OrderEvent {
+	event := m.reserve(orderID, eventType)
+	m.commit(event)
+	return event
+}
+
+func (m *mockOrderEventsRepository) ListOrderEvents(ctx context.Context, orderID entity.UUID, afterSeq int64, limit int) ([]*entity.OrderEvent, error) {
+	var events []*entity.OrderEvent
+	for _, event := range m.events {
+		if event.OrderID == orderID && event.Seq > afterSeq && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+func (m *mockOrderEventsRepository) GetOrderSnapshot(ctx context.Context, orderID entity.UUID) (*entity.Order, int64, error) {
+	order, err := m.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, 0, err
+	}
+	var seq int64
+	for _, event := range m.events {
+		if event.OrderID == orderID {
+			seq = max(seq, event.Seq)
+		}
+	}
+	return order, seq, nil
+}
+
+func newWatchedOrder(repo *mockOrdersRepository) *entity.Order {
+	order := newOrder()
+	order.ID = entity.NewUUID()
+	order.Status = entity.OrderStatusPending
+	repo.orders[order.ID] = order
+	return order
+}
+
+func TestOrderWatcher_StartsWithSnapshotAndEndsOnDeletion(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := newWatchedOrder(ordersRepo)
+	events := newMockOrderEventsRepository(ordersRepo)
+	events.append(order.ID, entity.EventOrderCreated)
+	watcher := application.NewOrderWatcher(ordersRepo, events, time.Millisecond, 10)
+
+	var got []string
+	err := watcher.Watch(context.Background(), order.ID, "", func(event *entity.OrderEvent) error {
+		got = append(got, event.Type)
+		if event.Type == entity.EventOrderSnapshot {
+			if event.Order == nil || event.Order.ID != order.ID {
+				t.Fatalf("expected the snapshot to carry the order, got %+v", event.Order)
+			}
+			// Changes made after the snapshot reach the watcher.
+			events.append(order.ID, entity.EventOrderStatusChanged)
+			events.append(entity.NewUUID(), entity.EventOrderUpdated)
+			events.append(order.ID, entity.EventPaymentUpdated)
+			events.append(order.ID, entity.EventOrderDeleted)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	want := []string{entity.EventOrderSnapshot, entity.EventOrderStatusChanged, entity.EventPaymentUpdated, entity.EventOrderDeleted}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestOrderWatcher_SnapshotKeepsChangesCommittedAfterIt(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := newWatchedOrder(ordersRepo)
+	events := newMockOrderEventsRepository(ordersRepo)
+	events.append(order.ID, entity.EventOrderCreated)
+	// A change to the order takes its seq before another order's change,
+	// but only commits after the snapshot is read.
+	late := events.reserve(order.ID, entity.EventOrderStatusChanged)
+	events.append(entity.NewUUID(), entity.EventOrderUpdated)
+	watcher := application.NewOrderWatcher(ordersRepo, events, time.Millisecond, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []*entity.OrderEvent
+	err := watcher.Watch(ctx, order.ID, "", func(event *entity.OrderEvent) error {
+		got = append(got, event)
+		if event.Type == entity.EventOrderSnapshot {
+			events.commit(late)
+			return nil
+		}
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the watch to end with the context, got %v", err)
+	}
+	if len(got) != 2 || got[1].ID != late.ID {
+		t.Fatalf("expected the snapshot and the late change, got %v", got)
+	}
+}
+
+func TestOrderWatcher_ResumesAfterToken(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := newWatchedOrder(ordersRepo)
+	events := newMockOrderEventsRepository(ordersRepo)
+	seen := events.append(order.ID, entity.EventOrderCreated)
+	missed := events.append(order.ID, entity.EventOrderStatusChanged)
+	watcher := application.NewOrderWatcher(ordersRepo, events, time.Millisecond, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []*entity.OrderEvent
+	err := watcher.Watch(ctx, order.ID, seen.ResumeToken(), func(event *entity.OrderEvent) error {
+		got = append(got, event)
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the watch to end with the context, got %v", err)
+	}
+	if len(got) != 1 || got[0].ID != missed.ID {
+		t.Fatalf("expected only the missed event, got %v", got)
+	}
+}
+
+func TestOrderWatcher_RejectsInvalidResumeToken(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := newWatchedOrder(ordersRepo)
+	watcher := application.NewOrderWatcher(ordersRepo, newMockOrderEventsRepository(ordersRepo), time.Millisecond, 10)
+
+	err := watcher.Watch(context.Background(), order.ID, "not-a-token", func(*entity.OrderEvent) error { return nil })
+	if !errors.Is(err, entity.ErrInvalidResumeToken) {
+		t.Fatalf("expected ErrInvalidResumeToken, got %v", err)
+	}
+}
+
+func TestOrderWatcher_ResumingDeletedOrderDeliversMissedDeletion(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := newWatchedOrder(ordersRepo)
+	events := newMockOrderEventsRepository(ordersRepo)
+	seen := events.append(order.ID, entity.EventOrderCreated)
+	events.append(order.ID, entity.EventOrderDeleted)
+	delete(ordersRepo.orders, order.ID)
+	watcher := application.NewOrderWatcher(ordersRepo, events, time.Millisecond, 10)
+
+	var got []string
+	err := watcher.Watch(context.Background(), order.ID, seen.ResumeToken(), func(event *entity.OrderEvent) error {
+		got = append(got, event.Type)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if len(got) != 1 || got[0] != entity.EventOrderDeleted {
+		t.Fatalf("expected the deletion, got %v", got)
+	}
+
+	err = watcher.Watch(context.Background(), order.ID, "2", func(*entity.OrderEvent) error { return nil })
+	if !errors.Is(err, entity.ErrOrderNotFound) {
+		t.Fatalf("expected ErrOrderNotFound once nothing is left to send, got %v", err)
+	}
+}
@@ -0,0 +1,699 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/money"
+)
+
+type mockOrdersRepository struct {
+	orders   map[entity.UUID]*entity.Order
+	tasks    map[string]*entity.OrderTask
+	saveFunc func(ctx context.Context, order entity.Order) error
+	// payments, when set, is what an Unpaid filter checks orders against.
+	payments *mockPaymentsRepository
+}
+
+func newMockOrdersRepository() *mockOrdersRepository {
+	return &mockOrdersRepository{
+		orders: make(map[entity.UUID]*entity.Order),
+		tasks:  make(map[string]*entity.OrderTask),
+	}
+}
+
+// storeTasks moves the tasks an order change left into the repository,
+// keeping those already stored as they are.
+func (m *mockOrdersRepository) storeTasks(order *entity.Order) {
+	for _, task := range order.Tasks {
+		if _, ok := m.tasks[task.ID]; !ok {
+			m.tasks[task.ID] = &task
+		}
+	}
+	order.Tasks = nil
+}
+
+func (m *mockOrdersRepository) GetOrderByID(ctx context.Context, id entity.UUID) (*entity.Order, error) {
+	order, ok := m.orders[id]
+	if !ok {
+		return nil, entity.ErrOrderNotFound
+	}
+	return order, nil
+}
+func (m *mockOrdersRepository) SaveOrder(ctx context.Context, order entity.Order) error {
+	if m.saveFunc != nil {
+		if err := m.saveFunc(ctx, order); err != nil {
+			return err
+		}
+	}
+	m.storeTasks(&order)
+	m.orders[order.ID] = &order
+	return nil
+}
+func (m *mockOrdersRepository) UpdateOrderByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Order) (bool, error)) error {
+	order, ok := m.orders[id]
+	if !ok {
+		return entity.ErrOrderNotFound
+	}
+	updated, err := updateFn(order)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return entity.ErrNotUpdated
+	}
+	m.storeTasks(order)
+	return nil
+}
+func (m *mockOrdersRepository) DeleteOrderByID(ctx context.Context, id entity.UUID) error {
+	delete(m.orders, id)
+	return nil
+}
+func (m *mockOrdersRepository) GetOrderStatusHistory(ctx context.Context, id entity.UUID) ([]entity.StatusTransition, error) {
+	order, ok := m.orders[id]
+	if !ok {
+		return nil, entity.ErrOrderNotFound
+	}
+	return order.StatusHistory, nil
+}
+func (m *mockOrdersRepository) GetTotalOrdersCount(ctx context.Context, filter entity.OrderFilter) (int64, error) {
+	return int64(len(m.orders)), nil
+}
+
+// GetAllOrders lists orders by status, creation time and payment, oldest
+// first, which is all the unpaid order sweeper filters on.
+func (m *mockOrdersRepository) GetAllOrders(ctx context.Context, filter entity.OrderFilter, pagination *entity.Pagination) ([]*entity.Order, error) {
+	var orders []*entity.Order
+	for _, order := range m.orders {
+		if len(filter.Statuses) > 0 && order.Status != filter.Statuses[0] {
+			continue
+		}
+		if !filter.CreatedTo.IsZero() && !order.CreatedAt.Before(filter.CreatedTo) {
+			continue
+		}
+		if filter.Unpaid && m.payments != nil && m.payments.paid(order.ID) {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].CreatedAt.Before(orders[j].CreatedAt) })
+	if int64(len(orders)) > pagination.PageSize {
+		orders = orders[:pagination.PageSize]
+	}
+	return orders, nil
+}
+func (m *mockOrdersRepository) GetOrderTasks(ctx context.Context, filter entity.OrderTaskFilter) ([]*entity.OrderTask, error) {
+	var tasks []*entity.OrderTask
+	for _, task := range m.tasks {
+		if task.Status != entity.OrderTaskPending ||
+			filter.OrderID != "" && task.OrderID != filter.OrderID ||
+			!filter.DueBy.IsZero() && task.RunAt.After(filter.DueBy) {
+			continue
+		}
+		copied := *task
+		tasks = append(tasks, &copied)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if !tasks[i].RunAt.Equal(tasks[j].RunAt) {
+			return tasks[i].RunAt.Before(tasks[j].RunAt)
+		}
+		return tasks[i].ID < tasks[j].ID
+	})
+	if filter.Limit > 0 && len(tasks) > filter.Limit {
+		tasks = tasks[:filter.Limit]
+	}
+	return tasks, nil
+}
+func (m *mockOrdersRepository) RunOrderTask(ctx context.Context, id string, runFn func(*entity.Order, *entity.OrderTask)) error {
+	task, ok := m.tasks[id]
+	if !ok || task.Status != entity.OrderTaskPending {
+		return entity.ErrNotUpdated
+	}
+	order, ok := m.orders[task.OrderID]
+	if !ok {
+		return entity.ErrOrderNotFound
+	}
+	copied := *task
+	runFn(order, &copied)
+	m.tasks[id] = &copied
+	return nil
+}
+
+type mockSagaRepository struct {
+	sagas map[entity.UUID]*entity.OrderSaga
+}
+
+func newMockSagaRepository() *mockSagaRepository {
+	return &mockSagaRepository{sagas: make(map[entity.UUID]*entity.OrderSaga)}
+}
+
+func (m *mockSagaRepository) CreateSaga(ctx context.Context, saga entity.OrderSaga) error {
+	saga.Steps = append([]entity.SagaStep(nil), saga.Steps...)
+	m.sagas[saga.OrderID] = &saga
+	return nil
+}
+func (m *mockSagaRepository) UpdateSagaStatus(ctx context.Context, orderID entity.UUID, status entity.SagaStatus, reason string) error {
+	saga, ok := m.sagas[orderID]
+	if !ok {
+		return entity.ErrSagaNotFound
+	}
+	saga.Status = status
+	saga.Error = reason
+	return nil
+}
+func (m *mockSagaRepository) UpdateSagaStep(ctx context.Context, orderID entity.UUID, step entity.SagaStep) error {
+	saga, ok := m.sagas[orderID]
+	if !ok {
+		return entity.ErrSagaNotFound
+	}
+	for i := range saga.Steps {
+		if saga.Steps[i].ProductID == step.ProductID {
+			saga.Steps[i] = step
+			return nil
+		}
+	}
+	return entity.ErrSagaNotFound
+}
+func (m *mockSagaRepository) GetUnfinishedSagas(ctx context.Context, updatedBefore time.Time) ([]*entity.OrderSaga, error) {
+	var sagas []*entity.OrderSaga
+	for _, saga := range m.sagas {
+		if !saga.IsFinished() && saga.UpdatedAt.Before(updatedBefore) {
+			copied := *saga
+			copied.Steps = append([]entity.SagaStep(nil), saga.Steps...)
+			sagas = append(sagas, &copied)
+		}
+	}
+	return sagas, nil
+}
+
+// mockInventoryClient treats stock as the available quantity. Held stock
+// moves to reserved and is either confirmed or given back.
+type mockInventoryClient struct {
+	stock     map[entity.UUID]int64
+	price     money.Money
+	failOn    entity.UUID
+	reserved  map[entity.UUID]int64
+	confirmed map[entity.UUID]int64
+	holds     map[entity.UUID][]entity.OrderItem
+	releases  map[string]int
+	// releaseFailures makes the next ReleaseItems calls fail.
+	releaseFailures int
+	// confirmErr makes ConfirmReservation fail.
+	confirmErr error
+	taxClasses map[entity.UUID]string
+}
+
+func newMockInventoryClient(stock map[entity.UUID]int64) *mockInventoryClient {
+	return &mockInventoryClient{
+		stock:     stock,
+		price:     money.New(1000, "USD"),
+		reserved:  make(map[entity.UUID]int64),
+		confirmed: make(map[entity.UUID]int64),
+		holds:     make(map[entity.UUID][]entity.OrderItem),
+		releases:  make(map[string]int),
+	}
+}
+
+func (m *mockInventoryClient) GetProduct(ctx context.Context, productID entity.UUID) (*entity.OrderItem, error) {
+	quantity, ok := m.stock[productID]
+	if !ok {
+		return nil, entity.ErrItemNotFound
+	}
+	return &entity.OrderItem{
+		ProductID:    productID,
+		ProductName:  "Product " + productID.String(),
+		ProductPrice: m.price,
+		Quantity:     quantity,
+		TaxClass:     m.taxClasses[productID],
+	}, nil
+}
+func (m *mockInventoryClient) ReserveItems(ctx context.Context, orderID entity.UUID, items []entity.OrderItem) error {
+	mismatch := &entity.PriceMismatchError{}
+	for _, item := range items {
+		if item.ExpectedPrice != nil && *item.ExpectedPrice != m.price {
+			mismatch.Mismatches = append(mismatch.Mismatches, entity.PriceMismatch{
+				ProductID: item.ProductID,
+				Expected:  *item.ExpectedPrice,
+				Current:   m.price,
+			})
+		}
+	}
+	if len(mismatch.Mismatches) > 0 {
+		return mismatch
+	}
+	for _, item := range items {
+		if item.ProductID == m.failOn || item.Quantity > m.stock[item.ProductID] {
+			return entity.ErrInsufficientQuantity
+		}
+	}
+	for _, item := range items {
+		m.stock[item.ProductID] -= item.Quantity
+		m.reserved[item.ProductID] += item.Quantity
+	}
+	m.holds[orderID] = append(m.holds[orderID], items...)
+	return nil
+}
+func (m *mockInventoryClient) ConfirmReservation(ctx context.Context, orderID entity.UUID) error {
+	if m.confirmErr != nil {
+		return m.confirmErr
+	}
+	items, ok := m.holds[orderID]
+	if !ok {
+		return entity.ErrReservationNotFound
+	}
+	for _, item := range items {
+		m.reserved[item.ProductID] -= item.Quantity
+		m.confirmed[item.ProductID] += item.Quantity
+	}
+	delete(m.holds, orderID)
+	return nil
+}
+func (m *mockInventoryClient) ReleaseReservation(ctx context.Context, orderID entity.UUID) error {
+	items, ok := m.holds[orderID]
+	if !ok {
+		return entity.ErrReservationNotFound
+	}
+	for _, item := range items {
+		m.stock[item.ProductID] += item.Quantity
+		m.reserved[item.ProductID] -= item.Quantity
+	}
+	delete(m.holds, orderID)
+	return nil
+}
+
+func (m *mockInventoryClient) ReleaseItems(ctx context.Context, releaseID string, orderID entity.UUID, items []entity.OrderItem) error {
+	if m.releaseFailures > 0 {
+		m.releaseFailures--
+		return errors.New("inventory unavailable")
+	}
+	m.releases[releaseID]++
+	if m.releases[releaseID] > 1 {
+		return nil
+	}
+	if _, ok := m.holds[orderID]; ok {
+		return m.ReleaseReservation(ctx, orderID)
+	}
+	for _, item := range items {
+		m.confirmed[item.ProductID] -= item.Quantity
+		m.stock[item.ProductID] += item.Quantity
+	}
+	return nil
+}
+
+func fixedTime() time.Time {
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func newOrder(items ...entity.OrderItem) *entity.Order {
+	return &entity.Order{
+		UserID:   entity.NewUUID(),
+		UserName: "Test User",
+		Items:    items,
+	}
+}
+
+func TestCreateOrder_Success(t *testing.T) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	sagaRepo := newMockSagaRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5, p2: 5})
+
+	service := application.NewOrdersService(ordersRepo, sagaRepo, newMockCouponRepository(), nil, inventory, nil, fixedTime)
+	order := newOrder(
+		entity.OrderItem{ProductID: p1, Quantity: 2},
+		entity.OrderItem{ProductID: p2, Quantity: 1},
+		entity.OrderItem{ProductID: p1, Quantity: 1},
+	)
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(order.Items) != 2 {
+		t.Fatalf("expected repeated lines to be merged, got %d items", len(order.Items))
+	}
+	if order.TotalAmount != money.New(4000, "USD") {
+		t.Errorf("expected total 40, got %v", order.TotalAmount)
+	}
+	if inventory.confirmed[p1] != 3 || inventory.confirmed[p2] != 1 {
+		t.Errorf("unexpected confirmed reservations: %v", inventory.confirmed)
+	}
+	if len(inventory.holds) != 0 {
+		t.Errorf("expected no outstanding holds, got %v", inventory.holds)
+	}
+	if saga := sagaRepo.sagas[order.ID]; saga.Status != entity.SagaStatusCompleted {
+		t.Errorf("expected completed saga, got %s", saga.Status)
+	}
+}
+
+func TestCreateOrder_ReservationFailure_ReservesNothing(t *testing.T) {
+	p1, p2, p3 := entity.NewUUID(), entity.NewUUID(), entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	sagaRepo := newMockSagaRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5, p2: 5, p3: 5})
+	inventory.failOn = p3
+
+	service := application.NewOrdersService(ordersRepo, sagaRepo, newMockCouponRepository(), nil, inventory, nil, fixedTime)
+	order := newOrder(
+		entity.OrderItem{ProductID: p1, Quantity: 1},
+		entity.OrderItem{ProductID: p2, Quantity: 2},
+		entity.OrderItem{ProductID: p3, Quantity: 3},
+	)
+	err := service.CreateOrder(context.Background(), order)
+	if !errors.Is(err, entity.ErrInsufficientQuantity) {
+		t.Fatalf("expected ErrInsufficientQuantity, got %v", err)
+	}
+
+	for id, quantity := range inventory.reserved {
+		if quantity != 0 {
+			t.Errorf("product %s still has %d reserved", id, quantity)
+		}
+	}
+	saga := sagaRepo.sagas[order.ID]
+	if saga.Status != entity.SagaStatusCompensated {
+		t.Errorf("expected compensated saga, got %s", saga.Status)
+	}
+	if len(ordersRepo.orders) != 0 {
+		t.Errorf("expected no persisted orders, got %d", len(ordersRepo.orders))
+	}
+}
+
+func TestCreateOrder_PriceDrift_RejectsOrder(t *testing.T) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	sagaRepo := newMockSagaRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5, p2: 5})
+
+	service := application.NewOrdersService(ordersRepo, sagaRepo, newMockCouponRepository(), nil, inventory, nil, fixedTime)
+	quoted := money.New(900, "USD")
+	order := newOrder(
+		entity.OrderItem{ProductID: p1, Quantity: 1},
+		entity.OrderItem{ProductID: p2, Quantity: 1, ExpectedPrice: &quoted},
+	)
+	err := service.CreateOrder(context.Background(), order)
+	if !errors.Is(err, entity.ErrPriceMismatch) {
+		t.Fatalf("expected ErrPriceMismatch, got %v", err)
+	}
+	var mismatch *entity.PriceMismatchError
+	if !errors.As(err, &mismatch) || len(mismatch.Mismatches) != 1 || mismatch.Mismatches[0].ProductID != p2 {
+		t.Fatalf("expected a mismatch for the quoted line only, got %v", err)
+	}
+	if len(inventory.holds) != 0 || len(ordersRepo.orders) != 0 {
+		t.Errorf("expected nothing held or saved, got holds=%v orders=%d", inventory.holds, len(ordersRepo.orders))
+	}
+	for _, step := range sagaRepo.sagas[order.ID].Steps {
+		if step.Status != entity.SagaStepFailed {
+			t.Errorf("expected failed step for %s, got %s", step.ProductID, step.Status)
+		}
+	}
+}
+
+func TestCreateOrder_SaveFailure_ReleasesAllSteps(t *testing.T) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	ordersRepo.saveFunc = func(ctx context.Context, order entity.Order) error {
+		return errors.New("database is down")
+	}
+	sagaRepo := newMockSagaRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5, p2: 5})
+
+	service := application.NewOrdersService(ordersRepo, sagaRepo, newMockCouponRepository(), nil, inventory, nil, fixedTime)
+	order := newOrder(
+		entity.OrderItem{ProductID: p1, Quantity: 1},
+		entity.OrderItem{ProductID: p2, Quantity: 2},
+	)
+	if err := service.CreateOrder(context.Background(), order); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	if inventory.stock[p1] != 5 || inventory.stock[p2] != 5 {
+		t.Errorf("expected stock to be restored, got %v", inventory.stock)
+	}
+	if saga := sagaRepo.sagas[order.ID]; saga.Status != entity.SagaStatusCompensated {
+		t.Errorf("expected compensated saga, got %s", saga.Status)
+	}
+}
+
+func TestRecoverSagas(t *testing.T) {
+	p1 := entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	sagaRepo := newMockSagaRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5})
+
+	interrupted := &entity.Order{ID: entity.NewUUID(), Items: []entity.OrderItem{{ProductID: p1, Quantity: 1}}}
+	inventory.ReserveItems(context.Background(), interrupted.ID, interrupted.Items)
+	saga := entity.NewOrderSaga(interrupted, fixedTime())
+	saga.Steps[0].Status = entity.SagaStepReserved
+	sagaRepo.CreateSaga(context.Background(), *saga)
+
+	persisted := &entity.Order{ID: entity.NewUUID(), Items: []entity.OrderItem{{ProductID: p1, Quantity: 1}}}
+	inventory.ReserveItems(context.Background(), persisted.ID, persisted.Items)
+	ordersRepo.orders[persisted.ID] = persisted
+	sagaRepo.CreateSaga(context.Background(), *entity.NewOrderSaga(persisted, fixedTime()))
+
+	// A saga started just now may still be running, so it is left alone.
+	running := &entity.Order{ID: entity.NewUUID(), Items: []entity.OrderItem{{ProductID: p1, Quantity: 1}}}
+	inventory.ReserveItems(context.Background(), running.ID, running.Items)
+	sagaRepo.CreateSaga(context.Background(), *entity.NewOrderSaga(running, fixedTime().Add(time.Hour)))
+
+	later := func() time.Time { return fixedTime().Add(time.Hour) }
+	service := application.NewOrdersService(ordersRepo, sagaRepo, newMockCouponRepository(), nil, inventory, nil, later)
+	if err := service.RecoverSagas(context.Background(), 5*time.Minute); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := sagaRepo.sagas[interrupted.ID].Status; got != entity.SagaStatusCompensated {
+		t.Errorf("expected interrupted saga to be compensated, got %s", got)
+	}
+	if got := sagaRepo.sagas[persisted.ID].Status; got != entity.SagaStatusCompleted {
+		t.Errorf("expected persisted saga to be completed, got %s", got)
+	}
+	if got := sagaRepo.sagas[running.ID].Status; got != entity.SagaStatusStarted {
+		t.Errorf("expected the running saga to be left alone, got %s", got)
+	}
+	if inventory.stock[p1] != 3 || inventory.reserved[p1] != 1 || inventory.confirmed[p1] != 1 {
+		t.Errorf("expected one hold released, one confirmed and one kept, stock %d reserved %d confirmed %d",
+			inventory.stock[p1], inventory.reserved[p1], inventory.confirmed[p1])
+	}
+}
+
+func TestRecoverSagas_CancelsOrderWhoseHoldsExpired(t *testing.T) {
+	p1 := entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{p1: 5})
+	sagaRepo := newMockSagaRepository()
+	env.orders = application.NewOrdersService(env.ordersRepo, sagaRepo, env.couponRepo, nil, env.inventory, nil, env.timeSource)
+
+	order := env.addOrder(entity.OrderStatusPending, entity.OrderItem{ProductID: p1, Quantity: 2})
+	env.inventory.ReserveItems(context.Background(), order.ID, order.Items)
+	sagaRepo.CreateSaga(context.Background(), *entity.NewOrderSaga(order, env.now))
+	env.inventory.confirmErr = entity.ErrReservationExpired
+
+	env.now = env.now.Add(time.Hour)
+	if err := env.orders.RecoverSagas(context.Background(), 5*time.Minute); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if order.Status != entity.OrderStatusCancelled {
+		t.Errorf("expected the order to be cancelled, got %s", order.Status)
+	}
+	if got := sagaRepo.sagas[order.ID].Status; got != entity.SagaStatusCompensated {
+		t.Errorf("expected the saga to be compensated, got %s", got)
+	}
+	if env.inventory.stock[p1] != 5 {
+		t.Errorf("expected no stock left set aside for the order, got %d", env.inventory.stock[p1])
+	}
+}
+
+func TestSagaRecoverer_SkipsWhileLocked(t *testing.T) {
+	sagaRepo := newMockSagaRepository()
+	order := &entity.Order{ID: entity.NewUUID()}
+	sagaRepo.CreateSaga(context.Background(), *entity.NewOrderSaga(order, fixedTime()))
+	later := func() time.Time { return fixedTime().Add(time.Hour) }
+	service := application.NewOrdersService(newMockOrdersRepository(), sagaRepo, newMockCouponRepository(), nil, newMockInventoryClient(nil), nil, later)
+	locker := &mockLocker{held: true}
+	recoverer := application.NewSagaRecoverer(service, locker, time.Minute, time.Minute, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	if err := recoverer.Recover(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := sagaRepo.sagas[order.ID].Status; got != entity.SagaStatusStarted {
+		t.Fatalf("expected nothing recovered while another replica holds the lock, got %s", got)
+	}
+
+	locker.held = false
+	if err := recoverer.Recover(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := sagaRepo.sagas[order.ID].Status; got != entity.SagaStatusCompensated || locker.released != 1 {
+		t.Errorf("expected the saga compensated under the lock, got %s, %d releases", got, locker.released)
+	}
+}
+
+func TestCancelOrder_ReturnsStockOnce(t *testing.T) {
+	p1 := entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5})
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 2})
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		cancelled, err := service.CancelOrder(context.Background(), order.ID, "")
+		if err != nil {
+			t.Fatalf("attempt %d: expected no error, got %v", i+1, err)
+		}
+		if cancelled.Status != entity.OrderStatusCancelled {
+			t.Errorf("attempt %d: expected cancelled, got %s", i+1, cancelled.Status)
+		}
+	}
+
+	if inventory.stock[p1] != 5 || inventory.confirmed[p1] != 0 {
+		t.Errorf("expected stock to be returned once, stock %d confirmed %d", inventory.stock[p1], inventory.confirmed[p1])
+	}
+	if got := inventory.releases["cancel:"+order.ID.String()]; got != 1 {
+		t.Errorf("expected the second cancel not to release again, got %d releases", got)
+	}
+	if task := ordersRepo.tasks["return_stock:"+order.ID.String()]; task == nil || task.Status != entity.OrderTaskDone {
+		t.Errorf("expected the stock return task to be done, got %+v", task)
+	}
+}
+
+func TestCancelOrder_RetriesFailedRelease(t *testing.T) {
+	p1 := entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5})
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 2})
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	inventory.releaseFailures = 1
+	if _, err := service.CancelOrder(context.Background(), order.ID, ""); err == nil {
+		t.Fatal("expected the failed release to be reported")
+	}
+	if ordersRepo.orders[order.ID].Status != entity.OrderStatusCancelled {
+		t.Fatalf("expected the order to stay cancelled, got %s", ordersRepo.orders[order.ID].Status)
+	}
+	if inventory.stock[p1] != 3 {
+		t.Fatalf("expected no stock returned yet, got %d", inventory.stock[p1])
+	}
+
+	if _, err := service.CancelOrder(context.Background(), order.ID, ""); err != nil {
+		t.Fatalf("expected retry to succeed, got %v", err)
+	}
+	if inventory.stock[p1] != 5 {
+		t.Errorf("expected stock to be returned by the retry, got %d", inventory.stock[p1])
+	}
+}
+
+func TestRunDueTasks_RetriesFailedTaskAfterBackoff(t *testing.T) {
+	p1 := entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{p1: 5})
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 2})
+	ctx := context.Background()
+	if err := env.orders.CreateOrder(ctx, order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	env.inventory.releaseFailures = 1
+	if _, err := env.orders.CancelOrder(ctx, order.ID, ""); err == nil {
+		t.Fatal("expected the failed release to be reported")
+	}
+	task := env.ordersRepo.tasks["return_stock:"+order.ID.String()]
+	if task.Status != entity.OrderTaskPending || task.Attempts != 1 || task.LastError == "" {
+		t.Fatalf("expected the failure to be recorded on a pending task, got %+v", task)
+	}
+
+	if ran, err := env.orders.RunDueTasks(ctx, 10); err != nil || ran != 0 {
+		t.Fatalf("expected nothing due before the backoff, ran %d: %v", ran, err)
+	}
+	env.now = env.now.Add(time.Minute)
+	if ran, err := env.orders.RunDueTasks(ctx, 10); err != nil || ran != 1 {
+		t.Fatalf("expected the task to be retried, ran %d: %v", ran, err)
+	}
+	if env.inventory.stock[p1] != 5 {
+		t.Errorf("expected the retry to return the stock, got %d", env.inventory.stock[p1])
+	}
+	if task := env.ordersRepo.tasks["return_stock:"+order.ID.String()]; task.Status != entity.OrderTaskDone || task.Attempts != 2 {
+		t.Errorf("expected the task to be done after two attempts, got %+v", task)
+	}
+}
+
+func TestCancelOrder_RejectsCompletedOrder(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	order := &entity.Order{ID: entity.NewUUID(), Status: entity.OrderStatusCompleted}
+	ordersRepo.orders[order.ID] = order
+	inventory := newMockInventoryClient(nil)
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, nil, fixedTime)
+
+	if _, err := service.CancelOrder(context.Background(), order.ID, ""); !errors.Is(err, entity.ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+	if len(inventory.releases) != 0 {
+		t.Errorf("expected no stock release, got %v", inventory.releases)
+	}
+}
+
+func TestDeleteOrder_OnlyCancelledWithStockReturned(t *testing.T) {
+	p1 := entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5})
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 2})
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := service.DeleteOrder(context.Background(), order.ID); !errors.Is(err, entity.ErrOrderNotDeletable) {
+		t.Fatalf("expected ErrOrderNotDeletable, got %v", err)
+	}
+	if _, ok := ordersRepo.orders[order.ID]; !ok {
+		t.Fatal("expected the pending order to be kept")
+	}
+
+	inventory.releaseFailures = 1
+	if _, err := service.CancelOrder(context.Background(), order.ID, ""); err == nil {
+		t.Fatal("expected the failed release to be reported")
+	}
+	if _, err := service.DeleteOrder(context.Background(), order.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := ordersRepo.orders[order.ID]; ok {
+		t.Error("expected the cancelled order to be deleted")
+	}
+	if inventory.stock[p1] != 5 {
+		t.Errorf("expected the delete to return the stock, got %d", inventory.stock[p1])
+	}
+}
+
+func TestCreateOrder_FailureAfterSaveReportsPlacedOrder(t *testing.T) {
+	p1 := entity.NewUUID()
+	ordersRepo := newMockOrdersRepository()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5})
+	inventory.confirmErr = errors.New("inventory unavailable")
+	service := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 2})
+	err := service.CreateOrder(context.Background(), order)
+	if !errors.Is(err, entity.ErrOrderPlaced) {
+		t.Fatalf("expected ErrOrderPlaced, got %v", err)
+	}
+	if _, ok := ordersRepo.orders[order.ID]; !ok {
+		t.Error("expected the order to stay stored")
+	}
+	if inventory.reserved[p1] != 2 {
+		t.Errorf("expected the hold to be kept for recovery, got %d reserved", inventory.reserved[p1])
+	}
+}
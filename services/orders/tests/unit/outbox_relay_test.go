@@ -0,0 +1,167 @@
+package unit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/events"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockOutboxRepository struct {
+	events    []entity.Event
+	published map[entity.UUID]bool
+}
+
+func newMockOutboxRepository(events ...entity.Event) *mockOutboxRepository {
+	return &mockOutboxRepository{events: events, published: make(map[entity.UUID]bool)}
+}
+
+func (m *mockOutboxRepository) PublishOutboxEvents(ctx context.Context, limit int, publishFn func([]entity.Event) (int, error)) (int, error) {
+	var batch []entity.Event
+	for _, event := range m.events {
+		if !m.published[event.ID] && len(batch) < limit {
+			batch = append(batch, event)
+		}
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+	n, err := publishFn(batch)
+	for _, event := range batch[:n] {
+		m.published[event.ID] = true
+	}
+	return n, err
+}
+
+// flakyPublisher rejects the event with ID failOn until it has been tried
+// failures times, and records every delivery it accepts.
+type flakyPublisher struct {
+	failOn    entity.UUID
+	failures  int
+	delivered []entity.UUID
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event entity.Event) error {
+	if event.ID == p.failOn && p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	p.delivered = append(p.delivered, event.ID)
+	return nil
+}
+
+func newTestEvent(t *testing.T, id string) entity.Event {
+	t.Helper()
+	event, err := entity.NewEvent(entity.AggregateOrder, id, entity.EventOrderCreated, map[string]string{"id": id}, fixedTime())
+	if err != nil {
+		t.Fatalf("NewEvent: %v", err)
+	}
+	return event
+}
+
+func TestOutboxRelay_RetriesFromFirstFailedEvent(t *testing.T) {
+	first, second, third := newTestEvent(t, "o1"), newTestEvent(t, "o2"), newTestEvent(t, "o3")
+	repo := newMockOutboxRepository(first, second, third)
+	publisher := &flakyPublisher{failOn: second.ID, failures: 1}
+	relay := application.NewOutboxRelay(repo, publisher, time.Second, 10, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	published, err := relay.RelayBatch(context.Background())
+	if err == nil {
+		t.Fatal("expected the publish error to be returned")
+	}
+	if published != 1 {
+		t.Fatalf("expected 1 event published before the failure, got %d", published)
+	}
+
+	published, err = relay.RelayBatch(context.Background())
+	if err != nil {
+		t.Fatalf("RelayBatch: %v", err)
+	}
+	if published != 2 {
+		t.Fatalf("expected the remaining 2 events on retry, got %d", published)
+	}
+
+	want := []entity.UUID{first.ID, second.ID, third.ID}
+	if len(publisher.delivered) != len(want) {
+		t.Fatalf("expected deliveries %v, got %v", want, publisher.delivered)
+	}
+	for i := range want {
+		if publisher.delivered[i] != want[i] {
+			t.Fatalf("expected deliveries %v in order, got %v", want, publisher.delivered)
+		}
+	}
+
+	published, err = relay.RelayBatch(context.Background())
+	if err != nil || published != 0 {
+		t.Fatalf("expected an empty outbox, got %d, %v", published, err)
+	}
+}
+
+func TestOutboxRelay_MemoryPublisherReceivesBatchInOrder(t *testing.T) {
+	first, second := newTestEvent(t, "o1"), newTestEvent(t, "o2")
+	publisher := events.NewMemoryPublisher()
+	relay := application.NewOutboxRelay(newMockOutboxRepository(first, second), publisher, time.Second, 1, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	for range 2 {
+		if _, err := relay.RelayBatch(context.Background()); err != nil {
+			t.Fatalf("RelayBatch: %v", err)
+		}
+	}
+
+	got := publisher.Events()
+	if len(got) != 2 || got[0].ID != first.ID || got[1].ID != second.ID {
+		t.Fatalf("expected both events in order, got %v", got)
+	}
+}
+
+func TestJSONLPublisher_AppendsOneLinePerEvent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	publisher, err := events.NewJSONLPublisher(path)
+	if err != nil {
+		t.Fatalf("NewJSONLPublisher: %v", err)
+	}
+	first, second := newTestEvent(t, "o1"), newTestEvent(t, "o2")
+	for _, event := range []entity.Event{first, second} {
+		if err := publisher.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+	if err := publisher.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer file.Close()
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line is not JSON: %v", err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	if lines[0]["id"] != first.ID.String() || lines[0]["type"] != entity.EventOrderCreated {
+		t.Fatalf("unexpected first line %v", lines[0])
+	}
+	if payload, ok := lines[1]["payload"].(map[string]any); !ok || payload["id"] != "o2" {
+		t.Fatalf("expected the payload to be embedded as JSON, got %v", lines[1]["payload"])
+	}
+}
@@ -0,0 +1,506 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/payments"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/ExonegeS/money"
+)
+
+type mockPaymentsRepository struct {
+	payments map[entity.UUID]*entity.Payment
+}
+
+func newMockPaymentsRepository() *mockPaymentsRepository {
+	return &mockPaymentsRepository{payments: make(map[entity.UUID]*entity.Payment)}
+}
+
+// paid reports whether the order has a charge that is authorized or
+// captured.
+func (m *mockPaymentsRepository) paid(orderID entity.UUID) bool {
+	for _, payment := range m.payments {
+		if payment.OrderID == orderID && payment.Kind == entity.PaymentKindCharge &&
+			(payment.Status == entity.PaymentStatusAuthorized || payment.Status == entity.PaymentStatusCaptured) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockPaymentsRepository) SavePayment(ctx context.Context, payment entity.Payment) error {
+	m.payments[payment.ID] = &payment
+	return nil
+}
+func (m *mockPaymentsRepository) GetPaymentByID(ctx context.Context, id entity.UUID) (*entity.Payment, error) {
+	payment, ok := m.payments[id]
+	if !ok {
+		return nil, entity.ErrPaymentNotFound
+	}
+	copied := *payment
+	return &copied, nil
+}
+func (m *mockPaymentsRepository) GetPaymentsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Payment, error) {
+	var payments []*entity.Payment
+	for _, payment := range m.payments {
+		if payment.OrderID == orderID {
+			copied := *payment
+			payments = append(payments, &copied)
+		}
+	}
+	return payments, nil
+}
+func (m *mockPaymentsRepository) UpdatePaymentByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Payment) (bool, error)) error {
+	payment, ok := m.payments[id]
+	if !ok {
+		return entity.ErrPaymentNotFound
+	}
+	copied := *payment
+	updated, err := updateFn(&copied)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return entity.ErrNotUpdated
+	}
+	m.payments[id] = &copied
+	return nil
+}
+func (m *mockPaymentsRepository) CreateRefund(ctx context.Context, chargeID entity.UUID, createFn func(*entity.Payment, []*entity.Payment) (*entity.Payment, error)) error {
+	charge, err := m.GetPaymentByID(ctx, chargeID)
+	if err != nil {
+		return err
+	}
+	var refunds []*entity.Payment
+	for _, payment := range m.payments {
+		if payment.ParentID == chargeID {
+			copied := *payment
+			refunds = append(refunds, &copied)
+		}
+	}
+	refund, err := createFn(charge, refunds)
+	if err != nil {
+		return err
+	}
+	return m.SavePayment(ctx, *refund)
+}
+
+// flakyRefundProvider fails the next refundFailures refunds before they
+// reach the provider it wraps.
+type flakyRefundProvider struct {
+	ports.PaymentProvider
+	refundFailures int
+}
+
+func (p *flakyRefundProvider) Refund(ctx context.Context, reference string, refundID entity.UUID, amount money.Money) error {
+	if p.refundFailures > 0 {
+		p.refundFailures--
+		return errors.New("provider unavailable")
+	}
+	return p.PaymentProvider.Refund(ctx, reference, refundID, amount)
+}
+
+func newPaymentsFixture(status entity.OrderStatus) (*testEnv, *entity.Order, application.PaymentsService) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{p1: 0, p2: 0})
+	order := env.addOrder(status,
+		entity.OrderItem{ProductID: p1, ProductPrice: money.New(1000, "USD"), Quantity: 2},
+		entity.OrderItem{ProductID: p2, ProductPrice: money.New(500, "USD"), Quantity: 1},
+	)
+	order.TotalAmount = money.New(2500, "USD")
+
+	service := application.NewPaymentsService(newMockPaymentsRepository(), payments.NewFakeProvider(), env.orders, env.inventory, env.timeSource)
+	return env, order, service
+}
+
+// newCompletedOrder pays for a pending fixture order and completes it.
+func newCompletedOrder(t *testing.T) (*testEnv, *entity.Order, application.PaymentsService) {
+	t.Helper()
+	env, order, service := newPaymentsFixture(entity.OrderStatusPending)
+	ctx := context.Background()
+
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	order.Status = entity.OrderStatusCompleted
+	return env, order, service
+}
+
+func TestPayments_CaptureMovesOrderToProcessing(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusPending)
+	ctx := context.Background()
+
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if payment.Amount != money.New(2500, "USD") || payment.Status != entity.PaymentStatusPending {
+		t.Errorf("unexpected payment intent: %+v", payment)
+	}
+	if _, err := service.CreatePaymentIntent(ctx, order.ID, "card", "EUR"); !errors.Is(err, entity.ErrInvalidRequestPayload) {
+		t.Errorf("expected a currency other than the order's to be rejected, got %v", err)
+	}
+
+	again, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil || again.ID != payment.ID {
+		t.Errorf("expected the open intent to be reused, got %v (%v)", again, err)
+	}
+
+	if payment, err = service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if payment.Status != entity.PaymentStatusAuthorized {
+		t.Errorf("expected authorized, got %s", payment.Status)
+	}
+	if order.Status != entity.OrderStatusPending {
+		t.Errorf("expected authorization to leave the order pending, got %s", order.Status)
+	}
+
+	for i := 0; i < 2; i++ {
+		if payment, err = service.CapturePayment(ctx, payment.ID); err != nil {
+			t.Fatalf("capture %d: expected no error, got %v", i+1, err)
+		}
+	}
+	if payment.Status != entity.PaymentStatusCaptured {
+		t.Errorf("expected captured, got %s", payment.Status)
+	}
+	if order.Status != entity.OrderStatusProcessing {
+		t.Errorf("expected processing, got %s", order.Status)
+	}
+
+	if _, err := service.CreatePaymentIntent(ctx, order.ID, "card", ""); !errors.Is(err, entity.ErrOrderAlreadyPaid) {
+		t.Errorf("expected ErrOrderAlreadyPaid, got %v", err)
+	}
+}
+
+func TestPayments_DeclineIsRecorded(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusPending)
+	ctx := context.Background()
+
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, payments.FakeMethodDecline, "usd")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if payment.Amount.Currency != "USD" {
+		t.Errorf("expected the order's currency, got %s", payment.Amount.Currency)
+	}
+
+	declined, err := service.AuthorizePayment(ctx, payment.ID)
+	if !errors.Is(err, entity.ErrPaymentDeclined) {
+		t.Fatalf("expected ErrPaymentDeclined, got %v", err)
+	}
+	if declined.Status != entity.PaymentStatusFailed || declined.FailureReason == "" {
+		t.Errorf("expected a failed payment with a reason, got %+v", declined)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); !errors.Is(err, entity.ErrInvalidPaymentTransition) {
+		t.Errorf("expected ErrInvalidPaymentTransition, got %v", err)
+	}
+
+	retry, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected a new intent after a decline, got %v", err)
+	}
+	if retry.ID == payment.ID {
+		t.Error("expected a new payment after a decline")
+	}
+}
+
+func TestPayments_CaptureErrorLeavesPaymentAuthorized(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusPending)
+	ctx := context.Background()
+
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, payments.FakeMethodCaptureError, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); err == nil {
+		t.Fatal("expected capture to fail")
+	}
+
+	stored, err := service.GetPayment(ctx, payment.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stored.Status != entity.PaymentStatusAuthorized {
+		t.Errorf("expected payment to stay authorized, got %s", stored.Status)
+	}
+	if order.Status != entity.OrderStatusPending {
+		t.Errorf("expected order to stay pending, got %s", order.Status)
+	}
+}
+
+func TestPayments_RejectsCancelledOrder(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusCancelled)
+
+	_, err := service.CreatePaymentIntent(context.Background(), order.ID, "card", "")
+	if !errors.Is(err, entity.ErrOrderNotPayable) {
+		t.Errorf("expected ErrOrderNotPayable, got %v", err)
+	}
+}
+
+func TestRefundOrder_PartialThenFull(t *testing.T) {
+	env, order, service := newCompletedOrder(t)
+	inventory := env.inventory
+	ctx := context.Background()
+	p1, p2 := order.Items[0].ProductID, order.Items[1].ProductID
+
+	partial, err := service.RefundOrder(ctx, order.ID, application.RefundParams{
+		Lines:   []entity.RefundLine{{ProductID: p1, Quantity: 1}},
+		Restock: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if partial.Kind != entity.PaymentKindRefund || partial.Status != entity.PaymentStatusRefunded || partial.Amount != money.New(1000, "USD") {
+		t.Errorf("unexpected partial refund: %+v", partial)
+	}
+	if order.Status != entity.OrderStatusCompleted {
+		t.Errorf("expected a partial refund to keep the order completed, got %s", order.Status)
+	}
+	if inventory.stock[p1] != 1 {
+		t.Errorf("expected one unit restocked, got %d", inventory.stock[p1])
+	}
+
+	_, err = service.RefundOrder(ctx, order.ID, application.RefundParams{
+		Lines: []entity.RefundLine{{ProductID: p1, Quantity: 2}},
+	})
+	if !errors.Is(err, entity.ErrInvalidQuantity) {
+		t.Errorf("expected ErrInvalidQuantity for refunding more than was left, got %v", err)
+	}
+
+	rest, err := service.RefundOrder(ctx, order.ID, application.RefundParams{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rest.Amount != money.New(1500, "USD") || len(rest.Lines) != 2 {
+		t.Errorf("expected the remaining 15 over two lines, got %v over %d", rest.Amount, len(rest.Lines))
+	}
+	if inventory.stock[p2] != 0 {
+		t.Errorf("expected no restock without the flag, got %d", inventory.stock[p2])
+	}
+	if order.Status != entity.OrderStatusRefunded {
+		t.Errorf("expected refunded, got %s", order.Status)
+	}
+}
+
+func TestRefundOrder_NeverExceedsCaptured(t *testing.T) {
+	_, order, service := newCompletedOrder(t)
+	ctx := context.Background()
+
+	// The charge captured 25; at the new price two units are worth 40.
+	order.Items[0].ProductPrice = money.New(2000, "USD")
+	_, err := service.RefundOrder(ctx, order.ID, application.RefundParams{
+		Lines: []entity.RefundLine{{ProductID: order.Items[0].ProductID, Quantity: 2}},
+	})
+	if !errors.Is(err, entity.ErrRefundExceedsCaptured) {
+		t.Fatalf("expected ErrRefundExceedsCaptured, got %v", err)
+	}
+
+	refund, err := service.RefundOrder(ctx, order.ID, application.RefundParams{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if refund.Amount != money.New(2500, "USD") {
+		t.Errorf("expected a full refund to be capped at 25, got %v", refund.Amount)
+	}
+	var lines []money.Money
+	for _, line := range refund.Lines {
+		lines = append(lines, line.Amount)
+	}
+	if total, err := money.Sum("USD", lines...); err != nil || total != refund.Amount {
+		t.Errorf("expected the refund lines to add up to %v, got %v (%v)", refund.Amount, total, err)
+	}
+	if _, err := service.RefundOrder(ctx, order.ID, application.RefundParams{}); !errors.Is(err, entity.ErrOrderNotRefundable) {
+		t.Errorf("expected a refunded order to reject further refunds, got %v", err)
+	}
+}
+
+func TestRefundOrder_RequiresCompletedOrder(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusProcessing)
+
+	_, err := service.RefundOrder(context.Background(), order.ID, application.RefundParams{})
+	if !errors.Is(err, entity.ErrOrderNotRefundable) {
+		t.Errorf("expected ErrOrderNotRefundable, got %v", err)
+	}
+}
+
+func TestRefundOrder_SettlesStalePendingRefund(t *testing.T) {
+	tests := []struct {
+		name           string
+		refundFailures int
+		wantStale      entity.PaymentStatus
+		wantRefund     money.Money
+	}{
+		{"paid out", 0, entity.PaymentStatusRefunded, money.New(1500, "USD")},
+		{"never paid", 1, entity.PaymentStatusFailed, money.New(2500, "USD")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, order, _ := newPaymentsFixture(entity.OrderStatusPending)
+			provider := &flakyRefundProvider{PaymentProvider: payments.NewFakeProvider()}
+			paymentsRepo := newMockPaymentsRepository()
+			service := application.NewPaymentsService(paymentsRepo, provider, env.orders, env.inventory, env.timeSource)
+			ctx := context.Background()
+
+			charge, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if _, err := service.AuthorizePayment(ctx, charge.ID); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if charge, err = service.CapturePayment(ctx, charge.ID); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			order.Status = entity.OrderStatusCompleted
+
+			// A refund whose request died before the provider answered.
+			stale := entity.Payment{
+				ID:                entity.NewUUID(),
+				OrderID:           order.ID,
+				Kind:              entity.PaymentKindRefund,
+				ParentID:          charge.ID,
+				Amount:            money.New(1000, "USD"),
+				Status:            entity.PaymentStatusPending,
+				ProviderReference: charge.ProviderReference,
+				CreatedAt:         env.now,
+			}
+			paymentsRepo.payments[stale.ID] = &stale
+			if tt.refundFailures == 0 {
+				if err := provider.Refund(ctx, charge.ProviderReference, stale.ID, stale.Amount); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			env.now = env.now.Add(time.Hour)
+			provider.refundFailures = tt.refundFailures
+			refund, err := service.RefundOrder(ctx, order.ID, application.RefundParams{})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := paymentsRepo.payments[stale.ID].Status; got != tt.wantStale {
+				t.Errorf("expected the stale refund to be settled as %s, got %s", tt.wantStale, got)
+			}
+			if refund.Amount != tt.wantRefund {
+				t.Errorf("expected a refund of %v, got %v", tt.wantRefund, refund.Amount)
+			}
+		})
+	}
+}
+
+func TestRefundOnCancel_RefundsCapturedCharge(t *testing.T) {
+	ordersRepo := newMockOrdersRepository()
+	p1 := entity.NewUUID()
+	order := &entity.Order{
+		ID:          entity.NewUUID(),
+		TotalAmount: money.New(2000, "USD"),
+		Status:      entity.OrderStatusPending,
+		Items:       []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1000, "USD"), Quantity: 2}},
+	}
+	ordersRepo.orders[order.ID] = order
+
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 0})
+	stateMachine := entity.NewOrderStateMachine()
+	orders := application.NewOrdersService(ordersRepo, newMockSagaRepository(), newMockCouponRepository(), nil, inventory, stateMachine, fixedTime)
+	paymentsRepo := newMockPaymentsRepository()
+	service := application.NewPaymentsService(paymentsRepo, payments.NewFakeProvider(), orders, inventory, fixedTime)
+	stateMachine.OnEnterTask(entity.OrderStatusCancelled, entity.OrderTaskRefund)
+	orders.HandleTask(entity.OrderTaskRefund, application.RefundOnCancel(service))
+
+	ctx := context.Background()
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := orders.CancelOrder(ctx, order.ID, "changed my mind"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var refunded []*entity.Payment
+	for _, p := range paymentsRepo.payments {
+		if p.Kind == entity.PaymentKindRefund {
+			refunded = append(refunded, p)
+		}
+	}
+	if len(refunded) != 1 || refunded[0].Amount != money.New(2000, "USD") || refunded[0].Status != entity.PaymentStatusRefunded {
+		t.Fatalf("expected the charge to be refunded in full, got %+v", refunded)
+	}
+	if order.Status != entity.OrderStatusCancelled {
+		t.Errorf("expected the order to stay cancelled, got %s", order.Status)
+	}
+	if inventory.stock[p1] != 2 || len(inventory.releases) != 1 {
+		t.Errorf("expected the stock to be returned once by the cancel, got %d after %v", inventory.stock[p1], inventory.releases)
+	}
+
+	if _, err := service.RefundOrder(ctx, order.ID, application.RefundParams{}); !errors.Is(err, entity.ErrNothingToRefund) {
+		t.Errorf("expected ErrNothingToRefund once the charge is refunded, got %v", err)
+	}
+	if _, err := service.RefundOrder(ctx, order.ID, application.RefundParams{Restock: true}); !errors.Is(err, entity.ErrInvalidRequestPayload) {
+		t.Errorf("expected restocking a cancelled order to be rejected, got %v", err)
+	}
+}
+
+func TestRefundOnCancel_RetriedByCancellingAgain(t *testing.T) {
+	env, order, _ := newPaymentsFixture(entity.OrderStatusPending)
+	provider := &flakyRefundProvider{PaymentProvider: payments.NewFakeProvider()}
+	paymentsRepo := newMockPaymentsRepository()
+	service := application.NewPaymentsService(paymentsRepo, provider, env.orders, env.inventory, env.timeSource)
+	stateMachine := entity.NewOrderStateMachine()
+	env.orders = application.NewOrdersService(env.ordersRepo, newMockSagaRepository(), env.couponRepo, nil, env.inventory, stateMachine, env.timeSource)
+	stateMachine.OnEnterTask(entity.OrderStatusCancelled, entity.OrderTaskRefund)
+	env.orders.HandleTask(entity.OrderTaskRefund, application.RefundOnCancel(service))
+
+	ctx := context.Background()
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	provider.refundFailures = 1
+	if _, err := env.orders.CancelOrder(ctx, order.ID, ""); err == nil {
+		t.Fatal("expected the failed refund to be reported")
+	}
+	if _, err := env.orders.CancelOrder(ctx, order.ID, ""); err != nil {
+		t.Fatalf("expected the retry to refund, got %v", err)
+	}
+
+	var refunded money.Money
+	for _, p := range paymentsRepo.payments {
+		if p.Kind == entity.PaymentKindRefund && p.Status == entity.PaymentStatusRefunded {
+			refunded = p.Amount
+		}
+	}
+	if refunded != money.New(2500, "USD") {
+		t.Errorf("expected the retry to refund the charge in full, got %v", refunded)
+	}
+	if task := env.ordersRepo.tasks["refund:"+order.ID.String()]; task == nil || task.Status != entity.OrderTaskDone {
+		t.Errorf("expected the refund task to be done, got %+v", task)
+	}
+}
@@ -0,0 +1,142 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	inventory "github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/grpc/inventory"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+func TestReservationBatcher_CoalescesConcurrentCallers(t *testing.T) {
+	var calls atomic.Int64
+	batcher := inventory.NewReservationBatcher(inventory.ReservationBatcherConfig{
+		MaxBatchSize:  1000,
+		FlushInterval: 20 * time.Millisecond,
+	}, func(ctx context.Context, holds []inventory.ReservationHold) error {
+		calls.Add(1)
+		return nil
+	})
+	defer batcher.Close()
+
+	const callers = 50
+	errs := make(chan error, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- batcher.Reserve(context.Background(), entity.NewUUID(), []entity.OrderItem{{ProductID: entity.NewUUID(), Quantity: 1}})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if got := calls.Load(); got >= callers {
+		t.Errorf("expected fewer than %d round trips, got %d", callers, got)
+	}
+}
+
+func TestReservationBatcher_FlushesOnMaxBatchSize(t *testing.T) {
+	batcher := inventory.NewReservationBatcher(inventory.ReservationBatcherConfig{
+		MaxBatchSize:  2,
+		FlushInterval: time.Hour,
+	}, func(ctx context.Context, holds []inventory.ReservationHold) error {
+		return nil
+	})
+	defer batcher.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- batcher.Reserve(context.Background(), entity.NewUUID(), []entity.OrderItem{
+			{ProductID: entity.NewUUID(), Quantity: 1},
+			{ProductID: entity.NewUUID(), Quantity: 1},
+		})
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a full batch to be flushed without waiting for the interval")
+	}
+}
+
+func TestReservationBatcher_RejectedBatchFallsBackPerCaller(t *testing.T) {
+	unavailable := entity.NewUUID()
+	var mu sync.Mutex
+	var batches [][]inventory.ReservationHold
+	batcher := inventory.NewReservationBatcher(inventory.ReservationBatcherConfig{
+		MaxBatchSize:  3,
+		FlushInterval: time.Hour,
+	}, func(ctx context.Context, holds []inventory.ReservationHold) error {
+		mu.Lock()
+		batches = append(batches, holds)
+		mu.Unlock()
+		for _, hold := range holds {
+			for _, item := range hold.Items {
+				if item.ProductID == unavailable {
+					return entity.ErrInsufficientQuantity
+				}
+			}
+		}
+		return nil
+	})
+	defer batcher.Close()
+
+	results := make(map[entity.UUID]error)
+	var resultsMu sync.Mutex
+	var wg sync.WaitGroup
+	for _, id := range []entity.UUID{entity.NewUUID(), unavailable, entity.NewUUID()} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := batcher.Reserve(context.Background(), entity.NewUUID(), []entity.OrderItem{{ProductID: id, Quantity: 1}})
+			resultsMu.Lock()
+			results[id] = err
+			resultsMu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	for id, err := range results {
+		if id == unavailable {
+			if !errors.Is(err, entity.ErrInsufficientQuantity) {
+				t.Errorf("expected ErrInsufficientQuantity for the unavailable product, got %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("expected product %s to be reserved, got %v", id, err)
+		}
+	}
+	if len(batches) != 4 {
+		t.Errorf("expected one combined and three individual requests, got %d", len(batches))
+	}
+}
+
+func TestReservationBatcher_Closed(t *testing.T) {
+	batcher := inventory.NewReservationBatcher(inventory.ReservationBatcherConfig{
+		MaxBatchSize:  10,
+		FlushInterval: time.Millisecond,
+	}, func(ctx context.Context, holds []inventory.ReservationHold) error {
+		return nil
+	})
+	batcher.Close()
+
+	err := batcher.Reserve(context.Background(), entity.NewUUID(), []entity.OrderItem{{ProductID: entity.NewUUID(), Quantity: 1}})
+	if !errors.Is(err, inventory.ErrBatcherClosed) {
+		t.Fatalf("expected ErrBatcherClosed, got %v", err)
+	}
+}
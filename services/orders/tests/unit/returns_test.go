@@ -0,0 +1,208 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockReturnRepository struct {
+	orders  *mockOrdersRepository
+	returns *fakeStore[entity.Return]
+}
+
+func newMockReturnRepository(orders *mockOrdersRepository) *mockReturnRepository {
+	return &mockReturnRepository{orders: orders, returns: newFakeStore[entity.Return](entity.ErrReturnNotFound)}
+}
+
+func (m *mockReturnRepository) CreateReturn(ctx context.Context, orderID entity.UUID, createFn func(*entity.Order, []*entity.Return) (*entity.Return, error)) error {
+	order, err := m.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	returns, _ := m.GetReturnsByOrderID(ctx, orderID)
+	r, err := createFn(order, returns)
+	if err != nil {
+		return err
+	}
+	m.returns.put(r.ID, *r)
+	return nil
+}
+func (m *mockReturnRepository) GetReturnByID(ctx context.Context, id entity.UUID) (*entity.Return, error) {
+	return m.returns.get(id)
+}
+func (m *mockReturnRepository) GetReturnsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Return, error) {
+	return m.returns.find(func(r *entity.Return) bool { return r.OrderID == orderID }), nil
+}
+func (m *mockReturnRepository) UpdateReturnByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Order, *entity.Return) (bool, error)) error {
+	return m.returns.update(id, func(r *entity.Return) (bool, error) {
+		order, err := m.orders.GetOrderByID(ctx, r.OrderID)
+		if err != nil {
+			return false, err
+		}
+		return updateFn(order, r)
+	})
+}
+
+func newReturnsFixture(t *testing.T) (*testEnv, *entity.Order, application.ReturnsService) {
+	t.Helper()
+	env, order, payments := newCompletedOrder(t)
+	return env, order, application.NewReturnsService(newMockReturnRepository(env.ordersRepo), env.orders, payments, env.inventory, env.timeSource)
+}
+
+func TestRequestReturn_LimitsQuantities(t *testing.T) {
+	_, order, service := newReturnsFixture(t)
+	ctx := application.WithActor(context.Background(), "customer-1")
+	p1 := order.Items[0].ProductID
+
+	first, err := service.RequestReturn(ctx, order.ID, application.RequestReturnParams{
+		Items: []entity.ReturnItem{{ProductID: p1, Quantity: 2, Reason: "too small"}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if first.Status != entity.ReturnStatusRequested {
+		t.Errorf("expected a requested return, got %s", first.Status)
+	}
+
+	_, err = service.RequestReturn(ctx, order.ID, application.RequestReturnParams{Items: []entity.ReturnItem{{ProductID: p1, Quantity: 1}}})
+	if !errors.Is(err, entity.ErrInvalidReturn) {
+		t.Errorf("expected ErrInvalidReturn for items already being returned, got %v", err)
+	}
+
+	if _, err := service.RejectReturn(ctx, first.ID, "outside the return window"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.RequestReturn(ctx, order.ID, application.RequestReturnParams{Items: []entity.ReturnItem{{ProductID: p1, Quantity: 1}}}); err != nil {
+		t.Errorf("expected a rejected return to give its items back, got %v", err)
+	}
+
+	history := returnHistory(order)
+	if len(history) != 3 || history[1].ReturnID != first.ID || history[1].ReturnStatus != entity.ReturnStatusRejected {
+		t.Fatalf("expected the returns in the order history, got %+v", history)
+	}
+	if history[1].To != entity.OrderStatusCompleted || history[1].Actor != "customer-1" || history[1].Reason != "outside the return window" {
+		t.Errorf("unexpected history entry: %+v", history[1])
+	}
+}
+
+func TestRequestReturn_RequiresCompletedOrder(t *testing.T) {
+	_, order, service := newReturnsFixture(t)
+	order.Status = entity.OrderStatusProcessing
+
+	_, err := service.RequestReturn(context.Background(), order.ID, application.RequestReturnParams{
+		Items: []entity.ReturnItem{{ProductID: order.Items[0].ProductID, Quantity: 1}},
+	})
+	if !errors.Is(err, entity.ErrOrderNotReturnable) {
+		t.Errorf("expected ErrOrderNotReturnable, got %v", err)
+	}
+}
+
+func TestReturnWorkflow_RestocksAndRefunds(t *testing.T) {
+	env, order, service := newReturnsFixture(t)
+	inventory := env.inventory
+	ctx := context.Background()
+	p1, p2 := order.Items[0].ProductID, order.Items[1].ProductID
+
+	r, err := service.RequestReturn(ctx, order.ID, application.RequestReturnParams{
+		Items: []entity.ReturnItem{{ProductID: p1, Quantity: 1}, {ProductID: p2, Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := service.RefundReturn(ctx, r.ID); !errors.Is(err, entity.ErrInvalidReturnTransition) {
+		t.Errorf("expected a return to be received before its refund, got %v", err)
+	}
+	if _, err := service.ApproveReturn(ctx, r.ID, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	conditions := map[entity.UUID]entity.ItemCondition{p1: entity.ItemConditionResellable}
+	if _, err := service.ReceiveReturn(ctx, r.ID, conditions); !errors.Is(err, entity.ErrInvalidReturn) {
+		t.Errorf("expected every item to need a condition, got %v", err)
+	}
+	conditions[p2] = entity.ItemConditionDamaged
+	if _, err := service.ReceiveReturn(ctx, r.ID, conditions); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.ReceiveReturn(ctx, r.ID, conditions); err != nil {
+		t.Fatalf("expected receiving twice to succeed, got %v", err)
+	}
+	if inventory.stock[p1] != 1 || inventory.stock[p2] != 0 {
+		t.Errorf("expected only the resellable item to be restocked once, got %v", inventory.stock)
+	}
+	if _, err := service.RejectReturn(ctx, r.ID, "too late"); !errors.Is(err, entity.ErrInvalidReturnTransition) {
+		t.Errorf("expected a received return, already restocked, not to be rejectable, got %v", err)
+	}
+
+	refunded, err := service.RefundReturn(ctx, r.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if refunded.Status != entity.ReturnStatusRefunded || refunded.RefundID == "" {
+		t.Errorf("expected the return to be refunded, got %+v", refunded)
+	}
+	if order.Status != entity.OrderStatusCompleted {
+		t.Errorf("expected a partial return to leave the order completed, got %s", order.Status)
+	}
+
+	if _, err := service.RejectReturn(ctx, r.ID, "changed our mind"); !errors.Is(err, entity.ErrInvalidReturnTransition) {
+		t.Errorf("expected a refunded return to be final, got %v", err)
+	}
+
+	var statuses []entity.ReturnStatus
+	for _, entry := range returnHistory(order) {
+		statuses = append(statuses, entry.ReturnStatus)
+	}
+	if len(statuses) != 4 || statuses[3] != entity.ReturnStatusRefunded {
+		t.Errorf("expected each step in the order history, got %v", statuses)
+	}
+}
+
+func TestRefundReturn_FullReturnRefundsOrder(t *testing.T) {
+	_, order, service := newReturnsFixture(t)
+	ctx := context.Background()
+
+	var items []entity.ReturnItem
+	conditions := make(map[entity.UUID]entity.ItemCondition)
+	for _, item := range order.Items {
+		items = append(items, entity.ReturnItem{ProductID: item.ProductID, Quantity: item.Quantity})
+		conditions[item.ProductID] = entity.ItemConditionDamaged
+	}
+
+	r, err := service.RequestReturn(ctx, order.ID, application.RequestReturnParams{Items: items})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.ApproveReturn(ctx, r.ID, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.ReceiveReturn(ctx, r.ID, conditions); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.RefundReturn(ctx, r.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.RefundReturn(ctx, r.ID); err != nil {
+		t.Errorf("expected refunding twice to succeed, got %v", err)
+	}
+	if order.Status != entity.OrderStatusRefunded {
+		t.Errorf("expected returning everything to refund the order, got %s", order.Status)
+	}
+}
+
+// returnHistory lists the entries of the order's history that belong to
+// its returns.
+func returnHistory(order *entity.Order) []entity.StatusTransition {
+	var history []entity.StatusTransition
+	for _, entry := range order.StatusHistory {
+		if entry.ReturnID != "" {
+			history = append(history, entry)
+		}
+	}
+	return history
+}
@@ -0,0 +1,166 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockShipmentRepository struct {
+	orders    *mockOrdersRepository
+	shipments *fakeStore[entity.Shipment]
+}
+
+func newMockShipmentRepository(orders *mockOrdersRepository) *mockShipmentRepository {
+	return &mockShipmentRepository{orders: orders, shipments: newFakeStore[entity.Shipment](entity.ErrShipmentNotFound)}
+}
+
+func (m *mockShipmentRepository) CreateShipment(ctx context.Context, orderID entity.UUID, createFn func(*entity.Order, []*entity.Shipment) (*entity.Shipment, error)) error {
+	order, err := m.orders.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	shipments, _ := m.GetShipmentsByOrderID(ctx, orderID)
+	shipment, err := createFn(order, shipments)
+	if err != nil {
+		return err
+	}
+	m.shipments.put(shipment.ID, *shipment)
+	return nil
+}
+func (m *mockShipmentRepository) GetShipmentByID(ctx context.Context, id entity.UUID) (*entity.Shipment, error) {
+	return m.shipments.get(id)
+}
+func (m *mockShipmentRepository) GetShipmentsByOrderID(ctx context.Context, orderID entity.UUID) ([]*entity.Shipment, error) {
+	return m.shipments.find(func(shipment *entity.Shipment) bool { return shipment.OrderID == orderID }), nil
+}
+func (m *mockShipmentRepository) UpdateShipmentByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Shipment) (bool, error)) error {
+	return m.shipments.update(id, updateFn)
+}
+
+func newShipmentsFixture(status entity.OrderStatus) (*testEnv, *entity.Order, application.ShipmentsService) {
+	env := newTestEnv(nil)
+	order := env.addOrder(status,
+		entity.OrderItem{ProductID: entity.NewUUID(), Quantity: 2},
+		entity.OrderItem{ProductID: entity.NewUUID(), Quantity: 1},
+	)
+	return env, order, application.NewShipmentsService(newMockShipmentRepository(env.ordersRepo), env.orders, env.timeSource)
+}
+
+func TestCreateShipment_LimitsQuantities(t *testing.T) {
+	_, order, service := newShipmentsFixture(entity.OrderStatusProcessing)
+	ctx := context.Background()
+	p1, p2 := order.Items[0].ProductID, order.Items[1].ProductID
+
+	first, err := service.CreateShipment(ctx, order.ID, application.CreateShipmentParams{
+		Carrier:        "UPS",
+		TrackingNumber: "1Z001",
+		Items:          []entity.ShipmentItem{{ProductID: p1, Quantity: 1}, {ProductID: p1, Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(first.Items) != 1 || first.Items[0].Quantity != 2 {
+		t.Errorf("expected repeated items to be merged, got %+v", first.Items)
+	}
+
+	_, err = service.CreateShipment(ctx, order.ID, application.CreateShipmentParams{
+		Carrier:        "UPS",
+		TrackingNumber: "1Z002",
+		Items:          []entity.ShipmentItem{{ProductID: p1, Quantity: 1}},
+	})
+	if !errors.Is(err, entity.ErrInvalidShipment) {
+		t.Errorf("expected ErrInvalidShipment for an item already shipped, got %v", err)
+	}
+
+	rest, err := service.CreateShipment(ctx, order.ID, application.CreateShipmentParams{Carrier: "UPS", TrackingNumber: "1Z003"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(rest.Items) != 1 || rest.Items[0].ProductID != p2 || rest.Items[0].Quantity != 1 {
+		t.Errorf("expected the remaining item to ship, got %+v", rest.Items)
+	}
+
+	_, err = service.CreateShipment(ctx, order.ID, application.CreateShipmentParams{Carrier: "UPS", TrackingNumber: "1Z004"})
+	if !errors.Is(err, entity.ErrInvalidShipment) {
+		t.Errorf("expected ErrInvalidShipment with nothing left to ship, got %v", err)
+	}
+}
+
+func TestCreateShipment_RequiresProcessingOrder(t *testing.T) {
+	_, order, service := newShipmentsFixture(entity.OrderStatusPending)
+
+	_, err := service.CreateShipment(context.Background(), order.ID, application.CreateShipmentParams{Carrier: "UPS", TrackingNumber: "1Z001"})
+	if !errors.Is(err, entity.ErrOrderNotShippable) {
+		t.Errorf("expected ErrOrderNotShippable, got %v", err)
+	}
+}
+
+func TestMarkShipmentDelivered_CompletesOrder(t *testing.T) {
+	env, order, service := newShipmentsFixture(entity.OrderStatusProcessing)
+	ctx := context.Background()
+	p1 := order.Items[0].ProductID
+
+	first, err := service.CreateShipment(ctx, order.ID, application.CreateShipmentParams{
+		Carrier:        "UPS",
+		TrackingNumber: "1Z001",
+		Items:          []entity.ShipmentItem{{ProductID: p1, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second, err := service.CreateShipment(ctx, order.ID, application.CreateShipmentParams{Carrier: "DHL", TrackingNumber: "JD002"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := service.MarkShipmentDelivered(ctx, first.ID, fixedTime().Add(-time.Hour)); !errors.Is(err, entity.ErrInvalidShipment) {
+		t.Errorf("expected a delivery before shipping to be rejected, got %v", err)
+	}
+
+	delivered, err := service.MarkShipmentDelivered(ctx, first.ID, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if delivered.Status != entity.ShipmentStatusDelivered || !delivered.DeliveredAt.Equal(fixedTime()) {
+		t.Errorf("expected the shipment to be delivered now, got %+v", delivered)
+	}
+	if env.ordersRepo.orders[order.ID].Status != entity.OrderStatusProcessing {
+		t.Fatalf("expected the order to wait for its other shipment, got %s", env.ordersRepo.orders[order.ID].Status)
+	}
+
+	if _, err := service.MarkShipmentDelivered(ctx, second.ID, time.Time{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if env.ordersRepo.orders[order.ID].Status != entity.OrderStatusCompleted {
+		t.Errorf("expected the order to complete, got %s", env.ordersRepo.orders[order.ID].Status)
+	}
+
+	if _, err := service.MarkShipmentDelivered(ctx, second.ID, time.Time{}); err != nil {
+		t.Errorf("expected delivering twice to succeed, got %v", err)
+	}
+}
+
+func TestCreateOrder_TaxRegionFromShippingAddress(t *testing.T) {
+	p1 := entity.NewUUID()
+	service := application.NewOrdersService(newMockOrdersRepository(), newMockSagaRepository(), newMockCouponRepository(), newTestTaxCalculator(t), newMockInventoryClient(map[entity.UUID]int64{p1: 5}), nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	order.ShippingAddress = &entity.Address{Line1: "1 Main St", City: "Los Angeles", Region: "ca", Country: "us"}
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if order.TaxRegion != "US-CA" || len(order.TaxLines) != 1 || order.TaxLines[0].Region != "US-CA" {
+		t.Errorf("expected the order to be taxed where it ships, got %q %+v", order.TaxRegion, order.TaxLines)
+	}
+
+	invalid := newOrder(entity.OrderItem{ProductID: p1, Quantity: 1})
+	invalid.BillingAddress = &entity.Address{Line1: "1 Main St", City: "Berlin", Country: "Germany"}
+	if err := service.CreateOrder(context.Background(), invalid); !errors.Is(err, entity.ErrInvalidAddress) {
+		t.Errorf("expected ErrInvalidAddress, got %v", err)
+	}
+}
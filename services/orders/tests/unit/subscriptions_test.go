@@ -0,0 +1,270 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockSubscriptionRepository struct {
+	subscriptions *fakeStore[entity.Subscription]
+	runs          []*entity.SubscriptionRun
+}
+
+func newMockSubscriptionRepository() *mockSubscriptionRepository {
+	return &mockSubscriptionRepository{subscriptions: newFakeStore[entity.Subscription](entity.ErrSubscriptionNotFound)}
+}
+
+func (m *mockSubscriptionRepository) CreateSubscription(ctx context.Context, subscription *entity.Subscription) error {
+	m.subscriptions.put(subscription.ID, *subscription)
+	return nil
+}
+
+func (m *mockSubscriptionRepository) GetSubscriptionByID(ctx context.Context, id entity.UUID) (*entity.Subscription, error) {
+	return m.subscriptions.get(id)
+}
+
+func (m *mockSubscriptionRepository) GetSubscriptionsByUserID(ctx context.Context, userID entity.UUID) ([]*entity.Subscription, error) {
+	return m.subscriptions.find(func(subscription *entity.Subscription) bool { return subscription.UserID == userID }), nil
+}
+
+func (m *mockSubscriptionRepository) GetDueSubscriptions(ctx context.Context, now time.Time, limit int) ([]*entity.Subscription, error) {
+	due := m.subscriptions.find(func(subscription *entity.Subscription) bool { return subscription.Due(now) })
+	sort.Slice(due, func(i, j int) bool { return due[i].NextRunAt.Before(due[j].NextRunAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (m *mockSubscriptionRepository) UpdateSubscriptionByID(ctx context.Context, id entity.UUID, updateFn func(*entity.Subscription) (bool, error)) error {
+	return m.subscriptions.update(id, updateFn)
+}
+
+func (m *mockSubscriptionRepository) SaveSubscriptionRun(ctx context.Context, run *entity.SubscriptionRun) error {
+	m.runs = append(m.runs, run)
+	return nil
+}
+
+func (m *mockSubscriptionRepository) GetSubscriptionRuns(ctx context.Context, subscriptionID entity.UUID) ([]*entity.SubscriptionRun, error) {
+	var runs []*entity.SubscriptionRun
+	for i := len(m.runs) - 1; i >= 0; i-- {
+		if m.runs[i].SubscriptionID == subscriptionID {
+			runs = append(runs, m.runs[i])
+		}
+	}
+	return runs, nil
+}
+
+type subscriptionsFixture struct {
+	*testEnv
+	repo          *mockSubscriptionRepository
+	subscriptions application.SubscriptionsService
+	scheduler     *application.SubscriptionScheduler
+}
+
+func newSubscriptionsFixture(stock map[entity.UUID]int64) *subscriptionsFixture {
+	f := &subscriptionsFixture{
+		testEnv: newTestEnv(stock),
+		repo:    newMockSubscriptionRepository(),
+	}
+	f.subscriptions = application.NewSubscriptionsService(f.repo, f.orders, f.inventory, f.timeSource)
+	f.scheduler = application.NewSubscriptionScheduler(f.subscriptions, &mockLocker{}, time.Minute, 10, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return f
+}
+
+func (f *subscriptionsFixture) create(t *testing.T, draft entity.Subscription) *entity.Subscription {
+	t.Helper()
+	draft.UserID = entity.NewUUID()
+	draft.UserName = "Test User"
+	subscription, err := f.subscriptions.CreateSubscription(context.Background(), draft)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return subscription
+}
+
+func (f *subscriptionsFixture) get(t *testing.T, id entity.UUID) *entity.Subscription {
+	t.Helper()
+	subscription, err := f.subscriptions.GetSubscription(context.Background(), id)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return subscription
+}
+
+func (f *subscriptionsFixture) schedule(t *testing.T) []*entity.SubscriptionRun {
+	t.Helper()
+	runs, err := f.scheduler.Schedule(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return runs
+}
+
+func TestCadence_Cron(t *testing.T) {
+	// fixedTime is Monday, 1 January 2024.
+	tests := []struct {
+		cron  string
+		after time.Time
+		want  time.Time
+	}{
+		{"0 9 * * 1", fixedTime().Add(9 * time.Hour), time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", fixedTime().Add(7 * time.Minute), fixedTime().Add(15 * time.Minute)},
+		{"30 6 1 */3 *", fixedTime().Add(7 * time.Hour), time.Date(2024, 4, 1, 6, 30, 0, 0, time.UTC)},
+		// With both day fields set, either one matching will do.
+		{"0 0 13 * 5", fixedTime(), time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", fixedTime(), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", fixedTime(), time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		schedule, err := entity.ParseCron(tt.cron)
+		if err != nil {
+			t.Fatalf("%q: expected no error, got %v", tt.cron, err)
+		}
+		if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+			t.Errorf("%q after %s: expected %s, got %s", tt.cron, tt.after, tt.want, got)
+		}
+	}
+
+	for _, cron := range []string{"* * *", "61 * * * *", "0 0 30 2 *", "*/0 * * * *", "5-1 * * * *"} {
+		if _, err := entity.ParseCron(cron); !errors.Is(err, entity.ErrInvalidCadence) {
+			t.Errorf("%q: expected ErrInvalidCadence, got %v", cron, err)
+		}
+	}
+}
+
+func TestCadence_IntervalSkipsMissedCycles(t *testing.T) {
+	cadence := entity.Cadence{Interval: 12 * time.Hour}
+	prev := fixedTime()
+
+	if next := cadence.Next(prev, prev); !next.Equal(prev.Add(12 * time.Hour)) {
+		t.Errorf("expected the next cycle 12h on, got %s", next)
+	}
+	if next := cadence.Next(prev, prev.Add(25*time.Hour)); !next.Equal(prev.Add(36 * time.Hour)) {
+		t.Errorf("expected the missed cycles to be skipped, got %s", next)
+	}
+}
+
+func TestSubscriptionScheduler_PlacesOrderEachCycle(t *testing.T) {
+	product := entity.NewUUID()
+	f := newSubscriptionsFixture(map[entity.UUID]int64{product: 10})
+	subscription := f.create(t, entity.Subscription{
+		Items:   []entity.SubscriptionItem{{ProductID: product, Quantity: 2}},
+		Cadence: entity.Cadence{Interval: 7 * 24 * time.Hour},
+	})
+
+	runs := f.schedule(t)
+	if len(runs) != 1 || runs[0].Status != entity.SubscriptionRunOrdered || !runs[0].ScheduledAt.Equal(fixedTime()) {
+		t.Fatalf("expected one ordered run, got %+v", runs)
+	}
+	order := f.ordersRepo.orders[runs[0].OrderID]
+	if order == nil || order.UserID != subscription.UserID || order.Items[0].Quantity != 2 {
+		t.Fatalf("expected an order for the subscription's items, got %+v", order)
+	}
+	if actor := order.StatusHistory[0].Actor; actor != "subscription:"+subscription.ID.String() {
+		t.Errorf("expected the subscription as the actor, got %q", actor)
+	}
+	if f.inventory.stock[product] != 8 {
+		t.Errorf("expected the order to reserve stock, got %d", f.inventory.stock[product])
+	}
+	if stored := f.get(t, subscription.ID); !stored.NextRunAt.Equal(fixedTime().Add(7 * 24 * time.Hour)) {
+		t.Errorf("expected the next cycle a week on, got %s", stored.NextRunAt)
+	}
+
+	f.now = f.now.Add(time.Hour)
+	if runs := f.schedule(t); len(runs) != 0 {
+		t.Errorf("expected nothing to run before the next cycle, got %+v", runs)
+	}
+}
+
+func TestSubscriptionScheduler_StockPolicy(t *testing.T) {
+	tests := []struct {
+		policy     entity.StockPolicy
+		wantStatus entity.SubscriptionRunStatus
+		wantItems  int
+	}{
+		{entity.StockPolicySkip, entity.SubscriptionRunSkipped, 0},
+		{entity.StockPolicyPartial, entity.SubscriptionRunPartial, 2},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			plenty, scarce := entity.NewUUID(), entity.NewUUID()
+			f := newSubscriptionsFixture(map[entity.UUID]int64{plenty: 10, scarce: 1})
+			f.create(t, entity.Subscription{
+				Items: []entity.SubscriptionItem{
+					{ProductID: plenty, Quantity: 2},
+					{ProductID: scarce, Quantity: 3},
+				},
+				Cadence:     entity.Cadence{Cron: "0 * * * *"},
+				StockPolicy: tt.policy,
+			})
+
+			runs := f.schedule(t)
+			if len(runs) != 1 || runs[0].Status != tt.wantStatus || runs[0].Reason == "" {
+				t.Fatalf("expected one %s run with a reason, got %+v", tt.wantStatus, runs)
+			}
+			if tt.wantItems == 0 {
+				if len(f.ordersRepo.orders) != 0 {
+					t.Errorf("expected no order, got %d", len(f.ordersRepo.orders))
+				}
+				return
+			}
+			order := f.ordersRepo.orders[runs[0].OrderID]
+			if order == nil || len(order.Items) != tt.wantItems || f.inventory.stock[scarce] != 0 {
+				t.Errorf("expected what is in stock to be ordered, got %+v", order)
+			}
+		})
+	}
+}
+
+func TestSubscription_PauseResumeAndEnd(t *testing.T) {
+	product := entity.NewUUID()
+	f := newSubscriptionsFixture(map[entity.UUID]int64{product: 10})
+	day := 24 * time.Hour
+	subscription := f.create(t, entity.Subscription{
+		Items:   []entity.SubscriptionItem{{ProductID: product, Quantity: 1}},
+		Cadence: entity.Cadence{Interval: day},
+		StartAt: fixedTime().Add(day),
+		EndAt:   fixedTime().Add(10 * day),
+	})
+	ctx := context.Background()
+
+	if _, err := f.subscriptions.PauseSubscription(ctx, subscription.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	f.now = fixedTime().Add(5*day + time.Hour)
+	if runs := f.schedule(t); len(runs) != 0 {
+		t.Fatalf("expected a paused subscription not to run, got %+v", runs)
+	}
+
+	resumed, err := f.subscriptions.ResumeSubscription(ctx, subscription.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !resumed.NextRunAt.Equal(fixedTime().Add(6 * day)) {
+		t.Errorf("expected the missed cycles to be skipped, got %s", resumed.NextRunAt)
+	}
+	if _, err := f.subscriptions.ResumeSubscription(ctx, subscription.ID); err != nil {
+		t.Errorf("expected resuming an active subscription to be a no-op, got %v", err)
+	}
+
+	// The cycle on day 9 is the last one before the end date.
+	f.now = fixedTime().Add(9 * day)
+	if runs := f.schedule(t); len(runs) != 1 {
+		t.Fatalf("expected one run, got %+v", runs)
+	}
+	if stored := f.get(t, subscription.ID); stored.Status != entity.SubscriptionStatusEnded {
+		t.Errorf("expected the subscription to end after its last cycle, got %s", stored.Status)
+	}
+	if _, err := f.subscriptions.PauseSubscription(ctx, subscription.ID); !errors.Is(err, entity.ErrInvalidSubscriptionTransition) {
+		t.Errorf("expected ErrInvalidSubscriptionTransition, got %v", err)
+	}
+}
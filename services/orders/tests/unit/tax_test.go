@@ -0,0 +1,165 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/tax"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/ports"
+	"github.com/ExonegeS/money"
+)
+
+func newTestTaxCalculator(t *testing.T) ports.TaxCalculator {
+	t.Helper()
+	calculator, err := tax.NewRulesCalculator(tax.Rules{Regions: map[string]tax.RegionRules{
+		"US":    {Rates: map[string]string{"standard": "5"}},
+		"US-CA": {Rates: map[string]string{"standard": "7.25", "food": "0"}},
+		"DE":    {PricesIncludeTax: true, Rates: map[string]string{"standard": "19", "reduced": "7"}},
+	}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return calculator
+}
+
+func TestRulesCalculator_Calculate(t *testing.T) {
+	calculator := newTestTaxCalculator(t)
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+
+	tests := []struct {
+		name       string
+		region     string
+		items      []entity.OrderItem
+		discount   money.Money
+		wantRegion string
+		wantTax    []money.Money
+		inclusive  bool
+	}{
+		{
+			name:       "exclusive rate rounded per line",
+			region:     "us-ca",
+			items:      []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1000, "USD"), Quantity: 3}, {ProductID: p2, ProductPrice: money.New(1010, "USD"), Quantity: 1}},
+			wantRegion: "US-CA",
+			wantTax:    []money.Money{money.New(218, "USD"), money.New(73, "USD")},
+		},
+		{
+			name:       "category tax class",
+			region:     "US-CA",
+			items:      []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1000, "USD"), Quantity: 1, TaxClass: "food"}, {ProductID: p2, ProductPrice: money.New(1000, "USD"), Quantity: 1, TaxClass: "unknown"}},
+			wantRegion: "US-CA",
+			wantTax:    []money.Money{money.New(0, "USD"), money.New(73, "USD")},
+		},
+		{
+			name:       "falls back to the country",
+			region:     "US-NY",
+			items:      []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1000, "USD"), Quantity: 1}},
+			wantRegion: "US",
+			wantTax:    []money.Money{money.New(50, "USD")},
+		},
+		{
+			name:       "inclusive prices",
+			region:     "DE",
+			items:      []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1190, "EUR"), Quantity: 1}, {ProductID: p2, ProductPrice: money.New(1070, "EUR"), Quantity: 1, TaxClass: "Reduced"}},
+			wantRegion: "DE",
+			wantTax:    []money.Money{money.New(190, "EUR"), money.New(70, "EUR")},
+			inclusive:  true,
+		},
+		{
+			name:       "discount is shared by value",
+			region:     "US",
+			items:      []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1000, "USD"), Quantity: 1}, {ProductID: p2, ProductPrice: money.New(3000, "USD"), Quantity: 1}},
+			discount:   money.New(400, "USD"),
+			wantRegion: "US",
+			wantTax:    []money.Money{money.New(45, "USD"), money.New(135, "USD")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &entity.Order{TaxRegion: tt.region, Items: tt.items, TotalAmount: money.New(0, tt.items[0].ProductPrice.Currency)}
+			if tt.discount.IsPositive() {
+				order.Discounts = []entity.OrderDiscount{{Code: "TEST", Amount: tt.discount}}
+			}
+			lines, err := calculator.Calculate(context.Background(), order)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(lines) != len(tt.wantTax) {
+				t.Fatalf("expected %d tax lines, got %+v", len(tt.wantTax), lines)
+			}
+			for i, line := range lines {
+				if line.Amount != tt.wantTax[i] || line.Region != tt.wantRegion || line.Inclusive != tt.inclusive {
+					t.Errorf("line %d: expected %v in %s (inclusive %v), got %+v", i, tt.wantTax[i], tt.wantRegion, tt.inclusive, line)
+				}
+			}
+		})
+	}
+
+	untaxed := &entity.Order{TaxRegion: "FR", Items: []entity.OrderItem{{ProductID: p1, ProductPrice: money.New(1000, "EUR"), Quantity: 1}}}
+	if lines, err := calculator.Calculate(context.Background(), untaxed); err != nil || len(lines) != 0 {
+		t.Errorf("expected a region without rules not to be taxed, got %+v (%v)", lines, err)
+	}
+}
+
+func TestNewRulesCalculator_InvalidRate(t *testing.T) {
+	for _, rate := range []string{"", "abc", "-5", "7.2501", "101"} {
+		_, err := tax.NewRulesCalculator(tax.Rules{Regions: map[string]tax.RegionRules{"US": {Rates: map[string]string{"standard": rate}}}})
+		if !errors.Is(err, entity.ErrInvalidTaxRules) {
+			t.Errorf("rate %q: expected ErrInvalidTaxRules, got %v", rate, err)
+		}
+	}
+}
+
+func TestCreateOrder_AddsTax(t *testing.T) {
+	p1, p2 := entity.NewUUID(), entity.NewUUID()
+	inventory := newMockInventoryClient(map[entity.UUID]int64{p1: 5, p2: 5})
+	inventory.taxClasses = map[entity.UUID]string{p2: "food"}
+	service := application.NewOrdersService(newMockOrdersRepository(), newMockSagaRepository(), newMockCouponRepository(), newTestTaxCalculator(t), inventory, nil, fixedTime)
+
+	order := newOrder(entity.OrderItem{ProductID: p1, Quantity: 2}, entity.OrderItem{ProductID: p2, Quantity: 1})
+	order.TaxRegion = "US-CA"
+	if err := service.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(order.TaxLines) != 2 || order.TaxLines[1].TaxClass != "food" {
+		t.Fatalf("expected a tax line per item, got %+v", order.TaxLines)
+	}
+	if order.TaxTotal() != money.New(145, "USD") {
+		t.Errorf("expected 7.25%% of 2000, got %v", order.TaxTotal())
+	}
+	if order.TotalAmount != money.New(3145, "USD") {
+		t.Errorf("expected the tax to be added to the total, got %v", order.TotalAmount)
+	}
+}
+
+func TestRefundOrder_PaysBackAddedTax(t *testing.T) {
+	_, order, service := newPaymentsFixture(entity.OrderStatusPending)
+	ctx := context.Background()
+	p1 := order.Items[0].ProductID
+	order.TaxLines = []entity.TaxLine{{ProductID: p1, Rate: 10000, Taxable: money.New(2000, "USD"), Amount: money.New(200, "USD")}}
+	order.TotalAmount = money.New(2700, "USD")
+
+	payment, err := service.CreatePaymentIntent(ctx, order.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CapturePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	order.Status = entity.OrderStatusCompleted
+
+	refund, err := service.RefundOrder(ctx, order.ID, application.RefundParams{Lines: []entity.RefundLine{{ProductID: p1, Quantity: 1}}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if refund.Amount != money.New(1100, "USD") {
+		t.Errorf("expected 1000 and half of the tax, got %v", refund.Amount)
+	}
+}
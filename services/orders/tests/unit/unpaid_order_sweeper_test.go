@@ -0,0 +1,175 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/adapters/outbound/payments"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/application"
+	"github.com/ExonegeS/go-ecom-services-grpc/services/orders/internal/domain/entity"
+)
+
+type mockLocker struct {
+	held     bool
+	released int
+}
+
+func (m *mockLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	if m.held {
+		return nil, false, nil
+	}
+	m.held = true
+	return func() {
+		m.held = false
+		m.released++
+	}, true, nil
+}
+
+type sweeperFixture struct {
+	*testEnv
+	payments application.PaymentsService
+	locker   *mockLocker
+	product  entity.UUID
+}
+
+func newSweeperFixture() *sweeperFixture {
+	product := entity.NewUUID()
+	env := newTestEnv(map[entity.UUID]int64{product: 10})
+	env.ordersRepo.payments = newMockPaymentsRepository()
+	return &sweeperFixture{
+		testEnv:  env,
+		payments: application.NewPaymentsService(env.ordersRepo.payments, payments.NewFakeProvider(), env.orders, env.inventory, env.timeSource),
+		locker:   &mockLocker{},
+		product:  product,
+	}
+}
+
+// placeOrder creates a pending order for one unit, created at createdAt.
+func (f *sweeperFixture) placeOrder(t *testing.T, createdAt time.Time) *entity.Order {
+	t.Helper()
+	order := newOrder(entity.OrderItem{ProductID: f.product, Quantity: 1})
+	if err := f.orders.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	stored := f.ordersRepo.orders[order.ID]
+	stored.CreatedAt = createdAt
+	return stored
+}
+
+func (f *sweeperFixture) sweeper(dryRun bool) *application.UnpaidOrderSweeper {
+	now := func() time.Time { return fixedTime().Add(48 * time.Hour) }
+	return application.NewUnpaidOrderSweeper(f.orders, f.payments, f.locker, 24*time.Hour, time.Minute, 10, dryRun, now, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestUnpaidOrderSweeper_CancelsStaleOrders(t *testing.T) {
+	f := newSweeperFixture()
+	ctx := context.Background()
+	stale := f.placeOrder(t, fixedTime())
+	authorized := f.placeOrder(t, fixedTime())
+	recent := f.placeOrder(t, fixedTime().Add(36*time.Hour))
+
+	payment, err := f.payments.CreatePaymentIntent(ctx, authorized.ID, "card", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := f.payments.AuthorizePayment(ctx, payment.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	cancelled, err := f.sweeper(false).Sweep(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cancelled != 1 {
+		t.Errorf("expected 1 cancelled order, got %d", cancelled)
+	}
+
+	if stale.Status != entity.OrderStatusCancelled {
+		t.Errorf("expected the stale order to be cancelled, got %s", stale.Status)
+	}
+	if authorized.Status != entity.OrderStatusPending || recent.Status != entity.OrderStatusPending {
+		t.Errorf("expected the authorized and recent orders to stay pending, got %s and %s", authorized.Status, recent.Status)
+	}
+	if f.inventory.stock[f.product] != 8 {
+		t.Errorf("expected the stale order's stock to be returned, got %d", f.inventory.stock[f.product])
+	}
+
+	last := stale.StatusHistory[len(stale.StatusHistory)-1]
+	if last.Actor != entity.ActorSystem || last.Reason != "not paid within 24h0m0s" {
+		t.Errorf("unexpected history entry: %+v", last)
+	}
+	if f.locker.held || f.locker.released != 1 {
+		t.Errorf("expected the lock to be released")
+	}
+}
+
+func TestUnpaidOrderSweeper_PaidOrdersDoNotFillTheBatch(t *testing.T) {
+	f := newSweeperFixture()
+	ctx := context.Background()
+	f.inventory.stock[f.product] = 20
+	for i := 0; i < 10; i++ {
+		order := f.placeOrder(t, fixedTime())
+		payment, err := f.payments.CreatePaymentIntent(ctx, order.ID, "card", "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := f.payments.AuthorizePayment(ctx, payment.ID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	stale := f.placeOrder(t, fixedTime().Add(time.Hour))
+
+	cancelled, err := f.sweeper(false).Sweep(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cancelled != 1 || stale.Status != entity.OrderStatusCancelled {
+		t.Errorf("expected the unpaid order behind a batch of paid ones to be cancelled, got %d cancelled and %s", cancelled, stale.Status)
+	}
+}
+
+func TestUnpaidOrderSweeper_DryRunOnlyLogs(t *testing.T) {
+	f := newSweeperFixture()
+	stale := f.placeOrder(t, fixedTime())
+
+	cancelled, err := f.sweeper(true).Sweep(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cancelled != 1 {
+		t.Errorf("expected 1 order to be reported, got %d", cancelled)
+	}
+	if stale.Status != entity.OrderStatusPending || f.inventory.stock[f.product] != 9 {
+		t.Errorf("expected a dry run to change nothing, got %s with stock %d", stale.Status, f.inventory.stock[f.product])
+	}
+}
+
+func TestUnpaidOrderSweeper_SkipsWhileLocked(t *testing.T) {
+	f := newSweeperFixture()
+	stale := f.placeOrder(t, fixedTime())
+	f.locker.held = true
+
+	cancelled, err := f.sweeper(false).Sweep(context.Background())
+	if err != nil || cancelled != 0 {
+		t.Fatalf("expected nothing to be swept, got %d (%v)", cancelled, err)
+	}
+	if stale.Status != entity.OrderStatusPending {
+		t.Errorf("expected the order to stay pending while another replica sweeps, got %s", stale.Status)
+	}
+}
+
+func TestUpdateOrder_ExpectedStatus(t *testing.T) {
+	f := newSweeperFixture()
+	order := f.placeOrder(t, fixedTime())
+	order.Status = entity.OrderStatusProcessing
+
+	pending, cancelled := entity.OrderStatusPending, entity.OrderStatusCancelled
+	_, err := f.orders.UpdateOrder(context.Background(), order.ID, application.UpdateOrderParams{Status: &cancelled, ExpectedStatus: &pending})
+	if !errors.Is(err, entity.ErrStatusChanged) || order.Status != entity.OrderStatusProcessing {
+		t.Errorf("expected ErrStatusChanged with the order left processing, got %v with status %s", err, order.Status)
+	}
+}
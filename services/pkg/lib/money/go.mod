@@ -0,0 +1,3 @@
+module github.com/ExonegeS/money
+
+go 1.24.2
@@ -0,0 +1,268 @@
+// Package money holds amounts as integer minor units of an ISO 4217 currency,
+// so sums and per-line totals never drift the way float64 prices do.
+//
+// Whenever an amount has to be rounded to minor units it is rounded half away
+// from zero, which is the usual commercial rule for prices, taxes and refunds.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+	ErrInvalidAmount    = errors.New("money: invalid amount")
+)
+
+// Money is an amount in the minor unit of its currency, e.g. cents for USD.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// minorUnits lists currencies whose minor unit is not a hundredth.
+var minorUnits = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "PYG": 0, "UGX": 0, "VND": 0,
+	"XAF": 0, "XOF": 0,
+}
+
+// Exponent returns the number of decimal places of the currency's minor unit.
+func Exponent(currency string) int {
+	if exp, ok := minorUnits[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+	return 2
+}
+
+// New returns amount minor units of currency.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// Parse reads a decimal amount in major units such as "12.5" or "-0.125".
+// Digits beyond the currency's minor unit are rounded.
+func Parse(s, currency string) (Money, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	exp := Exponent(currency)
+	roundUp := false
+	if len(frac) > exp {
+		roundUp = frac[exp] >= '5'
+		frac = frac[:exp]
+	}
+	frac += strings.Repeat("0", exp-len(frac))
+
+	amount, err := strconv.ParseInt(whole+frac, 10, 64)
+	if whole+frac == "" {
+		amount, err = 0, nil
+	}
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if roundUp {
+		amount++
+	}
+	if negative {
+		amount = -amount
+	}
+	return New(amount, currency), nil
+}
+
+// FromFloat converts a float amount in major units. It rounds the shortest
+// decimal form of v, so 0.285 becomes 29 cents rather than the 28 a plain
+// multiplication would give. It exists for fields that still carry floats.
+func FromFloat(v float64, currency string) Money {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return New(0, currency)
+	}
+	m, err := Parse(strconv.FormatFloat(v, 'f', -1, 64), currency)
+	if err != nil {
+		return New(0, currency)
+	}
+	return m
+}
+
+// Float64 returns the amount in major units. It is only meant for fields that
+// still carry floats; never compute with the result.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.Decimal(), 64)
+	return f
+}
+
+// Decimal formats the amount in major units, e.g. "12.50" or "-0.05".
+func (m Money) Decimal() string {
+	exp := Exponent(m.Currency)
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatUint(absUint(amount), 10)
+	if exp == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+func (m Money) IsZero() bool     { return m.Amount == 0 }
+func (m Money) IsNegative() bool { return m.Amount < 0 }
+func (m Money) IsPositive() bool { return m.Amount > 0 }
+
+// Add returns m+o. Both must be in the same currency; a zero amount without a
+// currency takes the other's.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := sameCurrency(m, o)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(m.Amount+o.Amount, currency), nil
+}
+
+// Sub returns m-o under the same rules as Add.
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := sameCurrency(m, o)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(m.Amount-o.Amount, currency), nil
+}
+
+// Compare returns -1, 0 or 1 as m is less than, equal to or greater than o.
+func (m Money) Compare(o Money) (int, error) {
+	if _, err := sameCurrency(m, o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Mul returns the amount times n, e.g. a unit price times a quantity.
+func (m Money) Mul(n int64) Money {
+	return New(m.Amount*n, m.Currency)
+}
+
+// MulRate returns the amount times num/den, rounded to the minor unit. A tax
+// of 8.875% is MulRate(8875, 100000).
+func (m Money) MulRate(num, den int64) Money {
+	if den == 0 {
+		return New(0, m.Currency)
+	}
+	product := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(num))
+	return New(divRound(product, big.NewInt(den)), m.Currency)
+}
+
+// Allocate splits the amount in proportion to weights without losing a
+// minor unit: leftovers from rounding down go to the first parts.
+func (m Money) Allocate(weights ...int64) []Money {
+	parts := make([]Money, len(weights))
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		for i := range parts {
+			parts[i] = New(0, m.Currency)
+		}
+		return parts
+	}
+
+	remainder := m.Amount
+	for i, w := range weights {
+		share := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(w))
+		share.Quo(share, big.NewInt(total))
+		parts[i] = New(share.Int64(), m.Currency)
+		remainder -= share.Int64()
+	}
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for i := 0; remainder != 0 && len(parts) > 0; i = (i + 1) % len(parts) {
+		if weights[i] == 0 {
+			continue
+		}
+		parts[i].Amount += step
+		remainder -= step
+	}
+	return parts
+}
+
+// Sum adds amounts of the same currency. The sum of nothing is zero in
+// currency.
+func Sum(currency string, amounts ...Money) (Money, error) {
+	total := New(0, currency)
+	for _, amount := range amounts {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+func sameCurrency(a, b Money) (string, error) {
+	switch {
+	case a.Currency == b.Currency:
+		return a.Currency, nil
+	case a.Currency == "" && a.Amount == 0:
+		return b.Currency, nil
+	case b.Currency == "" && b.Amount == 0:
+		return a.Currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, b.Currency)
+}
+
+// divRound divides rounding half away from zero.
+func divRound(n, d *big.Int) int64 {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	r.Abs(r).Mul(r, big.NewInt(2))
+	if r.Cmp(new(big.Int).Abs(d)) >= 0 {
+		if (n.Sign() < 0) != (d.Sign() < 0) {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q.Int64()
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func absUint(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
@@ -0,0 +1,96 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in       string
+		currency string
+		want     int64
+	}{
+		{"12.34", "USD", 1234},
+		{"12.5", "usd", 1250},
+		{"0.005", "USD", 1},
+		{"0.004", "USD", 0},
+		{"-0.005", "USD", -1},
+		{".7", "JPY", 1},
+		{"1200", "JPY", 1200},
+		{"1.2345", "KWD", 1235},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in, tt.currency)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error %v", tt.in, err)
+			continue
+		}
+		if got.Amount != tt.want {
+			t.Errorf("Parse(%q, %s) = %d, want %d", tt.in, tt.currency, got.Amount, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", ".", "1.2.3", "abc", "1e5"} {
+		if _, err := Parse(in, "USD"); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Parse(%q): expected ErrInvalidAmount, got %v", in, err)
+		}
+	}
+}
+
+func TestFromFloatRoundsDecimalForm(t *testing.T) {
+	if got := FromFloat(0.285, "USD").Amount; got != 29 {
+		t.Errorf("expected 0.285 to round to 29 cents, got %d", got)
+	}
+	if got := FromFloat(19.99, "USD").Amount; got != 1999 {
+		t.Errorf("expected 1999, got %d", got)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := map[Money]string{
+		New(1234, "USD"): "12.34",
+		New(5, "USD"):    "0.05",
+		New(-5, "USD"):   "-0.05",
+		New(1200, "JPY"): "1200",
+		New(1, "KWD"):    "0.001",
+	}
+	for m, want := range tests {
+		if got := m.Decimal(); got != want {
+			t.Errorf("%#v.Decimal() = %q, want %q", m, got, want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	price := New(333, "USD")
+	if got := price.Mul(3).Amount; got != 999 {
+		t.Errorf("expected 999, got %d", got)
+	}
+	if got := New(1000, "USD").MulRate(8875, 100000).Amount; got != 89 {
+		t.Errorf("expected 8.875%% of 10.00 to round to 89 cents, got %d", got)
+	}
+	if got := New(-1000, "USD").MulRate(8875, 100000).Amount; got != -89 {
+		t.Errorf("expected -89, got %d", got)
+	}
+
+	if _, err := price.Add(New(1, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	total, err := Sum("USD", New(100, "USD"), New(250, "USD"))
+	if err != nil || total.Amount != 350 {
+		t.Errorf("expected 350, got %v (%v)", total, err)
+	}
+}
+
+func TestAllocateKeepsEveryMinorUnit(t *testing.T) {
+	parts := New(100, "USD").Allocate(1, 1, 1)
+	if parts[0].Amount != 34 || parts[1].Amount != 33 || parts[2].Amount != 33 {
+		t.Errorf("unexpected split: %v", parts)
+	}
+
+	parts = New(1000, "USD").Allocate(0, 3, 7)
+	if parts[0].Amount != 0 || parts[1].Amount != 300 || parts[2].Amount != 700 {
+		t.Errorf("unexpected split: %v", parts)
+	}
+}